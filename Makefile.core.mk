@@ -18,7 +18,7 @@ TAG ?= 1.5-dev
 pwd := $(shell pwd)
 
 # make targets
-.PHONY: lint lint-dependencies test_with_coverage mandiff build fmt vfsgen update-charts update-goldens
+.PHONY: lint lint-dependencies test_with_coverage mandiff build fmt vfsgen update-charts update-goldens generate-crd
 
 build: mesh
 
@@ -39,7 +39,7 @@ mandiff: update-charts
 
 fmt: format-go tidy-go
 
-gen: generate-v1alpha1 generate-vfs tidy-go mirror-licenses
+gen: generate-v1alpha1 generate-vfs generate-crd tidy-go mirror-licenses
 
 gen-check: clean gen check-clean-repo
 
@@ -57,6 +57,9 @@ generate-vfs: update-charts
 clean-vfs:
 	@rm -fr pkg/vfs/assets.gen.go
 
+generate-crd:
+	@go run ./cmd/mesh.go profile schema -o crd > deploy/crds/istio_v1alpha1_istiooperator_crd.yaml
+
 mesh:
 	# First line is for test environment, second is for target. Since these architectures can differ, the workaround
 	# is to build both. TODO: figure out some way to implement this better, e.g. separate test target.
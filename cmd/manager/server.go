@@ -79,6 +79,11 @@ func getWatchNamespace() (string, error) {
 	return ns, nil
 }
 
+// getOperatorNamespace returns the namespace the operator runs in
+func getOperatorNamespace() (string, bool) {
+	return os.LookupEnv("POD_NAMESPACE")
+}
+
 // getLeaderElectionNamespace returns the namespace in which the leader election configmap will be created
 func getLeaderElectionNamespace() (string, bool) {
 	return os.LookupEnv("LEADER_ELECTION_NAMESPACE")
@@ -125,6 +130,15 @@ func run() {
 		log.Fatalf("Could not add all controllers to operator manager: %v", err)
 	}
 
+	// Setup the validating webhook
+	if operatorNS, ok := getOperatorNamespace(); ok {
+		if err := istiocontrolplane.AddWebhook(mgr, operatorNS); err != nil {
+			log.Fatalf("Could not add the validating webhook to operator manager: %v", err)
+		}
+	} else {
+		log.Warn("POD_NAMESPACE not set. The IstioOperator validating webhook is disabled.")
+	}
+
 	log.Info("Starting the Cmd.")
 
 	// Start the Cmd
@@ -0,0 +1,107 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ghodss/yaml"
+
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/object"
+	"istio.io/operator/pkg/translate"
+	"istio.io/operator/pkg/util"
+	"istio.io/operator/pkg/validate"
+	"istio.io/operator/pkg/version"
+)
+
+// deprecatedFields returns the fields set by the user in the IstioOperator CR in inFilename, if any, and in
+// setOverlayYAML that are deprecated or removed in the Istio minor version target. Fields set in profiles are not
+// reported, since users cannot change them. The position of fields in inFilename is set.
+func deprecatedFields(inFilename, setOverlayYAML string, target version.MinorVersion) (validate.Diagnostics, error) {
+	d, err := translate.NewDeprecations()
+	if err != nil {
+		return nil, err
+	}
+	var ds validate.Diagnostics
+	if inFilename != "" {
+		b, err := ioutil.ReadFile(inFilename)
+		if err != nil {
+			return nil, fmt.Errorf("could not read values from file %s: %s", inFilename, err)
+		}
+		cr := make(map[string]interface{})
+		if err := yaml.Unmarshal(b, &cr); err != nil {
+			return nil, err
+		}
+		specYAML, err := yaml.Marshal(cr["spec"])
+		if err != nil {
+			return nil, err
+		}
+		if ds, err = validate.CheckDeprecatedFields(string(specYAML), d, target); err != nil {
+			return nil, err
+		}
+		doc, err := util.ParseYAMLNode(string(b))
+		if err != nil {
+			return nil, err
+		}
+		ds.Locate(doc, specPath, inFilename)
+	}
+	setDS, err := validate.CheckDeprecatedFields(setOverlayYAML, d, target)
+	if err != nil {
+		return nil, err
+	}
+	inFile := make(map[string]bool)
+	for _, diag := range ds {
+		inFile[diag.Path] = true
+	}
+	for _, diag := range setDS {
+		if !inFile[diag.Path] {
+			ds = append(ds, diag)
+		}
+	}
+	return ds, nil
+}
+
+// checkDeprecatedFields logs the fields set by the user in the IstioOperator CR in inFilename and in setOverlayYAML that
+// are deprecated in the Istio minor version target. Fields that are removed in target are returned as errors, unless
+// force is set.
+func checkDeprecatedFields(inFilename, setOverlayYAML string, target version.MinorVersion, force bool, l *Logger) error {
+	ds, err := deprecatedFields(inFilename, setOverlayYAML, target)
+	if err != nil {
+		return err
+	}
+	return reportDiagnostics(ds, force, "the configuration uses removed fields", l)
+}
+
+// checkAPIs logs the objects in manifests with a Kubernetes API version that is deprecated. Objects with an API version
+// that the cluster does not serve according to served, unless it is nil, are returned as errors, unless force is set.
+func checkAPIs(manifests name.ManifestMap, served validate.ServedFunc, force bool, l *Logger) error {
+	d, err := translate.NewDeprecations()
+	if err != nil {
+		return err
+	}
+	var objs object.K8sObjects
+	for _, ms := range manifests {
+		for _, m := range ms {
+			mo, err := object.ParseK8sObjectsFromYAMLManifest(m)
+			if err != nil {
+				return err
+			}
+			objs = append(objs, mo...)
+		}
+	}
+	return reportDiagnostics(validate.CheckAPIs(objs, d, served), force, "the manifests use APIs the cluster does not serve", l)
+}
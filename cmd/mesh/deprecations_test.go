@@ -0,0 +1,68 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"istio.io/operator/pkg/version"
+)
+
+func TestDeprecatedFields(t *testing.T) {
+	dir := createTempDirOrFail(t, "deprecated-fields")
+	defer removeDirOrFail(t, dir)
+	inFilename := filepath.Join(dir, "iop.yaml")
+	cr := `apiVersion: install.istio.io/v1alpha1
+kind: IstioOperator
+spec:
+  values:
+    pilot:
+      enabled: true
+      replicaCount: 2
+`
+	if err := ioutil.WriteFile(inFilename, []byte(cr), 0644); err != nil {
+		t.Fatal(err)
+	}
+	setOverlayYAML := `
+values:
+  pilot:
+    replicaCount: 3
+    nodeSelector:
+      disktype: ssd
+`
+	ds, err := deprecatedFields(inFilename, setOverlayYAML, version.NewMinorVersion(1, 5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, d := range ds {
+		got = append(got, d.String())
+	}
+	want := []string{
+		inFilename + ":7:7: warning: values.pilot.replicaCount is deprecated since 1.4, use components.pilot.k8s.replicaCount " +
+			"instead [deprecated-field]",
+		"warning: values.pilot.nodeSelector is deprecated since 1.4, use components.pilot.k8s.nodeSelector instead [deprecated-field]",
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("got:\n%v\nwant:\n%v", got, want)
+	}
+
+	if ds, err = deprecatedFields(inFilename, setOverlayYAML, version.NewMinorVersion(1, 3)); err != nil || len(ds) != 0 {
+		t.Errorf("got %v and error %v for 1.3, want no deprecated fields", ds, err)
+	}
+}
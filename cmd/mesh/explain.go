@@ -0,0 +1,226 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+
+	"istio.io/operator/pkg/schema"
+	"istio.io/operator/pkg/tpath"
+	"istio.io/operator/pkg/translate"
+	"istio.io/operator/pkg/util"
+	"istio.io/operator/pkg/validate"
+	version2 "istio.io/operator/version"
+)
+
+const (
+	// maxInlineDefaultLen is the length that defaults of the listed fields are truncated to.
+	maxInlineDefaultLen = 80
+)
+
+type explainArgs struct {
+	// inFilename is the path to the input IstioOperator CR, whose merged values are shown as defaults.
+	inFilename string
+	// profile is the profile whose values are shown as defaults if inFilename is not set.
+	profile string
+}
+
+func addExplainFlags(cmd *cobra.Command, args *explainArgs) {
+	cmd.PersistentFlags().StringVarP(&args.inFilename, "filename", "f", "",
+		"Path to file containing IstioOperator CustomResource, whose merged values are shown as defaults")
+	cmd.PersistentFlags().StringVarP(&args.profile, "profile", "p", "",
+		"The profile whose values are shown as defaults. Defaults to the default profile")
+}
+
+// ExplainCmd is a command to describe the fields of the IstioOperatorSpec API.
+func ExplainCmd() *cobra.Command {
+	rootArgs := &rootArgs{}
+	eArgs := &explainArgs{}
+	cmd := &cobra.Command{
+		Use:   "explain [<path>]",
+		Short: "Describes fields of the IstioOperator API",
+		Long: "The explain command describes the field at a path of the IstioOperatorSpec, e.g. components.pilot.k8s " +
+			"or values.global.proxy, and its fields: their type, description, default from the selected profile, " +
+			"validation rules and the Helm values or k8s resource paths they translate to.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			l := NewLogger(rootArgs.logToStdErr, cmd.OutOrStdout(), cmd.ErrOrStderr())
+			return explain(args, rootArgs, eArgs, l)
+		},
+	}
+	addFlags(cmd, rootArgs)
+	addExplainFlags(cmd, eArgs)
+	return cmd
+}
+
+func explain(args []string, rootArgs *rootArgs, eArgs *explainArgs, l *Logger) error {
+	initLogsOrExit(rootArgs)
+
+	if eArgs.inFilename != "" && eArgs.profile != "" {
+		return fmt.Errorf("cannot specify both profile and filename flags")
+	}
+	var path util.Path
+	if len(args) == 1 {
+		path = util.PathFromString(args[0])
+	}
+	f, err := schema.Find(path)
+	if err != nil {
+		return err
+	}
+
+	y, _, err := genIOPS(eArgs.inFilename, eArgs.profile, "", "", nil, true, l)
+	if err != nil {
+		return err
+	}
+	defaults := make(map[string]interface{})
+	if err := yaml.Unmarshal([]byte(y), &defaults); err != nil {
+		return err
+	}
+	t, err := translate.NewTranslator(version2.OperatorBinaryVersion.MinorVersion)
+	if err != nil {
+		return err
+	}
+	e := &explainer{defaults: defaults, translator: t}
+
+	var sb strings.Builder
+	if err := e.writeField(&sb, f); err != nil {
+		return err
+	}
+	if children := f.Fields(); len(children) != 0 {
+		sb.WriteString("\nFIELDS:\n")
+		for _, c := range children {
+			if err := e.writeFieldSummary(&sb, c); err != nil {
+				return err
+			}
+		}
+	}
+	l.print(sb.String())
+	return nil
+}
+
+// explainer writes descriptions of IstioOperatorSpec fields.
+type explainer struct {
+	// defaults is the IstioOperatorSpec tree whose values are shown as defaults.
+	defaults map[string]interface{}
+	// translator translates fields to Helm values and k8s resource paths.
+	translator *translate.Translator
+}
+
+// writeField writes the full description of f to sb.
+func (e *explainer) writeField(sb *strings.Builder, f *schema.Field) error {
+	fmt.Fprintf(sb, "FIELD:   %s\n", f.PathString())
+	fmt.Fprintf(sb, "TYPE:    %s\n", f.TypeName())
+	if ev := f.EnumValues(); len(ev) != 0 {
+		fmt.Fprintf(sb, "VALUES:  %s\n", strings.Join(ev, ", "))
+	}
+	if rules := validate.Rules(f.Path); len(rules) != 0 {
+		fmt.Fprintf(sb, "RULES:   %s\n", strings.Join(rules, "; "))
+	}
+	targets, err := e.targets(f)
+	if err != nil {
+		return err
+	}
+	for _, t := range targets {
+		fmt.Fprintf(sb, "MAPS TO: %s\n", t)
+	}
+	if d := f.Description(); d != "" {
+		fmt.Fprintf(sb, "\nDESCRIPTION:\n%s\n", indent(d, "  "))
+	}
+	if v, ok := e.defaultValue(f); ok {
+		out, err := yaml.Marshal(v)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(sb, "\nDEFAULT:\n%s\n", indent(strings.TrimSuffix(string(out), "\n"), "  "))
+	}
+	return nil
+}
+
+// writeFieldSummary writes the description of f as an entry in the list of fields of its parent to sb.
+func (e *explainer) writeFieldSummary(sb *strings.Builder, f *schema.Field) error {
+	fmt.Fprintf(sb, "  %s <%s>\n", f.Name(), f.TypeName())
+	if d := f.Description(); d != "" {
+		sb.WriteString(indent(d, "    ") + "\n")
+	}
+	if v, ok := e.defaultValue(f); ok {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		s := string(b)
+		if len(s) > maxInlineDefaultLen {
+			s = s[:maxInlineDefaultLen] + "..."
+		}
+		fmt.Fprintf(sb, "    Default: %s\n", s)
+	}
+	if ev := f.EnumValues(); len(ev) != 0 {
+		fmt.Fprintf(sb, "    Values: %s\n", strings.Join(ev, ", "))
+	}
+	if rules := validate.Rules(f.Path); len(rules) != 0 {
+		fmt.Fprintf(sb, "    Rules: %s\n", strings.Join(rules, "; "))
+	}
+	targets, err := e.targets(f)
+	if err != nil {
+		return err
+	}
+	for _, t := range targets {
+		fmt.Fprintf(sb, "    Maps to: %s\n", t)
+	}
+	return nil
+}
+
+// defaultValue returns the value of f in the defaults tree.
+func (e *explainer) defaultValue(f *schema.Field) (interface{}, bool) {
+	if len(f.Path) == 0 {
+		return nil, false
+	}
+	v, found, err := tpath.GetFromTreePath(e.defaults, f.Path)
+	if err != nil || !found {
+		return nil, false
+	}
+	return v, true
+}
+
+// targets returns the Helm values and k8s resource paths that f translates to.
+func (e *explainer) targets(f *schema.Field) ([]string, error) {
+	if f.IsValues() {
+		if len(f.Path) < 2 {
+			return nil, nil
+		}
+		return []string{"Helm values " + f.Path[1:].String()}, nil
+	}
+	valuesPath, k8sPaths, err := e.translator.Targets(f.GoPath)
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	if valuesPath != "" {
+		out = append(out, "Helm values "+valuesPath)
+	}
+	for _, p := range k8sPaths {
+		out = append(out, "k8s "+p)
+	}
+	return out, nil
+}
+
+// indent prefixes each line of s with prefix.
+func indent(s, prefix string) string {
+	return prefix + strings.Replace(s, "\n", "\n"+prefix, -1)
+}
@@ -0,0 +1,119 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"istio.io/operator/pkg/httprequest"
+)
+
+type httpArgs struct {
+	// timeout is the timeout of each HTTP request.
+	timeout time.Duration
+	// retries is the number of retries of failed HTTP requests.
+	retries int
+	// caFile is the path to a PEM bundle of extra CA certificates to trust.
+	caFile string
+	// certFile is the path to the PEM client certificate.
+	certFile string
+	// keyFile is the path to the PEM client key.
+	keyFile string
+	// headers are extra request headers in "Name: value" format.
+	headers []string
+	// headersFile is the path to a file with extra request headers.
+	headersFile string
+}
+
+var (
+	// httpClientArgs holds the HTTP client settings shared by every command that downloads from remote URLs.
+	httpClientArgs = &httpArgs{}
+)
+
+func addHTTPFlags(cmd *cobra.Command, args *httpArgs) {
+	cmd.PersistentFlags().DurationVar(&args.timeout, "http-timeout", httprequest.DefaultTimeout,
+		"Timeout of each HTTP request. Defaults to $"+httprequest.TimeoutEnvVar+" if set")
+	cmd.PersistentFlags().IntVar(&args.retries, "http-retries", httprequest.DefaultRetries,
+		"Number of retries of HTTP requests failing with a connection error or a 5xx response. Defaults to $"+
+			httprequest.RetriesEnvVar+" if set")
+	cmd.PersistentFlags().StringVar(&args.caFile, "http-ca-file", "",
+		"Path to a PEM bundle of CA certificates to trust for HTTPS downloads in addition to the system ones. "+
+			"Defaults to $"+httprequest.CAFileEnvVar)
+	cmd.PersistentFlags().StringVar(&args.certFile, "http-client-cert", "",
+		"Path to the PEM client certificate for HTTPS downloads. Defaults to $"+httprequest.CertFileEnvVar)
+	cmd.PersistentFlags().StringVar(&args.keyFile, "http-client-key", "",
+		"Path to the PEM client key for HTTPS downloads. Defaults to $"+httprequest.KeyFileEnvVar)
+	cmd.PersistentFlags().StringArrayVar(&args.headers, "http-header", nil,
+		`Extra header added to HTTP requests, e.g. --http-header "Authorization: Bearer <token>". May be repeated`)
+	cmd.PersistentFlags().StringVar(&args.headersFile, "http-headers-file", "",
+		"Path to a file with extra headers added to HTTP requests, one \"Name: value\" pair per line. It is read "+
+			"on every request. Defaults to $"+httprequest.HeadersFileEnvVar)
+}
+
+// setHTTPClient configures the client used for all downloads from the HTTP flags set on cmd. Options come from
+// the environment first and are overridden by any flags set explicitly.
+func setHTTPClient(cmd *cobra.Command, args *httpArgs) error {
+	opts, err := httprequest.DefaultOptions()
+	if err != nil {
+		return err
+	}
+	flags := cmd.Flags()
+	if flags.Changed("http-timeout") {
+		opts.Timeout = args.timeout
+	}
+	if flags.Changed("http-retries") {
+		if args.retries < 0 {
+			return fmt.Errorf("--http-retries must not be negative")
+		}
+		opts.Retries = args.retries
+	}
+	if args.caFile != "" {
+		opts.CAFile = args.caFile
+	}
+	if args.certFile != "" {
+		opts.CertFile = args.certFile
+	}
+	if args.keyFile != "" {
+		opts.KeyFile = args.keyFile
+	}
+	if args.headersFile != "" {
+		opts.HeadersFile = args.headersFile
+	}
+	if len(args.headers) != 0 {
+		h, err := httprequest.ParseHeaders(strings.Join(args.headers, "\n"))
+		if err != nil {
+			return fmt.Errorf("bad --http-header: %s", err)
+		}
+		if opts.Headers == nil {
+			opts.Headers = h
+		} else {
+			for k, v := range h {
+				opts.Headers[k] = v
+			}
+		}
+	}
+	opts.Progress = os.Stderr
+	c, err := httprequest.NewClient(opts)
+	if err != nil {
+		return err
+	}
+	httprequest.SetDefaultClient(c)
+	return nil
+}
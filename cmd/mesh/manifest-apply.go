@@ -43,6 +43,14 @@ type manifestApplyArgs struct {
 	// set is a string with element format "path=value" where path is an IstioOperator path and the value is a
 	// value to set the node at that path to.
 	set []string
+	// pkgCache selects the package cache that install packages are fetched into.
+	pkgCache packageCacheArgs
+	// policy selects the guardrail policy the installation must follow.
+	policy policyArgs
+	// strictOverlays fails the installation if overlays, overlay paths or K8S settings match nothing.
+	strictOverlays bool
+	// postRender selects the post-renderer that the manifest is transformed with before it is applied.
+	postRender postRenderArgs
 }
 
 func addManifestApplyFlags(cmd *cobra.Command, args *manifestApplyArgs) {
@@ -56,6 +64,10 @@ func addManifestApplyFlags(cmd *cobra.Command, args *manifestApplyArgs) {
 	cmd.PersistentFlags().BoolVarP(&args.wait, "wait", "w", false, "Wait, if set will wait until all Pods, Services, and minimum number of Pods "+
 		"of a Deployment are in a ready state before the command exits. It will wait for a maximum duration of --readiness-timeout seconds")
 	cmd.PersistentFlags().StringSliceVarP(&args.set, "set", "s", nil, SetFlagHelpStr)
+	cmd.PersistentFlags().BoolVar(&args.strictOverlays, "strict-overlays", false, strictOverlaysFlagHelpStr)
+	addPackageCacheFlags(cmd, &args.pkgCache)
+	addPolicyFlags(cmd, &args.policy)
+	addPostRenderFlags(cmd, &args.postRender)
 }
 
 func manifestApplyCmd(rootArgs *rootArgs, maArgs *manifestApplyArgs) *cobra.Command {
@@ -81,8 +93,9 @@ func manifestApply(args *rootArgs, maArgs *manifestApplyArgs, l *Logger) error {
 	if err := configLogs(args.logToStdErr); err != nil {
 		return fmt.Errorf("could not configure logs: %s", err)
 	}
-	if err := genApplyManifests(maArgs.set, maArgs.inFilename, maArgs.force, args.dryRun, args.verbose,
-		maArgs.kubeConfigPath, maArgs.context, maArgs.wait, maArgs.readinessTimeout, l); err != nil {
+	if err := genApplyManifests(maArgs.set, maArgs.inFilename, &maArgs.pkgCache, &maArgs.policy, &maArgs.postRender, maArgs.force,
+		maArgs.strictOverlays, args.dryRun, args.verbose, maArgs.kubeConfigPath, maArgs.context, maArgs.wait, maArgs.readinessTimeout,
+		l); err != nil {
 		return fmt.Errorf("failed to generate and apply manifests, error: %v", err)
 	}
 
@@ -16,14 +16,14 @@ package mesh
 
 import (
 	"fmt"
-	"path"
-	"path/filepath"
+	"io/ioutil"
 	"strings"
 	"time"
 
 	"github.com/ghodss/yaml"
 
 	"istio.io/api/operator/v1alpha1"
+	"istio.io/operator/pkg/component/component"
 	"istio.io/operator/pkg/component/controlplane"
 	"istio.io/operator/pkg/helm"
 	"istio.io/operator/pkg/kubectlcmd"
@@ -43,17 +43,31 @@ var (
 	}
 )
 
-func genApplyManifests(setOverlay []string, inFilename string, force bool, dryRun bool, verbose bool,
-	kubeConfigPath string, context string, wait bool, waitTimeout time.Duration, l *Logger) error {
+func genApplyManifests(setOverlay []string, inFilename string, pkgArgs *packageCacheArgs, polArgs *policyArgs,
+	prArgs *postRenderArgs, force bool, strictOverlays bool, dryRun bool, verbose bool, kubeConfigPath string, context string, wait bool,
+	waitTimeout time.Duration, l *Logger) error {
 	overlayFromSet, err := MakeTreeFromSetList(setOverlay, force, l)
 	if err != nil {
 		return fmt.Errorf("failed to generate tree from the set overlay, error: %v", err)
 	}
 
-	manifests, iops, err := GenManifests(inFilename, overlayFromSet, force, l)
+	var namespaceExists func(string) (bool, error)
+	var served validate.ServedFunc
+	if !dryRun {
+		namespaceExists = func(ns string) (bool, error) {
+			return manifest.NamespaceExists(kubeConfigPath, context, ns)
+		}
+		served = func(apiVersion, kind string) (bool, error) {
+			return manifest.APIServed(kubeConfigPath, context, apiVersion, kind)
+		}
+	}
+	manifests, iops, err := genManifests(inFilename, overlayFromSet, pkgArgs, prArgs, force, strictOverlays, namespaceExists, served, l)
 	if err != nil {
 		return fmt.Errorf("failed to generate manifest: %v", err)
 	}
+	if err := checkPolicy(polArgs, inFilename, iops, manifests, l); err != nil {
+		return err
+	}
 	opts := &kubectlcmd.Options{
 		DryRun:      dryRun,
 		Verbose:     verbose,
@@ -62,7 +76,11 @@ func genApplyManifests(setOverlay []string, inFilename string, force bool, dryRu
 		Kubeconfig:  kubeConfigPath,
 		Context:     context,
 	}
-	out, err := manifest.ApplyAll(manifests, version.OperatorBinaryVersion, opts)
+	addonsAfter, err := component.AddonsInstallAfter(iops)
+	if err != nil {
+		return err
+	}
+	out, err := manifest.ApplyAll(manifests, version.OperatorBinaryVersion, opts, addonsAfter)
 	if err != nil {
 		return fmt.Errorf("failed to apply manifest with kubectl client: %v", err)
 	}
@@ -105,8 +123,19 @@ func genApplyManifests(setOverlay []string, inFilename string, force bool, dryRu
 	return nil
 }
 
-// GenManifests generate manifest from input file and setOverLay
-func GenManifests(inFilename string, setOverlayYAML string, force bool, l *Logger) (name.ManifestMap, *v1alpha1.IstioOperatorSpec, error) {
+// GenManifests generate manifest from input file and setOverLay. Install packages are fetched into the package cache
+// selected by pkgArgs, and the manifests are transformed with the post-renderer selected by prArgs, unless it is nil.
+// Overlays, overlay paths and K8S settings that match nothing are logged, or returned as errors if strictOverlays is set.
+func GenManifests(inFilename string, setOverlayYAML string, pkgArgs *packageCacheArgs, prArgs *postRenderArgs, force bool,
+	strictOverlays bool, l *Logger) (name.ManifestMap, *v1alpha1.IstioOperatorSpec, error) {
+	return genManifests(inFilename, setOverlayYAML, pkgArgs, prArgs, force, strictOverlays, nil, nil, l)
+}
+
+// genManifests is like GenManifests. The semantic rules look up namespaces with namespaceExists, and the API versions
+// of the rendered objects are checked against the cluster with served, unless they are nil.
+func genManifests(inFilename string, setOverlayYAML string, pkgArgs *packageCacheArgs, prArgs *postRenderArgs, force bool,
+	strictOverlays bool, namespaceExists func(string) (bool, error), served validate.ServedFunc,
+	l *Logger) (name.ManifestMap, *v1alpha1.IstioOperatorSpec, error) {
 	mergedYAML, err := genProfile(false, inFilename, "", setOverlayYAML, "", force, l)
 	if err != nil {
 		return nil, nil, err
@@ -115,13 +144,23 @@ func GenManifests(inFilename string, setOverlayYAML string, force bool, l *Logge
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := checkDeprecatedFields(inFilename, setOverlayYAML, version.OperatorBinaryVersion.MinorVersion, force, l); err != nil {
+		return nil, nil, err
+	}
+	if err := checkRules(inFilename, mergedIOPS, force, namespaceExists, l); err != nil {
+		return nil, nil, err
+	}
 
 	t, err := translate.NewTranslator(version.OperatorBinaryVersion.MinorVersion)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	if err := fetchInstallPackageFromURL(mergedIOPS); err != nil {
+	pkg, err := fetchInstallPackageFromURL(pkgArgs, mergedIOPS)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := fetchAddonCharts(pkgArgs, mergedIOPS); err != nil {
 		return nil, nil, err
 	}
 
@@ -129,6 +168,14 @@ func GenManifests(inFilename string, setOverlayYAML string, force bool, l *Logge
 	if err != nil {
 		return nil, nil, err
 	}
+	pr, err := newPostRenderer(prArgs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if pr != nil {
+		cp.SetPostRenderer(pr)
+	}
+	cp.SetReadLocalFiles(true)
 	if err := cp.Run(); err != nil {
 		return nil, nil, fmt.Errorf("failed to create Istio control plane with spec: \n%v\nerror: %s", mergedIOPS, err)
 	}
@@ -137,9 +184,110 @@ func GenManifests(inFilename string, setOverlayYAML string, force bool, l *Logge
 	if errs != nil {
 		return manifests, mergedIOPS, errs.ToError()
 	}
+	if err := checkOverlays(inFilename, setOverlayYAML, cp.Warnings(), strictOverlays, force, l); err != nil {
+		return nil, nil, err
+	}
+	if err := checkAPIs(manifests, served, force, l); err != nil {
+		return nil, nil, err
+	}
+	if pkg != nil {
+		if err := manifest.AnnotateManifests(manifests, installPackageAnnotations(pkg)); err != nil {
+			return nil, nil, err
+		}
+	}
 	return manifests, mergedIOPS, nil
 }
 
+// checkRules runs the semantic rules that are not disabled in the IstioOperator CR in inFilename, if any, on the merged
+// IstioOperatorSpec iops, looking up namespaces with namespaceExists unless it is nil. Warnings are logged. Errors are
+// returned, unless force is set.
+func checkRules(inFilename string, iops *v1alpha1.IstioOperatorSpec, force bool, namespaceExists func(string) (bool, error),
+	l *Logger) error {
+	disabled := make(map[string]bool)
+	if inFilename != "" {
+		b, err := ioutil.ReadFile(inFilename)
+		if err != nil {
+			return fmt.Errorf("could not read values from file %s: %s", inFilename, err)
+		}
+		if disabled, err = validate.DisabledRulesFromCR(string(b)); err != nil {
+			return err
+		}
+	}
+	return reportDiagnostics(validate.CheckRules(iops, disabled, namespaceExists), force, "the configuration breaks validation rules", l)
+}
+
+// checkOverlays logs the overlays, overlay paths and K8S settings in unmatched that matched nothing in the rendered
+// manifests. K8S settings are only reported if they are set by the user in the IstioOperator CR in inFilename, if any,
+// or in setOverlayYAML. If strict is set, they are returned as errors, unless force is set.
+func checkOverlays(inFilename, setOverlayYAML string, unmatched util.Errors, strict, force bool, l *Logger) error {
+	if len(unmatched) == 0 {
+		return nil
+	}
+	specYAML := ""
+	if inFilename != "" {
+		b, err := ioutil.ReadFile(inFilename)
+		if err != nil {
+			return fmt.Errorf("could not read values from file %s: %s", inFilename, err)
+		}
+		cr := make(map[string]interface{})
+		if err := yaml.Unmarshal(b, &cr); err != nil {
+			return err
+		}
+		if cr["spec"] == nil {
+			cr["spec"] = map[string]interface{}{}
+		}
+		sb, err := yaml.Marshal(cr["spec"])
+		if err != nil {
+			return err
+		}
+		specYAML = string(sb)
+	}
+	userSpecYAML := setOverlayYAML
+	if specYAML != "" {
+		var err error
+		if userSpecYAML, err = util.OverlayYAML(specYAML, setOverlayYAML); err != nil {
+			return err
+		}
+	}
+	ds, err := validate.UnmatchedOverlayDiagnostics(unmatched, userSpecYAML, strict)
+	if err != nil {
+		return err
+	}
+	return reportDiagnostics(ds, force, "overlays match nothing in the manifests", l)
+}
+
+// reportDiagnostics logs the warnings in ds and returns the errors in ds, prefixed with msg, unless force is set.
+func reportDiagnostics(ds validate.Diagnostics, force bool, msg string, l *Logger) error {
+	var errs util.Errors
+	for _, d := range ds {
+		if d.Severity != validate.SeverityError {
+			l.logAndError(d.String())
+			continue
+		}
+		errs = util.AppendErr(errs, fmt.Errorf("%s", d))
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	if !force {
+		l.logAndError("Run the command with the --force flag if you want to ignore the validation error and proceed.")
+		return fmt.Errorf("%s: %s", msg, errs)
+	}
+	l.logAndError("Proceeding despite the following validation errors: \n", errs.Error())
+	return nil
+}
+
+// installPackageAnnotations returns the annotations recording the origin of an install package.
+func installPackageAnnotations(pkg *helm.CachedPackage) map[string]string {
+	out := map[string]string{
+		manifest.InstallPackageDigestAnnotationStr: "sha256:" + pkg.Digest,
+	}
+	if pkg.Signer != "" {
+		out[manifest.InstallPackageSignerAnnotationStr] = pkg.Signer
+	}
+	return out
+}
+
 func ignoreError(stderr string) bool {
 	trimmedStdErr := strings.TrimSpace(stderr)
 	for _, ignore := range ignoreStdErrList {
@@ -150,32 +298,39 @@ func ignoreError(stderr string) bool {
 	return trimmedStdErr == ""
 }
 
-// fetchInstallPackageFromURL downloads installation packages from specified URL.
-func fetchInstallPackageFromURL(mergedIOPS *v1alpha1.IstioOperatorSpec) error {
-	if util.IsHTTPURL(mergedIOPS.InstallPackagePath) {
-		pkgPath, err := fetchInstallPackage(mergedIOPS.InstallPackagePath)
-		if err != nil {
-			return err
-		}
-		// TODO: replace with more robust logic to set local file path
-		mergedIOPS.InstallPackagePath = filepath.Join(pkgPath, helm.ChartsFilePath)
+// fetchInstallPackageFromURL downloads installation packages from specified URL into the package cache selected by
+// pkgArgs and returns the cached package, or nil if the install package path is not a URL.
+func fetchInstallPackageFromURL(pkgArgs *packageCacheArgs,
+	mergedIOPS *v1alpha1.IstioOperatorSpec) (*helm.CachedPackage, error) {
+	if !helm.IsInstallPackageURL(mergedIOPS.InstallPackagePath) {
+		return nil, nil
 	}
-	return nil
+	pkg, err := fetchInstallPackage(pkgArgs, mergedIOPS.InstallPackagePath)
+	if err != nil {
+		return nil, err
+	}
+	mergedIOPS.InstallPackagePath = pkg.ChartsPath()
+	return pkg, nil
 }
 
-// fetchInstallPackage downloads installation packages from the given url.
-func fetchInstallPackage(url string) (string, error) {
-	uf, err := helm.NewURLFetcher(url, "")
-	if err != nil {
-		return "", err
+// fetchAddonCharts fetches the charts of the addon components of mergedIOPS that are URLs of chart archives or charts
+// in chart repositories into the package cache selected by pkgArgs, and sets their chart paths to the fetched chart
+// dirs.
+func fetchAddonCharts(pkgArgs *packageCacheArgs, mergedIOPS *v1alpha1.IstioOperatorSpec) error {
+	newFetcher := func(url string) (*helm.URLFetcher, error) {
+		return newURLFetcher(pkgArgs, url)
 	}
-	if err := uf.FetchBundles().ToError(); err != nil {
-		return "", err
+	for an, c := range mergedIOPS.AddonComponents {
+		if c == nil || c.Enabled == nil || !c.Enabled.Value || !helm.IsInstallPackageURL(c.ChartPath) {
+			continue
+		}
+		dir, err := helm.FetchChart(c.ChartPath, newFetcher)
+		if err != nil {
+			return fmt.Errorf("addon %s: %s", an, err)
+		}
+		c.ChartPath = dir
 	}
-	isp := path.Base(url)
-	// get rid of the suffix, installation package is untared to folder name istio-{version}, e.g. istio-1.3.0
-	idx := strings.LastIndex(isp, "-")
-	return filepath.Join(uf.DestDir(), isp[:idx]), nil
+	return nil
 }
 
 // MakeTreeFromSetList creates a YAML tree from a string slice containing key-value pairs in the format key=value.
@@ -199,9 +354,21 @@ func MakeTreeFromSetList(setOverlay []string, force bool, l *Logger) (string, er
 		if err != nil {
 			return "", err
 		}
+		// Only the path of kv is checked, so that each unknown field is reported once.
+		kvTree := make(map[string]interface{})
+		if err := tpath.WriteNode(kvTree, util.PathFromString(k), v); err != nil {
+			return "", err
+		}
+		kvYAML, err := yaml.Marshal(kvTree)
+		if err != nil {
+			return "", err
+		}
+		if errs := checkFields(string(kvYAML), nil, "", l); len(errs) != 0 {
+			return "", fmt.Errorf("bad path=value %s: %s", kv, errs)
+		}
 		iops := &v1alpha1.IstioOperatorSpec{}
 		if err := util.UnmarshalWithJSONPB(string(testTree), iops); err != nil {
-			return "", fmt.Errorf("bad path=value: %s", kv)
+			return "", fmt.Errorf("bad path=value %s: %s", kv, err)
 		}
 		if errs := validate.CheckIstioOperatorSpec(iops, true); len(errs) != 0 {
 			if !force {
@@ -38,6 +38,16 @@ type manifestGenerateArgs struct {
 	set []string
 	// force proceeds even if there are validation errors
 	force bool
+	// explain outputs the merged IstioOperatorSpec annotated with the source of each field instead of the manifest.
+	explain bool
+	// pkgCache selects the package cache that install packages are fetched into.
+	pkgCache packageCacheArgs
+	// policy selects the guardrail policy the installation must follow.
+	policy policyArgs
+	// strictOverlays fails the generation if overlays, overlay paths or K8S settings match nothing.
+	strictOverlays bool
+	// postRender selects the post-renderer that the manifest is transformed with.
+	postRender postRenderArgs
 }
 
 func addManifestGenerateFlags(cmd *cobra.Command, args *manifestGenerateArgs) {
@@ -45,6 +55,13 @@ func addManifestGenerateFlags(cmd *cobra.Command, args *manifestGenerateArgs) {
 	cmd.PersistentFlags().StringVarP(&args.outFilename, "output", "o", "", "Manifest output directory path")
 	cmd.PersistentFlags().StringSliceVarP(&args.set, "set", "s", nil, SetFlagHelpStr)
 	cmd.PersistentFlags().BoolVar(&args.force, "force", false, "Proceed even with validation errors")
+	cmd.PersistentFlags().BoolVar(&args.explain, "explain", false,
+		"Output the merged IstioOperatorSpec with each field annotated with the layer and the file and line or --set "+
+			"flag that set it, instead of the manifest")
+	cmd.PersistentFlags().BoolVar(&args.strictOverlays, "strict-overlays", false, strictOverlaysFlagHelpStr)
+	addPackageCacheFlags(cmd, &args.pkgCache)
+	addPolicyFlags(cmd, &args.policy)
+	addPostRenderFlags(cmd, &args.postRender)
 }
 
 func manifestGenerateCmd(rootArgs *rootArgs, mgArgs *manifestGenerateArgs) *cobra.Command {
@@ -70,14 +87,27 @@ func manifestGenerate(args *rootArgs, mgArgs *manifestGenerateArgs, l *Logger) e
 		return fmt.Errorf("could not configure logs: %s", err)
 	}
 
+	if mgArgs.explain {
+		y, err := explainIOPS(mgArgs.inFilename, "", mgArgs.set, "", mgArgs.force, l)
+		if err != nil {
+			return err
+		}
+		l.print(y + "\n")
+		return nil
+	}
+
 	overlayFromSet, err := MakeTreeFromSetList(mgArgs.set, mgArgs.force, l)
 	if err != nil {
 		return err
 	}
-	manifests, _, err := GenManifests(mgArgs.inFilename, overlayFromSet, mgArgs.force, l)
+	manifests, iops, err := GenManifests(mgArgs.inFilename, overlayFromSet, &mgArgs.pkgCache, &mgArgs.postRender, mgArgs.force,
+		mgArgs.strictOverlays, l)
 	if err != nil {
 		return err
 	}
+	if err := checkPolicy(&mgArgs.policy, mgArgs.inFilename, iops, manifests, l); err != nil {
+		return err
+	}
 
 	if mgArgs.outFilename == "" {
 		for _, m := range orderedManifests(manifests) {
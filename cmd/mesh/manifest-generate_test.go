@@ -15,14 +15,24 @@
 package mesh
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
 
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
 	"istio.io/operator/pkg/compare"
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/object"
+	"istio.io/operator/pkg/postrender"
 	"istio.io/operator/pkg/util"
 	"istio.io/pkg/version"
 )
@@ -117,9 +127,258 @@ func TestManifestGeneratePilot(t *testing.T) {
 			desc:       "pilot_override_kubernetes",
 			diffSelect: "Deployment:*:istio-pilot, Service:*:istio-pilot",
 		},
+		{
+			desc:       "pilot_global_overlays",
+			diffSelect: "Deployment:*:istio-pilot, Service:*:istio-pilot, ServiceAccount:*:*",
+		},
 	})
 }
 
+func TestManifestGenerateUnmatchedOverlays(t *testing.T) {
+	inPath := filepath.Join(repoRootDir, "cmd/mesh/testdata/manifest-generate/input/pilot_unmatched_overlays.yaml")
+	wantWarnings := []string{
+		"warning: component Pilot: overlay for Deployment:istio-system:istio-pilot-old does not match any object in output manifest",
+		"warning: component Pilot: Deployment:istio-system:istio-pilot: path spec.template.spec.containers.[name:pilot].args: " +
+			"element [name:pilot] not found",
+	}
+	got, err := runManifestGenerate(inPath, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, w := range wantWarnings {
+		if !strings.Contains(got, w) {
+			t.Errorf("got output without warning %q", w)
+		}
+	}
+
+	_, err = runManifestGenerate(inPath, "--strict-overlays")
+	if err == nil {
+		t.Fatal("got no error with --strict-overlays, want one")
+	}
+	for _, w := range wantWarnings {
+		if want := strings.Replace(w, "warning: ", "", 1); !strings.Contains(err.Error(), want) {
+			t.Errorf("got error %q, want it to contain %q", err, want)
+		}
+	}
+}
+
+func TestManifestGeneratePostRenderer(t *testing.T) {
+	inPath := filepath.Join(repoRootDir, "cmd/mesh/testdata/manifest-generate/input/pilot_default.yaml")
+	got, err := runManifestGenerate(inPath, `--post-renderer sed --post-renderer-arg s/name:\(.\)istio-pilot$/name:\1istiod/`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	objs, err := object.ParseK8sObjectsFromYAMLManifest(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotHashes := make(map[string]bool)
+	for _, o := range objs {
+		gotHashes[o.Hash()] = true
+		if _, ok := o.UnstructuredObject().GetAnnotations()[postrender.ComponentAnnotation]; ok {
+			t.Errorf("%s still has annotation %s", o.Hash(), postrender.ComponentAnnotation)
+		}
+	}
+	for h, want := range map[string]bool{"Deployment:istio-control:istiod": true, "Deployment:istio-control:istio-pilot": false} {
+		if gotHashes[h] != want {
+			t.Errorf("got object %s %v, want %v", h, gotHashes[h], want)
+		}
+	}
+
+	if _, err := runManifestGenerate(inPath, "--post-renderer false"); err == nil {
+		t.Error("got no error for a failing post-renderer, want one")
+	}
+}
+
+func TestManifestGenerateExtraManifests(t *testing.T) {
+	tmpDir := createTempDirOrFail(t, "extra-manifests")
+	defer removeDirOrFail(t, tmpDir)
+	extraFile := filepath.Join(tmpDir, "extra.yaml")
+	if err := ioutil.WriteFile(extraFile, []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: pilot-extra
+  namespace: istio-control
+data:
+  team: mesh
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	in, err := ioutil.ReadFile(filepath.Join(repoRootDir, "cmd/mesh/testdata/manifest-generate/input/pilot_default.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Base is disabled in the empty profile.
+	in = []byte(strings.Replace(string(in), "  components:\n", "  components:\n    base:\n      enabled: true\n", 1))
+	in = append(in, fmt.Sprintf(`
+  values:
+    global:
+      extraManifests:
+      - manifest: |
+          apiVersion: networking.istio.io/v1alpha3
+          kind: Gateway
+          metadata:
+            name: default-gateway
+            namespace: istio-control
+          spec:
+            selector:
+              istio: ingressgateway
+      - component: pilot
+        file: %s
+      - component: my-gateway
+        manifest: |
+          apiVersion: v1
+          kind: ConfigMap
+          metadata:
+            name: gateway-extra
+`, extraFile)...)
+	inPath := filepath.Join(tmpDir, "in.yaml")
+	if err := ioutil.WriteFile(inPath, in, 0644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := runManifestGenerate(inPath, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "warning: values.global.extraManifests[2]: no component named my-gateway"; !strings.Contains(got, want) {
+		t.Errorf("got output without warning %q", want)
+	}
+	objs, err := object.ParseK8sObjectsFromYAMLManifest(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotObjs := objs.ToMap()
+	for h, want := range map[string]bool{
+		"Gateway:istio-control:default-gateway": true,
+		"ConfigMap:istio-control:pilot-extra":   true,
+		"ConfigMap::gateway-extra":              false,
+	} {
+		o := gotObjs[h]
+		if (o != nil) != want {
+			t.Errorf("got object %s %v, want %v", h, o != nil, want)
+			continue
+		}
+		if o != nil && o.UnstructuredObject().GetLabels()[name.ExtraManifestLabel] != "true" {
+			t.Errorf("got object %s without label %s", h, name.ExtraManifestLabel)
+		}
+	}
+	if l := gotObjs["Deployment:istio-control:istio-pilot"].UnstructuredObject().GetLabels(); l[name.ExtraManifestLabel] != "" {
+		t.Errorf("got rendered object with label %s", name.ExtraManifestLabel)
+	}
+}
+
+func TestManifestGenerateAddonCharts(t *testing.T) {
+	tmpDir := createTempDirOrFail(t, "addon-charts")
+	defer removeDirOrFail(t, tmpDir)
+	chartDir := filepath.Join(tmpDir, "charts", "greeter")
+	if err := os.MkdirAll(filepath.Join(chartDir, "templates"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for f, y := range map[string]string{
+		"Chart.yaml":  "apiVersion: v1\nname: greeter\nversion: 1.0.0\n",
+		"values.yaml": "name: greeter\ngreeting: hello\n",
+		"templates/configmap.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Values.name }}
+  namespace: {{ .Release.Namespace }}
+data:
+  greeting: {{ .Values.greeting }}
+`,
+	} {
+		if err := ioutil.WriteFile(filepath.Join(chartDir, f), []byte(y), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	archive := filepath.Join(tmpDir, "greeter-1.0.0.tgz")
+	if out, err := exec.Command("tar", "-czf", archive, "-C", filepath.Dir(chartDir), "greeter").CombinedOutput(); err != nil {
+		t.Fatalf("tar: %s: %s", err, out)
+	}
+	data, err := ioutil.ReadFile(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest := sha256.Sum256(data)
+	if err := ioutil.WriteFile(filepath.Join(tmpDir, "index.yaml"), []byte(fmt.Sprintf(`apiVersion: v1
+entries:
+  greeter:
+  - name: greeter
+    version: 1.0.0
+    digest: %s
+    urls:
+    - greeter-1.0.0.tgz
+`, hex.EncodeToString(digest[:]))), 0644); err != nil {
+		t.Fatal(err)
+	}
+	repo := httptest.NewServer(http.FileServer(http.Dir(tmpDir)))
+	defer repo.Close()
+	in, err := ioutil.ReadFile(filepath.Join(repoRootDir, "cmd/mesh/testdata/manifest-generate/input/pilot_default.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	in = append(in, fmt.Sprintf(`
+  addonComponents:
+    greeter:
+      enabled: true
+      namespace: istio-control
+      chartPath: %s
+    packaged-greeter:
+      enabled: true
+      namespace: istio-control
+      chartPath: %s
+      spec:
+        installAfter: pilot
+        values:
+          name: packaged-greeter
+          greeting: hi
+    repo-greeter:
+      enabled: true
+      namespace: istio-control
+      chartPath: %s#greeter@1.0.0
+      spec:
+        values:
+          name: repo-greeter
+          greeting: hey
+`, chartDir, archive, repo.URL)...)
+	inPath := filepath.Join(tmpDir, "in.yaml")
+	if err := ioutil.WriteFile(inPath, in, 0644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := runManifestGenerate(inPath, "--insecure-skip-verify --package-cache-dir "+filepath.Join(tmpDir, "cache"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	objs, err := object.ParseK8sObjectsFromYAMLManifest(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotObjs := objs.ToMap()
+	for h, want := range map[string]string{
+		"ConfigMap:istio-control:greeter":          "hello",
+		"ConfigMap:istio-control:packaged-greeter": "hi",
+		"ConfigMap:istio-control:repo-greeter":     "hey",
+	} {
+		o := gotObjs[h]
+		if o == nil {
+			t.Errorf("got no object %s", h)
+			continue
+		}
+		if g, _, _ := unstructured.NestedString(o.UnstructuredObject().Object, "data", "greeting"); g != want {
+			t.Errorf("%s: got greeting %q, want %q", h, g, want)
+		}
+	}
+
+	bad := strings.Replace(string(in), "installAfter: pilot", "installAfter: greeter", 1)
+	if err := ioutil.WriteFile(inPath, []byte(bad), 0644); err != nil {
+		t.Fatal(err)
+	}
+	_, err = runManifestGenerate(inPath, "--insecure-skip-verify --package-cache-dir "+filepath.Join(tmpDir, "cache"))
+	if want := "bad addonComponents.packaged-greeter.spec.installAfter: greeter is not a core component"; err == nil ||
+		!strings.Contains(err.Error(), want) {
+		t.Errorf("got error %v, want one containing %q", err, want)
+	}
+}
+
 func TestManifestGenerateTelemetry(t *testing.T) {
 	runTestGroup(t, testGroup{
 		{
@@ -177,7 +436,7 @@ func TestLDFlags(t *testing.T) {
 	version.DockerInfo.Hub = "testHub"
 	version.DockerInfo.Tag = "testTag"
 	l := NewLogger(true, os.Stdout, os.Stderr)
-	_, iops, err := genIOPS("", "default", "", "", true, l)
+	_, iops, err := genIOPS("", "default", "", "", nil, true, l)
 	if err != nil {
 		t.Fatal(err)
 	}
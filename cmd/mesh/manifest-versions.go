@@ -15,8 +15,12 @@
 package mesh
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"os"
+	"strings"
+	"time"
 
 	goversion "github.com/hashicorp/go-version"
 	"github.com/spf13/cobra"
@@ -31,16 +35,32 @@ import (
 
 const (
 	versionsMapURL = "https://raw.githubusercontent.com/istio/operator/master/data/versions.yaml"
+
+	// textOutput and jsonOutput are the supported values of the versions --output flag.
+	textOutput = "text"
+	jsonOutput = "json"
 )
 
 type manifestVersionsArgs struct {
 	// versionsURI is a URI pointing to a YAML formatted versions mapping.
 	versionsURI string
+	// output is the output format, either text or json.
+	output string
+}
+
+// versionsOutput is the JSON formatted output of the versions command.
+type versionsOutput struct {
+	OperatorVersion string                        `json:"operatorVersion"`
+	Compatibility   *version.CompatibilityMapping `json:"compatibility"`
+	// Channels maps each release channel to the newest operator version published in it.
+	Channels map[string]string `json:"channels,omitempty"`
 }
 
 func addManifestVersionsFlags(cmd *cobra.Command, mvArgs *manifestVersionsArgs) {
 	cmd.PersistentFlags().StringVarP(&mvArgs.versionsURI, "versionsURI", "u",
 		versionsMapURL, "URI for operator versions to Istio versions map")
+	cmd.PersistentFlags().StringVarP(&mvArgs.output, "output", "o",
+		textOutput, "Output format, one of text|json")
 }
 
 func manifestVersionsCmd(rootArgs *rootArgs, versionsArgs *manifestVersionsArgs) *cobra.Command {
@@ -64,10 +84,36 @@ func manifestVersionsCmd(rootArgs *rootArgs, versionsArgs *manifestVersionsArgs)
 func manifestVersions(args *rootArgs, mvArgs *manifestVersionsArgs, l *Logger) error {
 	initLogsOrExit(args)
 
-	myVersionMap, err := getVersionCompatibleMap(mvArgs.versionsURI, binversion.OperatorBinaryGoVersion, l)
+	if mvArgs.output != textOutput && mvArgs.output != jsonOutput {
+		return fmt.Errorf("unknown output format %s, must be one of %s|%s", mvArgs.output, textOutput, jsonOutput)
+	}
+
+	versions, err := getAllVersionMappings(mvArgs.versionsURI, l)
 	if err != nil {
 		return fmt.Errorf("failed to retrieve version map, error: %v", err)
 	}
+	myVersionMap, err := findVersionCompatibleMap(versions, binversion.OperatorBinaryGoVersion)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve version map, error: %v", err)
+	}
+	now := time.Now()
+	channels := make(map[string]string)
+	for _, c := range version.Channels {
+		if v := version.NewestInChannel(versions, c, now); v != nil {
+			channels[c] = v.OperatorVersion.String()
+		}
+	}
+
+	if mvArgs.output == jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		return enc.Encode(&versionsOutput{
+			OperatorVersion: binversion.OperatorBinaryGoVersion.String(),
+			Compatibility:   myVersionMap,
+			Channels:        channels,
+		})
+	}
 
 	fmt.Print("\nOperator version is ", binversion.OperatorBinaryGoVersion.String(), ".\n\n")
 	fmt.Println("The following installation package versions are recommended for use with this version of the operator:")
@@ -78,6 +124,31 @@ func manifestVersions(args *rootArgs, mvArgs *manifestVersionsArgs, l *Logger) e
 	for _, v := range myVersionMap.SupportedIstioVersions {
 		fmt.Printf("  %s\n", v.String())
 	}
+	if myVersionMap.K8sVersions != nil {
+		fmt.Printf("\nSupported Kubernetes versions: %s\n", myVersionMap.K8sVersions.String())
+	}
+	if len(myVersionMap.Channels) != 0 {
+		fmt.Printf("Release channels: %s\n", strings.Join(myVersionMap.Channels, ", "))
+	}
+	if !myVersionMap.DeprecationDate.IsZero() {
+		fmt.Printf("Deprecated on: %s\n", myVersionMap.DeprecationDate.Format(version.DateLayout))
+	}
+	if !myVersionMap.EOLDate.IsZero() {
+		fmt.Printf("End of life on: %s\n", myVersionMap.EOLDate.Format(version.DateLayout))
+	}
+	if myVersionMap.IsEOL(now) {
+		fmt.Println("Warning: this version of the operator has reached its end of life.")
+	} else if myVersionMap.IsDeprecated(now) {
+		fmt.Println("Warning: this version of the operator is deprecated.")
+	}
+	if len(channels) != 0 {
+		fmt.Println("\nThe newest versions in each release channel are:")
+		for _, c := range version.Channels {
+			if v, ok := channels[c]; ok {
+				fmt.Printf("  %-8s %s\n", c, v)
+			}
+		}
+	}
 	fmt.Println()
 
 	return nil
@@ -85,10 +156,16 @@ func manifestVersions(args *rootArgs, mvArgs *manifestVersionsArgs, l *Logger) e
 
 func getVersionCompatibleMap(versionsURI string, binVersion *goversion.Version,
 	l *Logger) (*version.CompatibilityMapping, error) {
-	var b []byte
-	var err error
+	versions, err := getAllVersionMappings(versionsURI, l)
+	if err != nil {
+		return nil, err
+	}
+	return findVersionCompatibleMap(versions, binVersion)
+}
 
-	b, err = loadCompatibleMapFile(versionsURI, l)
+// getAllVersionMappings reads and parses every entry of the versions map at versionsURI.
+func getAllVersionMappings(versionsURI string, l *Logger) ([]*version.CompatibilityMapping, error) {
+	b, err := loadCompatibleMapFile(versionsURI, l)
 	if err != nil {
 		return nil, err
 	}
@@ -97,7 +174,13 @@ func getVersionCompatibleMap(versionsURI string, binVersion *goversion.Version,
 	if err = yaml.Unmarshal(b, &versions); err != nil {
 		return nil, err
 	}
+	return versions, nil
+}
 
+// findVersionCompatibleMap returns the entry of versions for binVersion, falling back to a matching operator
+// version range or the closest lower operator version.
+func findVersionCompatibleMap(versions []*version.CompatibilityMapping,
+	binVersion *goversion.Version) (*version.CompatibilityMapping, error) {
 	var myVersionMap, closestVersionMap *version.CompatibilityMapping
 	for _, v := range versions {
 		if v.OperatorVersion.Equal(binVersion) {
@@ -196,3 +196,37 @@ func errToString(err error) string {
 	}
 	return err.Error()
 }
+
+func TestGetChannelVersion(t *testing.T) {
+	operatorVersionsFilePath := "../../data/versions.yaml"
+	l := NewLogger(true, os.Stdout, os.Stderr)
+	tests := []struct {
+		channel string
+		want    string
+		wantErr string
+	}{
+		{
+			channel: "stable",
+			want:    "1.4.3",
+		},
+		{
+			channel: "rapid",
+			want:    "1.5.0",
+		},
+		{
+			channel: "beta",
+			wantErr: "unknown channel beta, must be one of stable|regular|rapid",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.channel, func(t *testing.T) {
+			got, gotErr := getChannelVersion(tt.channel, operatorVersionsFilePath, l)
+			if got != tt.want {
+				t.Errorf("got: %v, want: %v", got, tt.want)
+			}
+			if errToString(gotErr) != tt.wantErr {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
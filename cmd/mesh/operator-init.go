@@ -122,6 +122,10 @@ func operatorInit(args *rootArgs, oiArgs *operatorInitArgs, l *Logger, apply man
 	if err != nil {
 		l.logAndFatal(err)
 	}
+	// The API server validates IstioOperator CRs with the schema generated from the proto definitions.
+	if mstr, err = addCRDSchema(mstr); err != nil {
+		l.logAndFatal(err)
+	}
 
 	log.Infof("Using the following manifest to install operator:\n%s\n", mstr)
 
@@ -25,8 +25,8 @@ import (
 
 	"github.com/kr/pretty"
 
+	"istio.io/operator/pkg/compare"
 	"istio.io/operator/pkg/kubectlcmd"
-	"istio.io/operator/pkg/util"
 )
 
 // applyParams is used to capture the inputs to operatorInit applyManifest call.
@@ -71,7 +71,12 @@ func TestOperatorInit(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if diff := util.YAMLDiff(wantYAML, gotYAML); diff != "" {
+	// The manifests hold several resources, so compare them object by object rather than as a single YAML document.
+	diff, err := compare.ManifestDiff(wantYAML, gotYAML, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != "" {
 		t.Fatalf("diff: %s", diff)
 	}
 
@@ -145,7 +150,11 @@ func TestOperatorRemove(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if diff := util.YAMLDiff(wantYAML, gotYAML); diff != "" {
+	diff, err := compare.ManifestDiff(wantYAML, gotYAML, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != "" {
 		t.Fatalf("diff: %s", diff)
 	}
 }
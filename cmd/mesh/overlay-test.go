@@ -0,0 +1,139 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"istio.io/operator/pkg/compare"
+	"istio.io/operator/pkg/component/controlplane"
+	"istio.io/operator/pkg/object"
+	"istio.io/operator/pkg/translate"
+	"istio.io/operator/version"
+)
+
+type overlayTestArgs struct {
+	// inFilename is the path to the input IstioOperator CR.
+	inFilename string
+	// set is a string with element format "path=value" where path is an IstioOperator path and the value is a
+	// value to set the node at that path to.
+	set []string
+	// component is the name of the component to render.
+	component string
+	// force proceeds even if there are validation errors
+	force bool
+	// pkgCache selects the package cache that install packages are fetched into.
+	pkgCache packageCacheArgs
+}
+
+func addOverlayTestFlags(cmd *cobra.Command, args *overlayTestArgs) {
+	cmd.PersistentFlags().StringVarP(&args.inFilename, "filename", "f", "", filenameFlagHelpStr)
+	cmd.PersistentFlags().StringSliceVarP(&args.set, "set", "s", nil, SetFlagHelpStr)
+	cmd.PersistentFlags().StringVarP(&args.component, "component", "c", "",
+		"Component to render: a core component name such as Pilot, or the name of a gateway or addon component")
+	cmd.PersistentFlags().BoolVar(&args.force, "force", false, "Proceed even with validation errors")
+	addPackageCacheFlags(cmd, &args.pkgCache)
+}
+
+func overlayTestCmd(rootArgs *rootArgs, otArgs *overlayTestArgs) *cobra.Command {
+	return &cobra.Command{
+		Use:   "test",
+		Short: "Shows how the K8S settings and overlays of a component change its manifest",
+		Long: "The test subcommand renders the manifest of one component step by step: the output of its chart, then " +
+			"after its K8S settings, then after each of its overlays and of the global overlays. It lists the objects " +
+			"of the chart, and outputs a unified diff of the objects that each following step changes.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if otArgs.component == "" {
+				return fmt.Errorf("a component must be given with --component")
+			}
+			l := NewLogger(rootArgs.logToStdErr, cmd.OutOrStdout(), cmd.ErrOrStderr())
+			return overlayTest(rootArgs, otArgs, l)
+		}}
+}
+
+// overlayTest renders the component selected by otArgs step by step, and prints the changes of each step.
+func overlayTest(args *rootArgs, otArgs *overlayTestArgs, l *Logger) error {
+	if err := configLogs(args.logToStdErr); err != nil {
+		return fmt.Errorf("could not configure logs: %s", err)
+	}
+
+	overlayFromSet, err := MakeTreeFromSetList(otArgs.set, otArgs.force, l)
+	if err != nil {
+		return err
+	}
+	mergedYAML, err := genProfile(false, otArgs.inFilename, "", overlayFromSet, "", otArgs.force, l)
+	if err != nil {
+		return err
+	}
+	mergedIOPS, err := unmarshalAndValidateIOPS(mergedYAML, otArgs.force, l)
+	if err != nil {
+		return err
+	}
+	t, err := translate.NewTranslator(version.OperatorBinaryVersion.MinorVersion)
+	if err != nil {
+		return err
+	}
+	if _, err := fetchInstallPackageFromURL(&otArgs.pkgCache, mergedIOPS); err != nil {
+		return err
+	}
+	if err := fetchAddonCharts(&otArgs.pkgCache, mergedIOPS); err != nil {
+		return err
+	}
+	cp, err := controlplane.NewIstioOperator(mergedIOPS, t)
+	if err != nil {
+		return err
+	}
+	cp.SetReadLocalFiles(true)
+	c, err := cp.Component(otArgs.component)
+	if err != nil {
+		return err
+	}
+	if err := c.Run(); err != nil {
+		return fmt.Errorf("failed to start component %s: %s", otArgs.component, err)
+	}
+	steps, err := c.RenderSteps()
+	if err != nil {
+		return err
+	}
+
+	objs, err := object.ParseK8sObjectsFromYAMLManifest(steps[0].Manifest)
+	if err != nil {
+		return err
+	}
+	l.print(fmt.Sprintf("# Step 0: %s\n", steps[0].Name))
+	for _, o := range objs {
+		l.print(fmt.Sprintf("#   %s\n", o.Hash()))
+	}
+	for i := 1; i < len(steps); i++ {
+		l.print(fmt.Sprintf("\n# Step %d: %s\n", i, steps[i].Name))
+		for _, w := range steps[i].Warnings {
+			l.print(fmt.Sprintf("# warning: %s\n", w))
+		}
+		diff, err := compare.ManifestUnifiedDiff(steps[i-1].Manifest, steps[i].Manifest,
+			fmt.Sprintf("step%d", i-1), fmt.Sprintf("step%d", i))
+		if err != nil {
+			return err
+		}
+		if strings.TrimSpace(diff) == "" {
+			diff = "# No changes\n"
+		}
+		l.print(diff)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestOverlayTest(t *testing.T) {
+	testDataDir = filepath.Join(repoRootDir, "cmd/mesh/testdata/overlay-test")
+	inPath := filepath.Join(testDataDir, "input", "pilot.yaml")
+	outPath := filepath.Join(testDataDir, "output", "pilot.diff")
+
+	got, err := runCommand("overlay test -c pilot -f " + inPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if refreshGoldenFiles() {
+		t.Logf("Refreshing golden file for %s", outPath)
+		if err := ioutil.WriteFile(outPath, []byte(got), 0644); err != nil {
+			t.Error(err)
+		}
+	}
+
+	want, err := readFile(outPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("got:\n%s\n\nwant:\n%s", got, want)
+	}
+}
+
+func TestOverlayTestErrors(t *testing.T) {
+	inPath := filepath.Join(repoRootDir, "cmd/mesh/testdata/overlay-test/input/pilot.yaml")
+	tests := []struct {
+		desc    string
+		command string
+		wantErr string
+	}{
+		{
+			desc:    "no component",
+			command: "overlay test -f " + inPath,
+			wantErr: "a component must be given with --component",
+		},
+		{
+			desc:    "unknown component",
+			command: "overlay test -c istio-foo -f " + inPath,
+			wantErr: "no component named istio-foo, the components are: Base, Pilot",
+		},
+		{
+			desc:    "disabled component",
+			command: "overlay test -c policy -f " + inPath,
+			wantErr: "component Policy is not enabled",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := runCommand(tt.command)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("got error %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// OverlayCmd is a group of commands related to the K8S settings and overlays of components.
+func OverlayCmd() *cobra.Command {
+	oc := &cobra.Command{
+		Use:   "overlay",
+		Short: "Commands related to the K8S settings and overlays of components",
+		Long:  "The overlay subcommand helps to write the K8S settings and overlays of the components of an IstioOperator CR.",
+	}
+
+	otArgs := &overlayTestArgs{}
+	args := &rootArgs{}
+
+	otc := overlayTestCmd(args, otArgs)
+
+	addFlags(oc, args)
+	addFlags(otc, args)
+
+	addOverlayTestFlags(otc, otArgs)
+
+	oc.AddCommand(otc)
+
+	return oc
+}
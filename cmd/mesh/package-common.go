@@ -0,0 +1,90 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"istio.io/operator/pkg/helm"
+)
+
+type packageCacheArgs struct {
+	// cacheDir is the root directory of the install package cache.
+	cacheDir string
+	// offline means install packages are only read from the package cache and never downloaded.
+	offline bool
+	// keyring is the path to the keyring that install package signatures are verified against.
+	keyring string
+	// insecureSkipVerify means install packages are used without verifying their signature.
+	insecureSkipVerify bool
+}
+
+func addPackageCacheFlags(cmd *cobra.Command, args *packageCacheArgs) {
+	cmd.PersistentFlags().StringVar(&args.cacheDir, "package-cache-dir", "",
+		"Root directory of the install package cache. Defaults to $"+helm.PackageCacheDirEnvVar+
+			" if set, or a directory under the system temp dir")
+	cmd.PersistentFlags().BoolVar(&args.offline, "offline", false,
+		"Only use install packages from the package cache and fail instead of downloading them")
+	cmd.PersistentFlags().StringVar(&args.keyring, "keyring", os.Getenv(helm.KeyringEnvVar),
+		"Path to a file or directory with the ed25519 (PEM) or PGP public keys that install package signatures are "+
+			"verified against. Defaults to $"+helm.KeyringEnvVar)
+	cmd.PersistentFlags().BoolVar(&args.insecureSkipVerify, "insecure-skip-verify", false,
+		"Use install packages without verifying their signature")
+}
+
+// loadKeyring returns the keyring selected by the package cache flags in args, or nil if none is set.
+func loadKeyring(args *packageCacheArgs) (*helm.Keyring, error) {
+	if args.keyring == "" {
+		return nil, nil
+	}
+	return helm.LoadKeyring(args.keyring)
+}
+
+// newPackageCache returns the install package cache selected by the package cache flags in args.
+func newPackageCache(args *packageCacheArgs) (*helm.PackageCache, error) {
+	return helm.NewPackageCache(args.cacheDir)
+}
+
+// newURLFetcher returns a fetcher for the install package at url that uses the package cache selected by the
+// package cache flags in args.
+func newURLFetcher(args *packageCacheArgs, url string) (*helm.URLFetcher, error) {
+	uf, err := helm.NewURLFetcher(url, args.cacheDir)
+	if err != nil {
+		return nil, err
+	}
+	keyring, err := loadKeyring(args)
+	if err != nil {
+		return nil, err
+	}
+	uf.SetOffline(args.offline)
+	uf.SetKeyring(keyring)
+	uf.SetInsecureSkipVerify(args.insecureSkipVerify)
+	return uf, nil
+}
+
+// fetchInstallPackage downloads installation packages from the given url into the package cache selected by args and
+// returns the cached package.
+func fetchInstallPackage(args *packageCacheArgs, url string) (*helm.CachedPackage, error) {
+	uf, err := newURLFetcher(args, url)
+	if err != nil {
+		return nil, err
+	}
+	if err := uf.FetchBundles().ToError(); err != nil {
+		return nil, err
+	}
+	return uf.Package(), nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"istio.io/operator/pkg/helm"
+)
+
+type packageImportArgs struct {
+	// shaFile is the path to the SHA file to verify the tarball against.
+	shaFile string
+	// signatureFile is the path to the detached signature of the tarball.
+	signatureFile string
+}
+
+func addPackageImportFlags(cmd *cobra.Command, args *packageImportArgs) {
+	cmd.PersistentFlags().StringVar(&args.shaFile, "sha256", "",
+		"Path to the SHA file to verify the tarball against. Defaults to <tarball>"+helm.SHAFileSuffix+" if it exists")
+	cmd.PersistentFlags().StringVar(&args.signatureFile, "signature", "",
+		"Path to the detached signature of the tarball. Defaults to <tarball>"+helm.SignatureFileSuffix)
+}
+
+func packageImportCmd(rootArgs *rootArgs, pcArgs *packageCacheArgs, piArgs *packageImportArgs) *cobra.Command {
+	return &cobra.Command{
+		Use:   "import <tarball>",
+		Short: "Imports a local install package tarball into the package cache",
+		Long: "The import subcommand copies a local install package tarball into the package cache, so that it can be " +
+			"used in clusters without network access.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			l := NewLogger(rootArgs.logToStdErr, cmd.OutOrStdout(), cmd.ErrOrStderr())
+			return packageImport(rootArgs, pcArgs, piArgs, args[0], l)
+		}}
+}
+
+// packageImport copies the tarball at path into the package cache.
+func packageImport(args *rootArgs, pcArgs *packageCacheArgs, piArgs *packageImportArgs, path string, l *Logger) error {
+	initLogsOrExit(args)
+
+	shaF := piArgs.shaFile
+	if shaF == "" {
+		if _, err := os.Stat(path + helm.SHAFileSuffix); err == nil {
+			shaF = path + helm.SHAFileSuffix
+		} else {
+			l.logAndPrintf("Warning: no SHA file found for %s, importing without verification.", path)
+		}
+	}
+	sigF, signer, err := verifyImportSignature(pcArgs, path, piArgs.signatureFile)
+	if err != nil {
+		return err
+	}
+	c, err := newPackageCache(pcArgs)
+	if err != nil {
+		return err
+	}
+	pkg, err := c.Import(path, shaF)
+	if err != nil {
+		return err
+	}
+	if sigF != "" {
+		if err := c.SetSignature(pkg, sigF, signer); err != nil {
+			return err
+		}
+		l.logAndPrintf("Verified signature of %s by %s", pkg.Name, signer)
+	}
+	l.logAndPrintf("Imported %s (sha256:%s) into %s", pkg.Name, pkg.Digest, pkg.Dir)
+	return nil
+}
+
+// verifyImportSignature verifies the detached signature of the tarball at path against the keyring selected by
+// pcArgs and returns the signature path and signer identity, which are empty if verification is skipped.
+func verifyImportSignature(pcArgs *packageCacheArgs, path, sigF string) (string, string, error) {
+	if pcArgs.insecureSkipVerify {
+		return "", "", nil
+	}
+	keyring, err := loadKeyring(pcArgs)
+	if err != nil {
+		return "", "", err
+	}
+	if keyring == nil {
+		return "", "", fmt.Errorf("refusing to import %s: no keyring is configured to verify its signature, "+
+			"set --keyring or skip verification with --insecure-skip-verify", path)
+	}
+	if sigF == "" {
+		sigF = path + helm.SignatureFileSuffix
+	}
+	signer, err := keyring.VerifyFile(path, sigF)
+	if err != nil {
+		return "", "", err
+	}
+	return sigF, signer, nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+func packageListCmd(rootArgs *rootArgs, pcArgs *packageCacheArgs) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "Lists the install packages in the package cache",
+		Long:  "The list subcommand lists the install packages in the package cache.",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return packageList(rootArgs, pcArgs, cmd)
+		}}
+}
+
+// packageList lists all the install packages in the package cache.
+func packageList(args *rootArgs, pcArgs *packageCacheArgs, cmd *cobra.Command) error {
+	initLogsOrExit(args)
+
+	c, err := newPackageCache(pcArgs)
+	if err != nil {
+		return err
+	}
+	pkgs, err := c.List()
+	if err != nil {
+		return err
+	}
+	if len(pkgs) == 0 {
+		cmd.Printf("No packages in the package cache at %s.\n", c.Root())
+		return nil
+	}
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tDIGEST\tADDED\tSIGNER\tSOURCE")
+	for _, p := range pkgs {
+		source := p.URL
+		if source == "" {
+			source = "imported"
+		}
+		signer := p.Signer
+		if signer == "" {
+			signer = "unverified"
+		}
+		fmt.Fprintf(w, "%s\tsha256:%.12s\t%s\t%s\t%s\n", p.Name, p.Digest, p.Added.Format("2006-01-02 15:04:05"),
+			signer, source)
+	}
+	return w.Flush()
+}
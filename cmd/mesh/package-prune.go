@@ -0,0 +1,70 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"github.com/spf13/cobra"
+)
+
+type packagePruneArgs struct {
+	// all removes every package from the cache.
+	all bool
+}
+
+func addPackagePruneFlags(cmd *cobra.Command, args *packagePruneArgs) {
+	cmd.PersistentFlags().BoolVar(&args.all, "all", false, "Remove all packages from the package cache")
+}
+
+func packagePruneCmd(rootArgs *rootArgs, pcArgs *packageCacheArgs, ppArgs *packagePruneArgs) *cobra.Command {
+	return &cobra.Command{
+		Use:   "prune",
+		Short: "Removes stale install packages from the package cache",
+		Long: "The prune subcommand removes install packages that fail verification or have been replaced by a newer " +
+			"package with the same name from the package cache.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			l := NewLogger(rootArgs.logToStdErr, cmd.OutOrStdout(), cmd.ErrOrStderr())
+			return packagePrune(rootArgs, pcArgs, ppArgs, l)
+		}}
+}
+
+// packagePrune removes stale packages, or all packages, from the package cache. In dry run mode, it only lists them.
+func packagePrune(args *rootArgs, pcArgs *packageCacheArgs, ppArgs *packagePruneArgs, l *Logger) error {
+	initLogsOrExit(args)
+
+	c, err := newPackageCache(pcArgs)
+	if err != nil {
+		return err
+	}
+	verb := "Removed"
+	if args.dryRun {
+		verb = "Would remove"
+	}
+	res, err := c.Prune(ppArgs.all, args.dryRun)
+	if res != nil {
+		for _, p := range res.Packages {
+			l.logAndPrintf("%s %s (sha256:%s)", verb, p.Name, p.Digest)
+		}
+		for _, p := range res.Leftovers {
+			l.logAndPrintf("%s incomplete or leftover %s", verb, p)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	l.logAndPrintf("%s %d packages and %d leftovers from the package cache at %s.", verb, len(res.Packages),
+		len(res.Leftovers), c.Root())
+	return nil
+}
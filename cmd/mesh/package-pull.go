@@ -0,0 +1,58 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"github.com/spf13/cobra"
+
+	"istio.io/operator/pkg/helm"
+	"istio.io/operator/pkg/version"
+)
+
+func packagePullCmd(rootArgs *rootArgs, pcArgs *packageCacheArgs) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pull <version|url>",
+		Short: "Downloads an install package into the package cache",
+		Long: "The pull subcommand downloads and verifies the install package for an Istio version, or at a URL, " +
+			"and stores it in the package cache.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			l := NewLogger(rootArgs.logToStdErr, cmd.OutOrStdout(), cmd.ErrOrStderr())
+			return packagePull(rootArgs, pcArgs, args[0], l)
+		}}
+}
+
+// packagePull downloads the install package for the given version or URL into the package cache.
+func packagePull(args *rootArgs, pcArgs *packageCacheArgs, ref string, l *Logger) error {
+	initLogsOrExit(args)
+
+	url := ref
+	if version.IsVersionString(ref) {
+		url = helm.InstallURLFromVersion(ref)
+	}
+	uf, err := newURLFetcher(pcArgs, url)
+	if err != nil {
+		return err
+	}
+	if err := uf.FetchBundles().ToError(); err != nil {
+		return err
+	}
+	pkg := uf.Package()
+	if pkg.Signer != "" {
+		l.logAndPrintf("Verified signature of %s by %s", pkg.Name, pkg.Signer)
+	}
+	l.logAndPrintf("Pulled %s (sha256:%s) into %s", pkg.Name, pkg.Digest, pkg.Dir)
+	return nil
+}
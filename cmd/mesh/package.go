@@ -0,0 +1,57 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// PackageCmd is a group of commands related to the local install package cache.
+func PackageCmd() *cobra.Command {
+	pc := &cobra.Command{
+		Use:   "package",
+		Short: "Commands related to the install package cache",
+		Long: "The package subcommand pulls, lists, prunes or imports Istio install packages in the local package cache. " +
+			"Packages in the cache can be used without network access by passing --offline to other commands.",
+	}
+
+	pcArgs := &packageCacheArgs{}
+	ppArgs := &packagePruneArgs{}
+	piArgs := &packageImportArgs{}
+	args := &rootArgs{}
+
+	ppc := packagePullCmd(args, pcArgs)
+	plc := packageListCmd(args, pcArgs)
+	pprc := packagePruneCmd(args, pcArgs, ppArgs)
+	pic := packageImportCmd(args, pcArgs, piArgs)
+
+	addFlags(pc, args)
+	addFlags(ppc, args)
+	addFlags(plc, args)
+	addFlags(pprc, args)
+	addFlags(pic, args)
+
+	addPackageCacheFlags(pc, pcArgs)
+
+	addPackagePruneFlags(pprc, ppArgs)
+	addPackageImportFlags(pic, piArgs)
+
+	pc.AddCommand(ppc)
+	pc.AddCommand(plc)
+	pc.AddCommand(pprc)
+	pc.AddCommand(pic)
+
+	return pc
+}
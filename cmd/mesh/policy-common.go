@@ -0,0 +1,108 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+
+	"istio.io/api/operator/v1alpha1"
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/object"
+	"istio.io/operator/pkg/policy"
+	"istio.io/operator/pkg/validate"
+)
+
+type policyArgs struct {
+	// policyFile is the path to the guardrail policy that the installation must follow.
+	policyFile string
+	// exempt are the policy violations that are allowed, see policy.ParseExemptions.
+	exempt []string
+}
+
+func addPolicyFlags(cmd *cobra.Command, args *policyArgs) {
+	cmd.PersistentFlags().StringVar(&args.policyFile, "policy", "",
+		"Path to a guardrail policy file that the IstioOperatorSpec and the generated manifest must follow")
+	cmd.PersistentFlags().StringSliceVar(&args.exempt, "policy-exempt", nil,
+		"Allow the violations of a policy rule, given as rule-id, or of a rule by one object, given as "+
+			"rule-id=Kind:namespace:name. May be repeated. Exemptions are also read from the "+
+			policy.ExemptAnnotationKey+" annotation of the IstioOperator CR")
+}
+
+// checkPolicy reports all the violations of the policy selected by args by the merged IstioOperatorSpec iops and the
+// rendered manifests, and returns an error if there is a violation of an error rule that is not exempted by args or by
+// the IstioOperator CR in inFilename. Unlike validation errors, violations cannot be ignored with --force.
+func checkPolicy(args *policyArgs, inFilename string, iops *v1alpha1.IstioOperatorSpec, manifests name.ManifestMap,
+	l *Logger) error {
+	if args == nil || args.policyFile == "" {
+		return nil
+	}
+	p, err := policy.Load(args.policyFile)
+	if err != nil {
+		return err
+	}
+	exempt, err := policy.ParseExemptions(args.exempt)
+	if err != nil {
+		return err
+	}
+	if inFilename != "" {
+		crExempt, err := exemptionsFromCR(inFilename)
+		if err != nil {
+			return err
+		}
+		exempt.Merge(crExempt)
+	}
+	var objs object.K8sObjects
+	for _, ms := range manifests {
+		for _, m := range ms {
+			mo, err := object.ParseK8sObjectsFromYAMLManifest(m)
+			if err != nil {
+				return err
+			}
+			objs = append(objs, mo...)
+		}
+	}
+	ds, err := p.Check(iops, objs, exempt)
+	if err != nil {
+		return err
+	}
+	for _, d := range ds {
+		l.logAndError(d.String())
+	}
+	if n := ds.Count(validate.SeverityError); n != 0 {
+		return fmt.Errorf("%d violations of policy %s, allow them with --policy-exempt if they are intended", n, args.policyFile)
+	}
+	return nil
+}
+
+// exemptionsFromCR returns the policy exemptions in the annotations of the IstioOperator CR in inFilename.
+func exemptionsFromCR(inFilename string) (policy.Exemptions, error) {
+	b, err := ioutil.ReadFile(inFilename)
+	if err != nil {
+		return nil, fmt.Errorf("could not read values from file %s: %s", inFilename, err)
+	}
+	cr := struct {
+		Metadata struct {
+			Annotations map[string]string `json:"annotations"`
+		} `json:"metadata"`
+	}{}
+	if err := yaml.Unmarshal(b, &cr); err != nil {
+		return nil, err
+	}
+	return policy.ExemptionsFromAnnotations(cr.Metadata.Annotations)
+}
@@ -0,0 +1,116 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"istio.io/api/operator/v1alpha1"
+	"istio.io/operator/pkg/name"
+)
+
+func TestCheckPolicy(t *testing.T) {
+	dir := createTempDirOrFail(t, "check-policy")
+	defer removeDirOrFail(t, dir)
+	inFilename := filepath.Join(dir, "iop.yaml")
+	cr := `apiVersion: install.istio.io/v1alpha1
+kind: IstioOperator
+metadata:
+  annotations:
+    install.istio.io/policy-exempt: no-host-network=DaemonSet:kube-system:istio-cni-node
+spec:
+  hub: docker.io/istio
+`
+	if err := ioutil.WriteFile(inFilename, []byte(cr), 0644); err != nil {
+		t.Fatal(err)
+	}
+	iops := &v1alpha1.IstioOperatorSpec{Hub: "docker.io/istio"}
+	manifests := name.ManifestMap{
+		name.CNIComponentName: {`apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: istio-cni-node
+  namespace: kube-system
+spec:
+  template:
+    spec:
+      hostNetwork: true
+      containers:
+      - name: install-cni
+        image: docker.io/istio/install-cni:1.5.0
+        resources:
+          limits:
+            cpu: 100m
+`},
+		name.IngressComponentName: {`apiVersion: v1
+kind: Service
+metadata:
+  name: istio-ingressgateway
+  namespace: istio-system
+spec:
+  type: LoadBalancer
+`},
+	}
+
+	tests := []struct {
+		desc    string
+		exempt  []string
+		wantErr string
+		wantOut []string
+	}{
+		{
+			desc:    "violation",
+			wantErr: "1 violations of policy",
+			wantOut: []string{
+				"info: DaemonSet kube-system/istio-cni-node: spec.template.spec.hostNetwork must not be true (exempted) [no-host-network]",
+				"error: Service istio-system/istio-ingressgateway: spec.type must not be LoadBalancer [no-loadbalancer-gateways]",
+			},
+		},
+		{
+			desc:   "exempted",
+			exempt: []string{"no-loadbalancer-gateways"},
+			wantOut: []string{
+				"info: Service istio-system/istio-ingressgateway: spec.type must not be LoadBalancer (exempted) [no-loadbalancer-gateways]",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var out bytes.Buffer
+			l := NewLogger(false, &out, &out)
+			args := &policyArgs{policyFile: "../../samples/policy.yaml", exempt: tt.exempt}
+			err := checkPolicy(args, inFilename, iops, manifests, l)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("got error %s, want none", err)
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Errorf("got error %v, want %q", err, tt.wantErr)
+			}
+			for _, want := range tt.wantOut {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("got output:\n%s\nwant it to contain:\n%s", out.String(), want)
+				}
+			}
+		})
+	}
+
+	if err := checkPolicy(&policyArgs{}, inFilename, iops, manifests, NewLogger(true, &bytes.Buffer{}, &bytes.Buffer{})); err != nil {
+		t.Errorf("got error %s without a policy, want none", err)
+	}
+}
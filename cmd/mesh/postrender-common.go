@@ -0,0 +1,44 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"github.com/spf13/cobra"
+
+	"istio.io/operator/pkg/postrender"
+)
+
+type postRenderArgs struct {
+	// postRenderer is the executable or kustomization directory that the generated manifests are transformed with.
+	postRenderer string
+	// args are the arguments of the postRenderer executable.
+	args []string
+}
+
+func addPostRenderFlags(cmd *cobra.Command, args *postRenderArgs) {
+	cmd.PersistentFlags().StringVar(&args.postRenderer, "post-renderer", "",
+		"Executable that the generated manifest is piped through, as YAML on stdin and stdout, or directory of a "+
+			"kustomization that lists "+postrender.ManifestFile+" in its resources, which the manifest is written to")
+	cmd.PersistentFlags().StringArrayVar(&args.args, "post-renderer-arg", nil,
+		"An argument of the --post-renderer executable. May be repeated.")
+}
+
+// newPostRenderer returns the PostRenderer selected by args, or nil if args is nil or selects none.
+func newPostRenderer(args *postRenderArgs) (postrender.PostRenderer, error) {
+	if args == nil || args.postRenderer == "" {
+		return nil, nil
+	}
+	return postrender.New(args.postRenderer, args.args)
+}
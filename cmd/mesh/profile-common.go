@@ -18,12 +18,15 @@ import (
 	"fmt"
 	"io/ioutil"
 	"path/filepath"
+	"strings"
 
 	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
 
 	"istio.io/api/operator/v1alpha1"
 	"istio.io/operator/pkg/helm"
 	"istio.io/operator/pkg/manifest"
+	"istio.io/operator/pkg/schema"
 	"istio.io/operator/pkg/tpath"
 	"istio.io/operator/pkg/translate"
 	"istio.io/operator/pkg/util"
@@ -32,6 +35,27 @@ import (
 	"istio.io/pkg/version"
 )
 
+var (
+	// profileDirs is the search path of user profile directories set with --profiles-dir.
+	profileDirs []string
+)
+
+func addProfileDirsFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringSliceVar(&profileDirs, "profiles-dir", nil,
+		"Directory with user profiles, which can be selected by file name without the .yaml suffix. May be repeated "+
+			"to set a search path, in which earlier directories take precedence. Defaults to $"+helm.ProfilesDirEnvVar)
+}
+
+// setProfileDirs sets the search path of user profile directories from the --profiles-dir flag on cmd, or from the
+// environment if the flag is not set.
+func setProfileDirs(cmd *cobra.Command) {
+	if cmd.Flags().Changed("profiles-dir") {
+		helm.SetProfileDirs(profileDirs)
+		return
+	}
+	helm.SetProfileDirs(nil)
+}
+
 // getIOPS creates an IstioOperatorSpec from the following sources, overlaid sequentially:
 // 1. Compiled in base, or optionally base from path pointed to in IOP stored at inFilename.
 // 2. Profile overlay, if non-default overlay is selected. This also comes either from compiled in or path specified in IOP contained in inFilename.
@@ -42,8 +66,19 @@ import (
 // ones that are compiled in. If it does, the starting point will be the base and profile YAMLs at that file path.
 // Otherwise it will be the compiled in profile YAMLs.
 // In step 3, the remaining fields in the same user overlay are applied on the resulting profile base.
-func genIOPS(inFilename, profile, setOverlayYAML, ver string, force bool, l *Logger) (string, *v1alpha1.IstioOperatorSpec, error) {
-	overlayYAML := ""
+// If ver is set, the profiles are read from the install package for that version, which is fetched into the package
+// cache selected by pkgArgs. pkgArgs may be nil otherwise.
+func genIOPS(inFilename, profile, setOverlayYAML, ver string, pkgArgs *packageCacheArgs, force bool,
+	l *Logger) (string, *v1alpha1.IstioOperatorSpec, error) {
+	return genIOPSWithProvenance(inFilename, profile, setOverlayYAML, ver, pkgArgs, force, l, nil)
+}
+
+// genIOPSWithProvenance is like genIOPS and also records the source of each field of the IstioOperatorSpec in prov,
+// unless it is nil.
+func genIOPSWithProvenance(inFilename, profile, setOverlayYAML, ver string, pkgArgs *packageCacheArgs, force bool,
+	l *Logger, prov util.Provenance) (string, *v1alpha1.IstioOperatorSpec, error) {
+	// inCRYAML is the IstioOperator CR in inFilename, overlayYAML its IstioOperatorSpec.
+	overlayYAML, inCRYAML := "", ""
 	var overlayIOPS *v1alpha1.IstioOperatorSpec
 	set := make(map[string]interface{})
 	err := yaml.Unmarshal([]byte(setOverlayYAML), &set)
@@ -55,7 +90,11 @@ func genIOPS(inFilename, profile, setOverlayYAML, ver string, force bool, l *Log
 		if err != nil {
 			return "", nil, fmt.Errorf("could not read values from file %s: %s", inFilename, err)
 		}
-		overlayIOPS, overlayYAML, err = unmarshalAndValidateIOP(string(b), force)
+		inCRYAML = string(b)
+		if errs := checkFields(inCRYAML, specPath, inFilename, l); len(errs) != 0 {
+			return "", nil, fmt.Errorf("unknown fields in %s: %s", inFilename, errs)
+		}
+		overlayIOPS, overlayYAML, err = unmarshalAndValidateIOP(inCRYAML, force)
 		if err != nil {
 			return "", nil, err
 		}
@@ -65,11 +104,16 @@ func genIOPS(inFilename, profile, setOverlayYAML, ver string, force bool, l *Log
 		profile = setProfile.(string)
 	}
 
-	if ver != "" && !util.IsFilePath(profile) {
-		pkgPath, err := fetchInstallPackage(helm.InstallURLFromVersion(ver))
+	userProfile, err := helm.FindUserProfile(profile)
+	if err != nil {
+		return "", nil, err
+	}
+	if ver != "" && !util.IsFilePath(profile) && userProfile == "" {
+		pkg, err := fetchInstallPackage(pkgArgs, helm.InstallURLFromVersion(ver))
 		if err != nil {
 			return "", nil, err
 		}
+		pkgPath := pkg.Dir
 		if helm.IsDefaultProfile(profile) {
 			profile = filepath.Join(pkgPath, helm.ProfilesFilePath, helm.DefaultProfileFilename)
 		} else {
@@ -77,25 +121,25 @@ func genIOPS(inFilename, profile, setOverlayYAML, ver string, force bool, l *Log
 		}
 	}
 
-	// This contains the IstioOperator CR.
-	baseCRYAML, err := helm.ReadProfileYAML(profile)
+	// This contains the IstioOperator CR, overlaid on its chain of base profiles.
+	layers, err := helm.ReadProfileLayers(profile)
+	if err != nil {
+		return "", nil, err
+	}
+	baseCRYAML, err := helm.OverlayProfileLayers(layers)
 	if err != nil {
-		return "", nil, fmt.Errorf("could not read the profile values for %s: %s", profile, err)
+		return "", nil, err
 	}
-
-	if !helm.IsDefaultProfile(profile) {
-		// Profile definitions are relative to the default profile, so read that first.
-		dfn, err := helm.DefaultFilenameForProfile(profile)
-		if err != nil {
-			return "", nil, err
+	for _, pl := range layers {
+		src := util.Source{Layer: profileLayer, File: pl.Profile}
+		if pl.Profile == "" {
+			src.File = helm.DefaultProfileString
 		}
-		defaultYAML, err := helm.ReadProfileYAML(dfn)
-		if err != nil {
-			return "", nil, fmt.Errorf("could not read the default profile values for %s: %s", dfn, err)
+		if errs := checkFields(pl.YAML, specPath, src.File, l); len(errs) != 0 {
+			return "", nil, fmt.Errorf("unknown fields in profile %s: %s", src.File, errs)
 		}
-		baseCRYAML, err = util.OverlayYAML(defaultYAML, baseCRYAML)
-		if err != nil {
-			return "", nil, fmt.Errorf("could not overlay the profile over the default %s: %s", profile, err)
+		if err := overlayProvenance(prov, pl.YAML, specPath, src); err != nil {
+			return "", nil, err
 		}
 	}
 
@@ -117,6 +161,9 @@ func genIOPS(inFilename, profile, setOverlayYAML, ver string, force bool, l *Log
 		if err != nil {
 			return "", nil, err
 		}
+		if err := overlayProvenance(prov, buildHubTagOverlayYAML, nil, util.Source{Layer: buildLayer}); err != nil {
+			return "", nil, err
+		}
 	}
 
 	// Merge base and overlay.
@@ -124,6 +171,9 @@ func genIOPS(inFilename, profile, setOverlayYAML, ver string, force bool, l *Log
 	if err != nil {
 		return "", nil, fmt.Errorf("could not overlay user config over base: %s", err)
 	}
+	if err := overlayProvenance(prov, inCRYAML, specPath, util.Source{Layer: fileLayer, File: inFilename}); err != nil {
+		return "", nil, err
+	}
 	if _, err := unmarshalAndValidateIOPS(mergedYAML, force, l); err != nil {
 		return "", nil, err
 	}
@@ -133,6 +183,9 @@ func genIOPS(inFilename, profile, setOverlayYAML, ver string, force bool, l *Log
 	if err != nil {
 		return "", nil, fmt.Errorf("could not overlay --set values over merged: %s", err)
 	}
+	if err := overlayProvenance(prov, setOverlayYAML, nil, util.Source{Layer: setLayer}); err != nil {
+		return "", nil, err
+	}
 
 	finalIOPS, err := unmarshalAndValidateIOPS(finalYAML, force, l)
 	if err != nil {
@@ -142,7 +195,7 @@ func genIOPS(inFilename, profile, setOverlayYAML, ver string, force bool, l *Log
 }
 
 func genProfile(helmValues bool, inFilename, profile, setOverlayYAML, configPath string, force bool, l *Logger) (string, error) {
-	finalYAML, finalIOPS, err := genIOPS(inFilename, profile, setOverlayYAML, "", force, l)
+	finalYAML, finalIOPS, err := genIOPS(inFilename, profile, setOverlayYAML, "", nil, force, l)
 	if err != nil {
 		return "", err
 	}
@@ -167,6 +220,79 @@ func genProfile(helmValues bool, inFilename, profile, setOverlayYAML, configPath
 	return finalYAML, err
 }
 
+// explainIOPS returns the IstioOperatorSpec YAML subtree at configPath, generated like genIOPS from inFilename,
+// profile and the --set flag values in set, with each field annotated with its source.
+func explainIOPS(inFilename, profile string, set []string, configPath string, force bool, l *Logger) (string, error) {
+	setOverlayYAML, err := MakeTreeFromSetList(set, force, l)
+	if err != nil {
+		return "", err
+	}
+	prov := make(util.Provenance)
+	finalYAML, _, err := genIOPSWithProvenance(inFilename, profile, setOverlayYAML, "", nil, force, l, prov)
+	if err != nil {
+		return "", err
+	}
+	setFlagProvenance(prov, set)
+	finalYAML, err = getConfigSubtree(finalYAML, configPath)
+	if err != nil {
+		return "", err
+	}
+	return util.AnnotateYAML(finalYAML, prov.Subtree(util.PathFromString(configPath)))
+}
+
+// setFlagProvenance records in prov the --set flag value in set that set each path. Paths into lists are recorded for
+// the whole list.
+func setFlagProvenance(prov util.Provenance, set []string) {
+	for _, kv := range set {
+		path := util.PathFromString(strings.Split(kv, "=")[0])
+		for i, pe := range path {
+			if _, ok := util.RemoveBrackets(pe); ok {
+				path = path[:i]
+				break
+			}
+		}
+		prov.Set(path, util.Source{Layer: setLayer, File: kv})
+	}
+}
+
+const (
+	// Layers of the IstioOperatorSpec, from the first applied to the last.
+	profileLayer = "profile"
+	buildLayer   = "build"
+	fileLayer    = "file"
+	setLayer     = "--set"
+)
+
+// specPath is the path of the IstioOperatorSpec in an IstioOperator CR.
+var specPath = util.Path{"spec"}
+
+// overlayProvenance records in prov that the subtree at root of overlayYAML from src was applied, unless prov is nil.
+func overlayProvenance(prov util.Provenance, overlayYAML string, root util.Path, src util.Source) error {
+	if prov == nil || overlayYAML == "" {
+		return nil
+	}
+	return prov.OverlayYAML(overlayYAML, root, src)
+}
+
+// checkFields checks that all fields of the IstioOperatorSpec at root in YAML string y, read from file, are in the
+// schema, and returns the errors for the unknown fields. Unknown values fields are only printed as warnings, because
+// values is passed to the charts as is.
+func checkFields(y string, root util.Path, file string, l *Logger) util.Errors {
+	var errs, valuesErrs util.Errors
+	for _, err := range schema.CheckFields(y, root, file) {
+		if ufe, ok := err.(*schema.UnknownFieldError); ok && ufe.Path[0] == "values" {
+			valuesErrs = append(valuesErrs, err)
+			continue
+		}
+		errs = append(errs, err)
+	}
+	if len(valuesErrs) != 0 {
+		l.logAndError("Warning: the following values fields are not in the values schema and are passed to the charts as is:\n",
+			valuesErrs.Error())
+	}
+	return errs
+}
+
 func unmarshalAndValidateIOP(crYAML string, force bool) (*v1alpha1.IstioOperatorSpec, string, error) {
 	// TODO: add GVK handling as appropriate.
 	if crYAML == "" {
@@ -27,6 +27,9 @@ type profileDumpArgs struct {
 	helmValues bool
 	// configPath sets the root node for the subtree to display the config for.
 	configPath string
+	// explain annotates each field with the layer that set it, e.g. a profile in the inheritance chain, and its file
+	// and line.
+	explain bool
 }
 
 func addProfileDumpFlags(cmd *cobra.Command, args *profileDumpArgs) {
@@ -35,6 +38,9 @@ func addProfileDumpFlags(cmd *cobra.Command, args *profileDumpArgs) {
 		"The path the root of the configuration subtree to dump e.g. trafficManagement.components.pilot. By default, dump whole tree")
 	cmd.PersistentFlags().BoolVarP(&args.helmValues, "helm-values", "", false,
 		"If set, dumps the Helm values that IstioControlPlaceSpec is translated to before manifests are rendered")
+	cmd.PersistentFlags().BoolVar(&args.explain, "explain", false,
+		"If set, annotates each field with the layer that set it, i.e. a profile in the inheritance chain, the build "+
+			"hub and tag or the input file, and its file and line")
 }
 
 func profileDumpCmd(rootArgs *rootArgs, pdArgs *profileDumpArgs) *cobra.Command {
@@ -62,10 +68,22 @@ func profileDump(args []string, rootArgs *rootArgs, pdArgs *profileDumpArgs, l *
 		return fmt.Errorf("cannot specify both profile name and filename flag")
 	}
 
+	if pdArgs.explain && pdArgs.helmValues {
+		return fmt.Errorf("cannot specify --explain with --helm-values")
+	}
+
 	profile := ""
 	if len(args) == 1 {
 		profile = args[0]
 	}
+	if pdArgs.explain {
+		y, err := explainIOPS(pdArgs.inFilename, profile, nil, pdArgs.configPath, true, l)
+		if err != nil {
+			return err
+		}
+		l.print(y + "\n")
+		return nil
+	}
 	y, err := genProfile(pdArgs.helmValues, pdArgs.inFilename, profile, "", pdArgs.configPath, true, l)
 	if err != nil {
 		return err
@@ -17,6 +17,7 @@ package mesh
 import (
 	"io/ioutil"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"istio.io/operator/pkg/util"
@@ -62,6 +63,53 @@ func TestProfileDump(t *testing.T) {
 	}
 }
 
+// TestExplain checks the annotated IstioOperatorSpec printed by the --explain flag of profile dump and manifest
+// generate. The output is compared as text, since the annotations are comments.
+func TestExplain(t *testing.T) {
+	testDataDir = filepath.Join(repoRootDir, "cmd/mesh/testdata/profile-dump")
+	inPath := filepath.Join(testDataDir, "input", "explain.yaml")
+	tests := []struct {
+		desc    string
+		command string
+	}{
+		{
+			desc:    "explain",
+			command: "profile dump --explain -f " + inPath,
+		},
+		{
+			desc:    "explain_manifest_generate",
+			command: "manifest generate --explain -f " + inPath + " --set values.global.proxy.logLevel=debug",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			outPath := filepath.Join(testDataDir, "output", tt.desc+".yaml")
+
+			got, err := runCommand(tt.command)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Make the annotations independent of the location of the repo.
+			got = strings.ReplaceAll(got, testDataDir+string(filepath.Separator), "")
+
+			if refreshGoldenFiles() {
+				t.Logf("Refreshing golden file for %s", outPath)
+				if err := ioutil.WriteFile(outPath, []byte(got), 0644); err != nil {
+					t.Error(err)
+				}
+			}
+
+			want, err := readFile(outPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != want {
+				t.Errorf("%s: got:\n%s\n\nwant:\n%s\nDiff:\n%s\n", tt.command, got, want, util.YAMLDiff(got, want))
+			}
+		})
+	}
+}
+
 func runProfileDump(path string) (string, error) {
 	return runCommand("profile dump -f " + path)
 }
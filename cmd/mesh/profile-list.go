@@ -34,16 +34,33 @@ func profileListCmd(rootArgs *rootArgs) *cobra.Command {
 
 }
 
-// profileList list all the builtin profiles.
+// profileList lists the builtin profiles and the user profiles in the profile directories.
 func profileList(args *rootArgs) error {
 	initLogsOrExit(args)
-	profiles := helm.ListBuiltinProfiles()
+	profiles, err := helm.ListProfiles()
+	if err != nil {
+		return err
+	}
 	if len(profiles) == 0 {
 		fmt.Println("No profiles available.")
-	} else {
-		fmt.Println("Istio configuration profiles:")
-		for _, profile := range profiles {
-			fmt.Printf("    %s\n", profile)
+		return nil
+	}
+	fmt.Println("Istio configuration profiles:")
+	var collisions []string
+	for _, profile := range profiles {
+		source, hiddenBy := "builtin", "the built-in profile"
+		if !profile.Builtin() {
+			source, hiddenBy = profile.Path, profile.Path
+		}
+		fmt.Printf("    %-20s %s\n", profile.Name, source)
+		for _, path := range profile.Shadowed {
+			collisions = append(collisions, fmt.Sprintf("%s: %s is hidden by %s", profile.Name, path, hiddenBy))
+		}
+	}
+	if len(collisions) != 0 {
+		fmt.Println("\nProfile name collisions:")
+		for _, c := range collisions {
+			fmt.Printf("    %s\n", c)
 		}
 	}
 
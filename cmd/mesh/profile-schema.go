@@ -0,0 +1,131 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"istio.io/operator/pkg/object"
+	"istio.io/operator/pkg/schema"
+	"istio.io/operator/pkg/vfs"
+)
+
+const (
+	// schemaOutputCRD prints the IstioOperator CRD with its schema.
+	schemaOutputCRD = "crd"
+	// schemaOutputOpenAPI prints the OpenAPI v3 schema of IstioOperatorSpec with descriptions.
+	schemaOutputOpenAPI = "openapi"
+	// schemaOutputJSONSchema prints the standalone JSON Schema of IstioOperator CRs.
+	schemaOutputJSONSchema = "jsonschema"
+
+	// istioOperatorCRDName is the name of the IstioOperator CRD.
+	istioOperatorCRDName = "istiooperators.install.istio.io"
+	// operatorCRDAsset is the compiled in manifest of the IstioOperator CRD, without its schema.
+	operatorCRDAsset = "operator/templates/crd.yaml"
+)
+
+type profileSchemaArgs struct {
+	// output is the format of the schema, one of the schemaOutput values.
+	output string
+}
+
+func addProfileSchemaFlags(cmd *cobra.Command, args *profileSchemaArgs) {
+	cmd.PersistentFlags().StringVarP(&args.output, "output", "o", schemaOutputCRD,
+		"The schema to print: "+schemaOutputCRD+" for the IstioOperator CRD with its structural schema, "+
+			schemaOutputOpenAPI+" for the OpenAPI v3 schema of IstioOperatorSpec with descriptions, or "+
+			schemaOutputJSONSchema+" for a JSON Schema of IstioOperator CRs for editors")
+}
+
+func profileSchemaCmd(rootArgs *rootArgs, psArgs *profileSchemaArgs) *cobra.Command {
+	return &cobra.Command{
+		Use:   "schema",
+		Short: "Prints the schema of the IstioOperator API",
+		Long: "The schema subcommand prints the schema of the IstioOperator API, including the Helm values, generated " +
+			"from the proto definitions.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			l := NewLogger(rootArgs.logToStdErr, cmd.OutOrStdout(), cmd.ErrOrStderr())
+			return profileSchema(rootArgs, psArgs, l)
+		}}
+}
+
+func profileSchema(rootArgs *rootArgs, psArgs *profileSchemaArgs, l *Logger) error {
+	initLogsOrExit(rootArgs)
+
+	var out string
+	switch psArgs.output {
+	case schemaOutputCRD:
+		b, err := vfs.ReadFile(operatorCRDAsset)
+		if err != nil {
+			return err
+		}
+		if out, err = addCRDSchema(string(b)); err != nil {
+			return err
+		}
+	case schemaOutputOpenAPI:
+		b, err := yaml.Marshal(schema.OpenAPIV3(true))
+		if err != nil {
+			return err
+		}
+		out = string(b)
+	case schemaOutputJSONSchema:
+		s, err := schema.JSONSchema()
+		if err != nil {
+			return err
+		}
+		b, err := json.MarshalIndent(s, "", "  ")
+		if err != nil {
+			return err
+		}
+		out = string(b) + "\n"
+	default:
+		return fmt.Errorf("unknown output %s, must be %s, %s or %s", psArgs.output, schemaOutputCRD, schemaOutputOpenAPI,
+			schemaOutputJSONSchema)
+	}
+	l.print(out)
+	return nil
+}
+
+// addCRDSchema returns manifest with the generated structural schema set in the IstioOperator CRD, if it has one.
+func addCRDSchema(manifest string) (string, error) {
+	objs, err := object.ParseK8sObjectsFromYAMLManifest(manifest)
+	if err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(schema.CRDValidation())
+	if err != nil {
+		return "", err
+	}
+	validation := make(map[string]interface{})
+	if err := json.Unmarshal(b, &validation); err != nil {
+		return "", err
+	}
+	for i, o := range objs {
+		if o.Kind != "CustomResourceDefinition" || o.Name != istioOperatorCRDName {
+			continue
+		}
+		u := o.UnstructuredObject()
+		if err := unstructured.SetNestedField(u.Object, validation, "spec", "validation"); err != nil {
+			return "", err
+		}
+		objs[i] = object.NewK8sObject(u, nil, nil)
+	}
+	return objs.YAMLManifest()
+}
@@ -0,0 +1,66 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"istio.io/operator/pkg/schema"
+	"istio.io/operator/pkg/util"
+)
+
+// TestProfileSchemaCRD checks that the CRD in deploy/crds is the one generated with its schema. It is refreshed like
+// golden files, or with make generate-crd.
+func TestProfileSchemaCRD(t *testing.T) {
+	crdPath := filepath.Join(repoRootDir, "deploy/crds/istio_v1alpha1_istiooperator_crd.yaml")
+	got, err := runCommand("profile schema")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refreshGoldenFiles() {
+		t.Logf("Refreshing golden file for %s", crdPath)
+		if err := ioutil.WriteFile(crdPath, []byte(got), 0644); err != nil {
+			t.Error(err)
+		}
+	}
+	want, err := readFile(crdPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !util.IsYAMLEqual(got, want) {
+		t.Errorf("%s is out of date, run make generate-crd. Diff:\n%s", crdPath, util.YAMLDiff(want, got))
+	}
+}
+
+func TestProfileSchemaJSONSchema(t *testing.T) {
+	got, err := runCommand("profile schema -o jsonschema")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(got), &s); err != nil {
+		t.Fatalf("got invalid JSON: %s", err)
+	}
+	if s["$schema"] != schema.JSONSchemaDraft {
+		t.Errorf("got $schema %v, want %s", s["$schema"], schema.JSONSchemaDraft)
+	}
+
+	if _, err := runCommand("profile schema -o xml"); err == nil {
+		t.Error("got no error for an unknown output, want one")
+	}
+}
@@ -29,7 +29,9 @@ Overrides the corresponding path value in the selected profile or passed through
 customization file`
 	skipConfirmationFlagHelpStr = `skipConfirmation determines whether the user is prompted for confirmation. 
 If set to true, the user is not prompted and a Yes response is assumed in all cases.`
-	filenameFlagHelpStr = `Path to file containing IstioOperator CustomResource`
+	filenameFlagHelpStr       = `Path to file containing IstioOperator CustomResource`
+	strictOverlaysFlagHelpStr = `Fail if an overlay, overlay path or K8S setting matches nothing in the generated manifest, ` +
+		`rather than warn about it`
 )
 
 type rootArgs struct {
@@ -58,15 +60,25 @@ func GetRootCmd(args []string) *cobra.Command {
 		SilenceUsage: true,
 		Long: "This command uses the Istio operator code to generate templates, query configurations and perform " +
 			"utility operations.",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			setProfileDirs(cmd)
+			return setHTTPClient(cmd, httpClientArgs)
+		},
 	}
 	rootCmd.SetArgs(args)
 	rootCmd.PersistentFlags().AddGoFlagSet(flag.CommandLine)
+	addHTTPFlags(rootCmd, httpClientArgs)
+	addProfileDirsFlag(rootCmd)
 
 	rootCmd.AddCommand(ManifestCmd())
 	rootCmd.AddCommand(ProfileCmd())
 	rootCmd.AddCommand(OperatorCmd())
 	rootCmd.AddCommand(version.CobraCommand())
 	rootCmd.AddCommand(UpgradeCmd())
+	rootCmd.AddCommand(PackageCmd())
+	rootCmd.AddCommand(ExplainCmd())
+	rootCmd.AddCommand(ValidateCmd())
+	rootCmd.AddCommand(OverlayCmd())
 
 	version.Info.Version = binversion.OperatorVersionString
 
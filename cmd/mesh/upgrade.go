@@ -27,6 +27,8 @@ import (
 	"istio.io/operator/pkg/compare"
 	"istio.io/operator/pkg/hooks"
 	"istio.io/operator/pkg/manifest"
+	"istio.io/operator/pkg/validate"
+	"istio.io/operator/pkg/version"
 	opversion "istio.io/operator/version"
 	"istio.io/pkg/log"
 )
@@ -64,6 +66,17 @@ type upgradeArgs struct {
 	skipConfirmation bool
 	// force means directly applying the upgrade without eligibility checks.
 	force bool
+	// channel is the release channel the target version is selected from. If set, it overrides the tag in the
+	// IstioOperator CR.
+	channel string
+	// pkgCache selects the package cache that the install package of the current version is fetched into.
+	pkgCache packageCacheArgs
+	// policy selects the guardrail policy the upgraded installation must follow.
+	policy policyArgs
+	// strictOverlays fails the upgrade if overlays, overlay paths or K8S settings match nothing.
+	strictOverlays bool
+	// postRender selects the post-renderer that the manifest is transformed with before it is applied.
+	postRender postRenderArgs
 }
 
 // addUpgradeFlags adds upgrade related flags into cobra command
@@ -85,6 +98,13 @@ func addUpgradeFlags(cmd *cobra.Command, args *upgradeArgs) {
 			upgradeWaitCheckVerMaxAttempts).String())
 	cmd.PersistentFlags().BoolVar(&args.force, "force", false,
 		"Apply the upgrade without eligibility checks")
+	cmd.PersistentFlags().StringVar(&args.channel, "channel", "",
+		"Upgrade to the newest recommended version in the given release channel, one of "+
+			strings.Join(version.Channels, "|")+". Overrides the tag in the IstioOperator CustomResource")
+	cmd.PersistentFlags().BoolVar(&args.strictOverlays, "strict-overlays", false, strictOverlaysFlagHelpStr)
+	addPackageCacheFlags(cmd, &args.pkgCache)
+	addPolicyFlags(cmd, &args.policy)
+	addPostRenderFlags(cmd, &args.postRender)
 }
 
 // Upgrade command upgrades Istio control plane in-place with eligibility checks
@@ -117,8 +137,23 @@ func UpgradeCmd() *cobra.Command {
 func upgrade(rootArgs *rootArgs, args *upgradeArgs, l *Logger) (err error) {
 	args.inFilename = strings.TrimSpace(args.inFilename)
 
+	// Select the target version from the release channel, if one is given.
+	var setOverlay []string
+	if args.channel != "" {
+		channelVersion, err := getChannelVersion(args.channel, args.versionsURI, l)
+		if err != nil {
+			return err
+		}
+		l.logAndPrintf("Selected version %s from the %s channel.\n", channelVersion, args.channel)
+		setOverlay = append(setOverlay, "tag="+channelVersion)
+	}
+	setOverlayYAML, err := MakeTreeFromSetList(setOverlay, args.force, l)
+	if err != nil {
+		return fmt.Errorf("failed to generate tree from the set overlay, error: %v", err)
+	}
+
 	// Generate IOPS objects
-	targetIOPSYaml, targetIOPS, err := genIOPS(args.inFilename, "", "", "", args.force, l)
+	targetIOPSYaml, targetIOPS, err := genIOPS(args.inFilename, "", setOverlayYAML, "", nil, args.force, l)
 	if err != nil {
 		return fmt.Errorf("failed to generate IOPS from file %s, error: %s", args.inFilename, err)
 	}
@@ -149,8 +184,14 @@ func upgrade(rootArgs *rootArgs, args *upgradeArgs, l *Logger) (err error) {
 		return fmt.Errorf("failed to read the current Istio version, error: %v", err)
 	}
 
+	// Read the Kubernetes version from the cluster
+	kubeVersion, err := kubeClient.GetKubernetesVersion()
+	if err != nil && !args.force {
+		return fmt.Errorf("failed to read the Kubernetes version, error: %v", err)
+	}
+
 	// Check if the upgrade currentVersion -> targetVersion is supported
-	err = checkSupportedVersions(currentVersion, targetVersion, args.versionsURI, l)
+	err = checkSupportedVersions(currentVersion, targetVersion, kubeVersion, args.versionsURI, l)
 	if err != nil && !args.force {
 		return fmt.Errorf("upgrade version check failed: %v -> %v. Error: %v",
 			currentVersion, targetVersion, err)
@@ -170,12 +211,15 @@ func upgrade(rootArgs *rootArgs, args *upgradeArgs, l *Logger) (err error) {
 	// Generates IOPS for args.inFilename IOP specs yaml. Param force is set to true to
 	// skip the validation because the code only has the validation proto for the
 	// target version.
-	currentIOPSYaml, _, err := genIOPS(args.inFilename, "", "", currentVersion, true, l)
+	currentIOPSYaml, _, err := genIOPS(args.inFilename, "", "", currentVersion, &args.pkgCache, true, l)
 	if err != nil {
 		return fmt.Errorf("failed to generate IOPS from file: %s for the current version: %s, error: %v",
 			args.inFilename, currentVersion, err)
 	}
 	checkUpgradeIOPS(currentIOPSYaml, targetIOPSYaml, overrideIOPSYaml, l)
+	if err := checkRemovedFields(args.inFilename, setOverlayYAML, targetVersion, args.force, l); err != nil {
+		return err
+	}
 
 	waitForConfirmation(args.skipConfirmation, l)
 
@@ -192,8 +236,8 @@ func upgrade(rootArgs *rootArgs, args *upgradeArgs, l *Logger) (err error) {
 	}
 
 	// Apply the Istio Control Plane specs reading from inFilename to the cluster
-	err = genApplyManifests(nil, args.inFilename, args.force, rootArgs.dryRun,
-		rootArgs.verbose, args.kubeConfigPath, args.context, args.wait, upgradeWaitSecWhenApply, l)
+	err = genApplyManifests(setOverlay, args.inFilename, &args.pkgCache, &args.policy, &args.postRender, args.force, args.strictOverlays,
+		rootArgs.dryRun, rootArgs.verbose, args.kubeConfigPath, args.context, args.wait, upgradeWaitSecWhenApply, l)
 	if err != nil {
 		return fmt.Errorf("failed to apply the Istio Control Plane specs. Error: %v", err)
 	}
@@ -239,6 +283,27 @@ func checkUpgradeIOPS(curIOPS, tarIOPS, ignoreIOPS string, l *Logger) {
 	}
 }
 
+// checkRemovedFields returns the fields set by the user in inFilename and setOverlayYAML that are removed in the
+// target version tarVer as errors, unless force is set, so that the upgrade stops before it is confirmed. Deprecated
+// fields are logged later, when the manifests are generated.
+func checkRemovedFields(inFilename, setOverlayYAML, tarVer string, force bool, l *Logger) error {
+	target := opversion.OperatorBinaryVersion.MinorVersion
+	if v, err := version.NewVersionFromString(tarVer); err == nil {
+		target = v.MinorVersion
+	}
+	ds, err := deprecatedFields(inFilename, setOverlayYAML, target)
+	if err != nil {
+		return err
+	}
+	var removed validate.Diagnostics
+	for _, d := range ds {
+		if d.Severity == validate.SeverityError {
+			removed = append(removed, d)
+		}
+	}
+	return reportDiagnostics(removed, force, "the configuration uses fields removed in "+tarVer, l)
+}
+
 // waitForConfirmation waits for user's confirmation if skipConfirmation is not set
 func waitForConfirmation(skipConfirmation bool, l *Logger) {
 	if skipConfirmation {
@@ -249,8 +314,9 @@ func waitForConfirmation(skipConfirmation bool, l *Logger) {
 	}
 }
 
-// checkSupportedVersions checks if the upgrade cur -> tar is supported by the tool
-func checkSupportedVersions(cur, tar, versionsURI string, l *Logger) error {
+// checkSupportedVersions checks if the upgrade cur -> tar is supported by the tool and if tar can run on
+// Kubernetes version kubeVersion.
+func checkSupportedVersions(cur, tar string, kubeVersion *goversion.Version, versionsURI string, l *Logger) error {
 	tarGoVersion, err := goversion.NewVersion(tar)
 	if err != nil {
 		return fmt.Errorf("failed to parse the target version: %v", tar)
@@ -270,9 +336,45 @@ func checkSupportedVersions(cur, tar, versionsURI string, l *Logger) error {
 		return fmt.Errorf("upgrade is currently not supported: %v -> %v", cur, tar)
 	}
 
+	if !compatibleMap.SupportsK8sVersion(kubeVersion) {
+		return fmt.Errorf("target version %v does not support Kubernetes version %v, supported Kubernetes versions: %v",
+			tar, kubeVersion, compatibleMap.K8sVersions)
+	}
+
+	now := time.Now()
+	if compatibleMap.IsEOL(now) {
+		l.logAndPrintf("Warning: the target version %v reached its end of life on %s.\n",
+			tar, compatibleMap.EOLDate.Format(version.DateLayout))
+	} else if compatibleMap.IsDeprecated(now) {
+		l.logAndPrintf("Warning: the target version %v is deprecated since %s.\n",
+			tar, compatibleMap.DeprecationDate.Format(version.DateLayout))
+	}
+
 	return nil
 }
 
+// getChannelVersion returns the recommended Istio version of the newest operator version in the given release
+// channel.
+func getChannelVersion(channel, versionsURI string, l *Logger) (string, error) {
+	if !version.IsValidChannel(channel) {
+		return "", fmt.Errorf("unknown channel %s, must be one of %s", channel, strings.Join(version.Channels, "|"))
+	}
+	versions, err := getAllVersionMappings(versionsURI, l)
+	if err != nil {
+		return "", err
+	}
+	v := version.NewestInChannel(versions, channel, time.Now())
+	if v == nil {
+		return "", fmt.Errorf("no supported version found in the %s channel", channel)
+	}
+	iv := v.RecommendedIstioVersion()
+	if iv == nil {
+		return "", fmt.Errorf("operator version %s in the %s channel has no exact recommended Istio version: %s",
+			v.OperatorVersion, channel, v.RecommendedIstioVersions)
+	}
+	return iv.String(), nil
+}
+
 // retrieveControlPlaneVersion retrieves the version number from the Istio control plane
 func retrieveControlPlaneVersion(kubeClient manifest.ExecClient, istioNamespace string, l *Logger) (string, error) {
 	cv, e := kubeClient.GetIstioVersions(istioNamespace)
@@ -0,0 +1,83 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	goversion "github.com/hashicorp/go-version"
+)
+
+func TestCheckSupportedVersions(t *testing.T) {
+	operatorVersionsFilePath := "../../data/versions.yaml"
+	l := NewLogger(true, os.Stdout, os.Stderr)
+	tests := []struct {
+		desc        string
+		cur         string
+		tar         string
+		kubeVersion string
+		wantErr     string
+	}{
+		{
+			desc:        "supported",
+			cur:         "1.3.5",
+			tar:         "1.4.3",
+			kubeVersion: "1.15.3",
+		},
+		{
+			desc: "unknown Kubernetes version",
+			cur:  "1.3.5",
+			tar:  "1.4.3",
+		},
+		{
+			desc:        "upgrade not supported",
+			cur:         "1.2.0",
+			tar:         "1.4.3",
+			kubeVersion: "1.15.3",
+			wantErr:     "upgrade is currently not supported: 1.2.0 -> 1.4.3",
+		},
+		{
+			desc:        "Kubernetes too new",
+			cur:         "1.3.5",
+			tar:         "1.4.3",
+			kubeVersion: "1.17.0",
+			wantErr:     "target version 1.4.3 does not support Kubernetes version 1.17.0",
+		},
+		{
+			desc:        "Kubernetes too old",
+			cur:         "1.5.0",
+			tar:         "1.5.0",
+			kubeVersion: "1.13.5",
+			wantErr:     "target version 1.5.0 does not support Kubernetes version 1.13.5",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var kv *goversion.Version
+			if tt.kubeVersion != "" {
+				var err error
+				if kv, err = goversion.NewVersion(tt.kubeVersion); err != nil {
+					t.Fatal(err)
+				}
+			}
+			err := checkSupportedVersions(tt.cur, tt.tar, kv, operatorVersionsFilePath, l)
+			if gotErr := errToString(err); (tt.wantErr == "") != (gotErr == "") || !strings.Contains(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+
+	"istio.io/operator/pkg/util"
+	"istio.io/operator/pkg/validate"
+	binversion "istio.io/operator/version"
+)
+
+const (
+	// Output formats of the validate command.
+	validateOutputText  = "text"
+	validateOutputJSON  = "json"
+	validateOutputSARIF = "sarif"
+)
+
+type validateArgs struct {
+	// inFilenames are the paths to the IstioOperator CRs to validate.
+	inFilenames []string
+	// output is the output format, one of text, json or sarif.
+	output string
+	// render also renders the manifests of each CR merged with its profile.
+	render bool
+	// pkgCache selects the package cache that install packages are fetched into for rendering.
+	pkgCache packageCacheArgs
+}
+
+func addValidateFlags(cmd *cobra.Command, args *validateArgs) {
+	cmd.PersistentFlags().StringSliceVarP(&args.inFilenames, "filename", "f", nil,
+		"Path to a file containing an IstioOperator CustomResource to validate. May be repeated")
+	cmd.PersistentFlags().StringVarP(&args.output, "output", "o", validateOutputText,
+		"Output format: text, json or sarif")
+	cmd.PersistentFlags().BoolVar(&args.render, "render", true,
+		"Also render the manifests of each CR merged with its profile, to find problems in the charts")
+	addPackageCacheFlags(cmd, &args.pkgCache)
+}
+
+// ValidateCmd is a command to validate IstioOperator CRs.
+func ValidateCmd() *cobra.Command {
+	rootArgs := &rootArgs{}
+	vArgs := &validateArgs{}
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validates IstioOperator CustomResources",
+		Long: "The validate command checks IstioOperator CRs against the IstioOperatorSpec and values schemas and " +
+			"validation rules, and renders their manifests. It reports every problem with its file, line and column, " +
+			"severity and rule ID, as text or as JSON or SARIF for editors and CI. It fails if there is an error.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			l := NewLogger(rootArgs.logToStdErr, cmd.OutOrStdout(), cmd.ErrOrStderr())
+			return validateCRs(rootArgs, vArgs, l)
+		},
+	}
+	addFlags(cmd, rootArgs)
+	addValidateFlags(cmd, vArgs)
+	return cmd
+}
+
+func validateCRs(rootArgs *rootArgs, vArgs *validateArgs, l *Logger) error {
+	initLogsOrExit(rootArgs)
+
+	if len(vArgs.inFilenames) == 0 {
+		return fmt.Errorf("at least one file must be set with --filename")
+	}
+	var ds validate.Diagnostics
+	for _, f := range vArgs.inFilenames {
+		fds, err := validateCR(f, vArgs)
+		if err != nil {
+			return err
+		}
+		ds = append(ds, fds...)
+	}
+	ds.Sort()
+
+	switch vArgs.output {
+	case validateOutputText:
+		for _, d := range ds {
+			l.print(d.String() + "\n")
+		}
+		if len(ds) == 0 {
+			l.print("✔ No problems found.\n")
+		}
+	case validateOutputJSON:
+		if ds == nil {
+			ds = validate.Diagnostics{}
+		}
+		b, err := json.MarshalIndent(ds, "", "  ")
+		if err != nil {
+			return err
+		}
+		l.print(string(b) + "\n")
+	case validateOutputSARIF:
+		b, err := ds.SARIF("mesh", binversion.OperatorVersionString)
+		if err != nil {
+			return err
+		}
+		l.print(string(b) + "\n")
+	default:
+		return fmt.Errorf("unknown output format %q, must be one of %s, %s or %s", vArgs.output,
+			validateOutputText, validateOutputJSON, validateOutputSARIF)
+	}
+
+	if ds.HasErrors() {
+		return fmt.Errorf("validation failed with %d error(s) and %d warning(s)", ds.Count(validate.SeverityError),
+			ds.Count(validate.SeverityWarning))
+	}
+	return nil
+}
+
+// validateCR returns the problems found in the IstioOperator CR in file. The CR is checked on its own first. If it
+// has no errors, it is merged with its profile to check the values it results in with the semantic rules that are not
+// disabled in the CR, and to render its manifests.
+func validateCR(file string, vArgs *validateArgs) (validate.Diagnostics, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	_, ds := validate.CheckIstioOperatorCR(string(b), file)
+	if ds.HasErrors() {
+		return ds, nil
+	}
+
+	// Problems found by the checks above are already reported, so the logs of merging and rendering are discarded.
+	quiet := NewLogger(false, ioutil.Discard, ioutil.Discard)
+	disabled, err := validate.DisabledRulesFromCR(string(b))
+	if err != nil {
+		return nil, err
+	}
+	var merged validate.Diagnostics
+	_, iops, err := genIOPS(file, "", "", "", &vArgs.pkgCache, true, quiet)
+	if err != nil {
+		merged = append(merged, &validate.Diagnostic{RuleID: validate.RuleInvalidSpec, Severity: validate.SeverityError,
+			Message: fmt.Sprintf("could not merge with the profile: %s", err)})
+	} else {
+		merged = append(merged, validate.CheckValuesConfig(iops)...)
+		merged = append(merged, validate.CheckRules(iops, disabled, nil)...)
+		if vArgs.render && !merged.HasErrors() {
+			if _, _, err := GenManifests(file, "", &vArgs.pkgCache, nil, true, false, quiet); err != nil {
+				merged = append(merged, &validate.Diagnostic{RuleID: validate.RuleRender, Severity: validate.SeverityError,
+					Message: err.Error()})
+			}
+		}
+	}
+	doc, err := util.ParseYAMLNode(string(b))
+	if err != nil {
+		return nil, err
+	}
+	merged.Locate(doc, specPath, file)
+	return append(ds, merged...), nil
+}
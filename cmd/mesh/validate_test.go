@@ -0,0 +1,101 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mesh
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	testDataDir = filepath.Join(repoRootDir, "cmd/mesh/testdata/validate")
+	tests := []struct {
+		desc    string
+		inFiles []string
+		output  string
+		wantErr bool
+	}{
+		{
+			desc:    "valid",
+			inFiles: []string{"valid"},
+			output:  validateOutputText,
+		},
+		{
+			desc:    "bad",
+			inFiles: []string{"bad"},
+			output:  validateOutputText,
+			wantErr: true,
+		},
+		{
+			desc:    "mtls",
+			inFiles: []string{"mtls"},
+			output:  validateOutputText,
+			wantErr: true,
+		},
+		{
+			desc:    "rules",
+			inFiles: []string{"rules"},
+			output:  validateOutputText,
+			wantErr: true,
+		},
+		{
+			desc:    "all_json",
+			inFiles: []string{"valid", "bad", "mtls"},
+			output:  validateOutputJSON,
+			wantErr: true,
+		},
+		{
+			desc:    "bad_sarif",
+			inFiles: []string{"bad"},
+			output:  validateOutputSARIF,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			outPath := filepath.Join(testDataDir, "output", tt.desc+"."+tt.output)
+			vArgs := &validateArgs{output: tt.output, render: true}
+			for _, f := range tt.inFiles {
+				vArgs.inFilenames = append(vArgs.inFilenames, filepath.Join(testDataDir, "input", f+".yaml"))
+			}
+
+			var out bytes.Buffer
+			err := validateCRs(&rootArgs{}, vArgs, NewLogger(false, &out, ioutil.Discard))
+			if gotErr := err != nil; gotErr != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			// Make the positions independent of the location of the repo.
+			got := strings.ReplaceAll(out.String(), testDataDir+string(filepath.Separator), "")
+
+			if refreshGoldenFiles() {
+				t.Logf("Refreshing golden file for %s", outPath)
+				if err := ioutil.WriteFile(outPath, []byte(got), 0644); err != nil {
+					t.Error(err)
+				}
+			}
+
+			want, err := readFile(outPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != want {
+				t.Errorf("%s: got:\n%s\n\nwant:\n%s\n", tt.desc, got, want)
+			}
+		})
+	}
+}
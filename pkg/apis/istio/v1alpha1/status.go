@@ -0,0 +1,42 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"istio.io/api/operator/v1alpha1"
+)
+
+// IstioOperatorStatus is the status of an IstioOperator resource. It extends the InstallStatus defined in istio.io/api
+// with the status of the install package. The fields of InstallStatus are inlined, so the status of existing
+// resources reads the same.
+type IstioOperatorStatus struct {
+	v1alpha1.InstallStatus `json:",inline"`
+	// InstallPackage is the status of the install package at spec.installPackagePath. It is only set if that is a URL.
+	InstallPackage *InstallPackageStatus `json:"installPackage,omitempty"`
+}
+
+// InstallPackageStatus is the status of the install package of an IstioOperator resource.
+type InstallPackageStatus struct {
+	// Digest is the SHA256 digest of the applied install package, e.g. sha256:0123...
+	Digest string `json:"digest,omitempty"`
+	// Signer is the identity of the key that signed the applied install package, if its signature was verified.
+	Signer string `json:"signer,omitempty"`
+	// LastCheck is the last time the install package URL was checked for updates.
+	LastCheck *metav1.Time `json:"lastCheck,omitempty"`
+	// Error is the error of the last check, if it failed.
+	Error string `json:"error,omitempty"`
+}
@@ -27,29 +27,10 @@ const (
 	validationMethodName = "Validate"
 )
 
-// ValidateConfig  calls validation func for every defined element in Values
+// ValidateConfig  calls validation func for every defined element in Values. Checks of features that depend on each
+// other are the semantic rules of the validate package.
 func ValidateConfig(failOnMissingValidation bool, values *valuesv1alpha1.Values, iopls *v1alpha1.IstioOperatorSpec) util.Errors {
-	var validationErrors util.Errors
-	validationErrors = util.AppendErrs(validationErrors, validateSubTypes(reflect.ValueOf(values).Elem(), failOnMissingValidation, values, iopls))
-	validationErrors = util.AppendErrs(validationErrors, validateFeatures(values, iopls))
-	return validationErrors
-}
-
-// validateFeatures check whether the config sematically make sense. For example, feature X and feature Y can't be enabled together.
-func validateFeatures(values *valuesv1alpha1.Values, _ *v1alpha1.IstioOperatorSpec) util.Errors {
-	// When automatic mutual TLS is enabled, we check control plane security must also be enabled.
-	g := values.GetGlobal()
-	if g == nil {
-		return nil
-	}
-	m := g.GetMtls()
-	if m == nil {
-		return nil
-	}
-	if m.GetAuto().Value && !g.GetControlPlaneSecurityEnabled().Value {
-		return []error{fmt.Errorf("security: auto mtls is enabled, but control plane security is not enabled")}
-	}
-	return nil
+	return validateSubTypes(reflect.ValueOf(values).Elem(), failOnMissingValidation, values, iopls)
 }
 
 func validateSubTypes(e reflect.Value, failOnMissingValidation bool, values *valuesv1alpha1.Values, iopls *v1alpha1.IstioOperatorSpec) util.Errors {
@@ -117,6 +98,9 @@ func processMap(e reflect.Value, failOnMissingValidation bool, values *valuesv1a
 	var validationErrors util.Errors
 	for _, k := range e.MapKeys() {
 		v := e.MapIndex(k)
+		if util.IsNilOrInvalidValue(v) || (v.Kind() == reflect.Interface && util.IsNilOrInvalidValue(v.Elem())) {
+			continue
+		}
 		validationErrors = append(validationErrors, validateSubTypes(v, failOnMissingValidation, values, iopls)...)
 	}
 
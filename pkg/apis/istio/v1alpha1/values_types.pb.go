@@ -1652,10 +1652,17 @@ type GlobalConfig struct {
 	// Specifies the Istio control plane’s telemetry Pod IP address or remote cluster DNS resolvable hostname
 	RemoteTelemetryAddress string `protobuf:"bytes,49,opt,name=remoteTelemetryAddress,proto3" json:"remoteTelemetryAddress,omitempty"`
 	// Specifies the configution of istiod
-	Istiod               *IstiodConfig `protobuf:"bytes,54,opt,name=istiod,proto3" json:"istiod,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Istiod *IstiodConfig `protobuf:"bytes,54,opt,name=istiod,proto3" json:"istiod,omitempty"`
+	// Overlays applied to the rendered resources of every component, after the overlays of the component. The overlays
+	// have the format of K8SObjectOverlay: kind may be * and name a glob or a /regex/, and an overlay that matches no
+	// resource of a component is skipped.
+	K8SOverlays []map[string]interface{} `protobuf:"bytes,55,opt,name=k8sOverlays,proto3" json:"k8sOverlays,omitempty"`
+	// Additional manifests installed with a component. The resources are labeled, ordered, waited for and pruned like
+	// the rendered resources of the component they belong to.
+	ExtraManifests       []*ExtraManifestConfig `protobuf:"bytes,56,rep,name=extraManifests,proto3" json:"extraManifests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *GlobalConfig) Reset()         { *m = GlobalConfig{} }
@@ -2058,6 +2065,20 @@ func (m *GlobalConfig) GetIstiod() *IstiodConfig {
 	return nil
 }
 
+func (m *GlobalConfig) GetK8SOverlays() []map[string]interface{} {
+	if m != nil {
+		return m.K8SOverlays
+	}
+	return nil
+}
+
+func (m *GlobalConfig) GetExtraManifests() []*ExtraManifestConfig {
+	if m != nil {
+		return m.ExtraManifests
+	}
+	return nil
+}
+
 type IstiodConfig struct {
 	// If enabled, all control plane functionality will be handled by a single deployment.
 	Enabled              *protobuf.BoolValue `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
@@ -7718,6 +7739,67 @@ func (m *ZeroVPNConfig) GetSuffix() string {
 	return ""
 }
 
+// ExtraManifestConfig is a manifest of resources installed with a component in addition to its rendered resources.
+type ExtraManifestConfig struct {
+	// Name of the component the resources are installed with: a core component name such as Base, in any case, or the
+	// name of a gateway or addon component. It is Base if it is not set.
+	Component string `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	// YAML of the resources. Exactly one of manifest and file must be set.
+	Manifest string `protobuf:"bytes,2,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	// Path of a file holding the YAML of the resources. Files are only read by the mesh CLI, the controller rejects
+	// entries that set file.
+	File                 string   `protobuf:"bytes,3,opt,name=file,proto3" json:"file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExtraManifestConfig) Reset()         { *m = ExtraManifestConfig{} }
+func (m *ExtraManifestConfig) String() string { return proto.CompactTextString(m) }
+func (*ExtraManifestConfig) ProtoMessage()    {}
+func (*ExtraManifestConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_261260e22432516f, []int{84}
+}
+
+func (m *ExtraManifestConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExtraManifestConfig.Unmarshal(m, b)
+}
+func (m *ExtraManifestConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExtraManifestConfig.Marshal(b, m, deterministic)
+}
+func (m *ExtraManifestConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtraManifestConfig.Merge(m, src)
+}
+func (m *ExtraManifestConfig) XXX_Size() int {
+	return xxx_messageInfo_ExtraManifestConfig.Size(m)
+}
+func (m *ExtraManifestConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtraManifestConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtraManifestConfig proto.InternalMessageInfo
+
+func (m *ExtraManifestConfig) GetComponent() string {
+	if m != nil {
+		return m.Component
+	}
+	return ""
+}
+
+func (m *ExtraManifestConfig) GetManifest() string {
+	if m != nil {
+		return m.Manifest
+	}
+	return ""
+}
+
+func (m *ExtraManifestConfig) GetFile() string {
+	if m != nil {
+		return m.File
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("v1alpha1.Mode", Mode_name, Mode_value)
 	proto.RegisterEnum("v1alpha1.IngressControllerMode", IngressControllerMode_name, IngressControllerMode_value)
@@ -7809,6 +7891,7 @@ func init() {
 	proto.RegisterType((*KialiConfig)(nil), "v1alpha1.KialiConfig")
 	proto.RegisterType((*Values)(nil), "v1alpha1.Values")
 	proto.RegisterType((*ZeroVPNConfig)(nil), "v1alpha1.ZeroVPNConfig")
+	proto.RegisterType((*ExtraManifestConfig)(nil), "v1alpha1.ExtraManifestConfig")
 }
 
 func init() {
@@ -7816,441 +7899,441 @@ func init() {
 }
 
 var fileDescriptor_261260e22432516f = []byte{
-	// 6961 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x49, 0x6c, 0xe4, 0x48,
-	0x76, 0x68, 0xa7, 0xf6, 0x7c, 0x99, 0x29, 0xa5, 0x42, 0x4b, 0xb1, 0x54, 0x9b, 0x9a, 0xbd, 0xd5,
-	0x54, 0xf7, 0xa8, 0xba, 0xd4, 0xd5, 0x55, 0xdd, 0xd5, 0xcb, 0xb4, 0xb6, 0xea, 0x52, 0xb7, 0xb6,
-	0x61, 0xaa, 0xab, 0x97, 0xf9, 0x7f, 0xca, 0x21, 0x32, 0x94, 0xc9, 0x11, 0x93, 0xe4, 0x90, 0x91,
-	0x2a, 0x69, 0x00, 0xc3, 0x30, 0x7c, 0xf0, 0xc5, 0x80, 0x61, 0x63, 0x60, 0x5f, 0xbc, 0x60, 0xec,
-	0x31, 0x7c, 0x32, 0x7c, 0xf0, 0xc1, 0x17, 0x1f, 0x6d, 0xc0, 0x80, 0xe1, 0xab, 0x61, 0xcc, 0xc1,
-	0x80, 0x8f, 0x36, 0x30, 0x07, 0x1f, 0x0d, 0x0f, 0x60, 0x23, 0x16, 0xee, 0x4c, 0x25, 0x95, 0xa9,
-	0xea, 0x1a, 0x7b, 0xe6, 0x46, 0xbe, 0x78, 0x2f, 0x22, 0x18, 0xf1, 0xe2, 0xc5, 0x7b, 0x2f, 0xe2,
-	0x3d, 0xc2, 0x2d, 0xf7, 0xa8, 0x79, 0x1b, 0xbb, 0xa6, 0x7f, 0xdb, 0xf4, 0xa9, 0xe9, 0xdc, 0x3e,
-	0xbe, 0x83, 0x2d, 0xb7, 0x85, 0xef, 0xdc, 0x3e, 0xc6, 0x56, 0x87, 0xf8, 0x4f, 0xe8, 0xa9, 0x4b,
-	0xfc, 0x25, 0xd7, 0x73, 0xa8, 0x83, 0x26, 0x82, 0xc2, 0x85, 0xeb, 0x4d, 0xc7, 0x69, 0x5a, 0xe4,
-	0x36, 0x87, 0x1f, 0x74, 0x0e, 0x6f, 0x1b, 0x1d, 0x0f, 0x53, 0xd3, 0xb1, 0x05, 0xe6, 0x82, 0x7a,
-	0xf4, 0x8e, 0xbf, 0x64, 0x3a, 0xac, 0xe2, 0xdb, 0xba, 0xe3, 0x91, 0xdb, 0xc7, 0x77, 0x6e, 0x37,
-	0x89, 0x4d, 0x3c, 0x4c, 0x89, 0x21, 0x71, 0x3e, 0x6a, 0x9a, 0xb4, 0xd5, 0x39, 0x58, 0xd2, 0x9d,
-	0xf6, 0xed, 0xa6, 0xd3, 0x74, 0xa2, 0xca, 0xc2, 0x87, 0x74, 0x2b, 0x4f, 0x3d, 0xec, 0xba, 0xc4,
-	0x93, 0xfd, 0x51, 0xff, 0xb9, 0x04, 0x68, 0xc5, 0x30, 0x1c, 0x7b, 0xd3, 0x6e, 0x7a, 0xc4, 0xf7,
-	0xd7, 0x1c, 0xfb, 0xd0, 0x6c, 0xa2, 0xbb, 0x30, 0x4e, 0x6c, 0x7c, 0x60, 0x11, 0x43, 0x29, 0x2d,
-	0x96, 0x6e, 0x56, 0x96, 0x17, 0x96, 0x44, 0x45, 0x4b, 0x41, 0x45, 0x4b, 0xab, 0x8e, 0x63, 0x3d,
-	0x66, 0x1f, 0xa8, 0x05, 0xa8, 0x68, 0x16, 0x46, 0x5b, 0x8e, 0x4f, 0x7d, 0x65, 0x68, 0x71, 0xf8,
-	0x66, 0x59, 0x13, 0x2f, 0x68, 0x15, 0x2a, 0xd8, 0xb6, 0x1d, 0xca, 0x3f, 0xce, 0x57, 0x86, 0x79,
-	0x7d, 0x8b, 0x4b, 0xc1, 0x40, 0x2c, 0xed, 0x9f, 0xba, 0x64, 0x1b, 0xbb, 0x0d, 0xea, 0x99, 0x76,
-	0x73, 0xd3, 0xa6, 0xc4, 0x3b, 0xc4, 0x3a, 0xd1, 0xe2, 0x44, 0x68, 0x19, 0x86, 0xa9, 0xe5, 0x2b,
-	0x23, 0x05, 0x69, 0x19, 0xb2, 0xaa, 0x01, 0xac, 0x78, 0x7a, 0x4b, 0x7e, 0xd1, 0x2c, 0x8c, 0xe2,
-	0xb6, 0x71, 0xef, 0x2e, 0xff, 0x9e, 0x9a, 0x26, 0x5e, 0x90, 0x02, 0xe3, 0xae, 0xab, 0xdf, 0xbb,
-	0x6b, 0x11, 0x65, 0x88, 0xc3, 0x83, 0x57, 0x86, 0xef, 0xbf, 0xf5, 0xee, 0x9b, 0x27, 0xbc, 0xbf,
-	0x35, 0x4d, 0xbc, 0xa8, 0x7f, 0x37, 0x0c, 0xe5, 0xb5, 0x9d, 0xcd, 0x81, 0x46, 0xa9, 0x0e, 0xc3,
-	0xad, 0xce, 0x01, 0x6f, 0xaf, 0xac, 0xb1, 0x47, 0x06, 0xa1, 0xb8, 0xc9, 0x5b, 0x2a, 0x6b, 0xec,
-	0x91, 0xb5, 0x6e, 0xb6, 0x71, 0x93, 0xf0, 0x2f, 0x2e, 0x6b, 0xe2, 0x05, 0x5d, 0x07, 0x70, 0x3b,
-	0x96, 0xb5, 0xe7, 0x58, 0xa6, 0x7e, 0xaa, 0x8c, 0xf2, 0xa2, 0x18, 0x04, 0x5d, 0x85, 0xb2, 0x6e,
-	0x9b, 0xab, 0xa6, 0xbd, 0x6e, 0x7a, 0xca, 0x18, 0x2f, 0x8e, 0x00, 0x8c, 0x5a, 0xb7, 0x4d, 0xd6,
-	0x75, 0x56, 0x3c, 0x2e, 0xa8, 0x23, 0x08, 0xba, 0x09, 0x53, 0xf2, 0xed, 0xa1, 0x69, 0x91, 0x1d,
-	0xdc, 0x26, 0xca, 0x04, 0x47, 0x4a, 0x83, 0xd1, 0x1b, 0x30, 0x4d, 0x4e, 0x74, 0xab, 0x63, 0xf0,
-	0x57, 0xdf, 0xc5, 0x3a, 0xf1, 0x95, 0x32, 0x9f, 0xf3, 0x6c, 0x01, 0xda, 0x82, 0x49, 0xd7, 0x31,
-	0x56, 0x62, 0x2c, 0x00, 0xc5, 0xa6, 0x71, 0x75, 0x48, 0x29, 0x69, 0x29, 0x5a, 0x74, 0x13, 0xea,
-	0xae, 0xef, 0x3e, 0xd1, 0xad, 0x8e, 0x4f, 0x89, 0xf7, 0xc4, 0x73, 0x2c, 0xa2, 0x54, 0x78, 0x37,
-	0x27, 0x5d, 0xdf, 0x5d, 0x13, 0x60, 0xcd, 0xb1, 0x08, 0x5a, 0x80, 0x09, 0xcb, 0x69, 0x6e, 0x91,
-	0x63, 0x62, 0x29, 0x55, 0x8e, 0x11, 0xbe, 0xab, 0x5f, 0xc0, 0xc2, 0xda, 0xde, 0x67, 0xfb, 0xd8,
-	0x6b, 0x12, 0xfa, 0x19, 0x35, 0x2d, 0xf3, 0x07, 0xbc, 0x7a, 0x39, 0xaf, 0x0f, 0x40, 0xa1, 0xbc,
-	0x68, 0xe5, 0x98, 0x78, 0xb8, 0x49, 0x62, 0x18, 0x7c, 0xa2, 0x47, 0xb5, 0xae, 0xe5, 0xea, 0x6f,
-	0x8c, 0xc1, 0xf4, 0x1a, 0xf1, 0xe8, 0x36, 0xb6, 0x71, 0x93, 0x78, 0xcf, 0x89, 0x53, 0x5e, 0x85,
-	0xaa, 0x47, 0x5c, 0xcb, 0xd4, 0xf1, 0x9a, 0xd3, 0xb1, 0x29, 0xe7, 0x95, 0x1a, 0x1f, 0xcf, 0x04,
-	0x9c, 0x51, 0x93, 0x36, 0x36, 0x2d, 0xc9, 0x2d, 0xe2, 0x85, 0xf1, 0x11, 0x39, 0xa1, 0x1e, 0x5e,
-	0xf1, 0x9a, 0xbe, 0x32, 0xce, 0xe7, 0x35, 0x02, 0xa0, 0x47, 0x50, 0xb5, 0x1d, 0x83, 0x34, 0x88,
-	0x45, 0x74, 0xea, 0x78, 0x9c, 0x49, 0x8a, 0xce, 0x66, 0x82, 0x12, 0xbd, 0x0d, 0x65, 0x8f, 0xf8,
-	0x4e, 0xc7, 0x13, 0xfc, 0xc3, 0xaa, 0x99, 0x89, 0xaa, 0xd1, 0x82, 0x22, 0x4e, 0x19, 0x61, 0x22,
-	0x15, 0xaa, 0xae, 0x63, 0xac, 0xdb, 0xbe, 0x5c, 0x08, 0xc0, 0xfb, 0x9e, 0x80, 0xa1, 0xf5, 0x00,
-	0x47, 0x4c, 0x00, 0x67, 0x91, 0x22, 0x92, 0x23, 0x41, 0x85, 0x1c, 0xb8, 0xca, 0xd9, 0x8f, 0x9a,
-	0x2b, 0x87, 0x87, 0xa6, 0x6d, 0xd2, 0xd3, 0x2d, 0x7c, 0x40, 0xac, 0xf0, 0xd3, 0xab, 0xbc, 0xd6,
-	0xd7, 0x92, 0xb5, 0x36, 0x2c, 0x53, 0x27, 0xbb, 0x87, 0x5d, 0x46, 0xe0, 0xcc, 0x0a, 0xd1, 0x53,
-	0x58, 0x4c, 0x95, 0xef, 0x13, 0xaf, 0x9d, 0x6c, 0xb4, 0x76, 0xfe, 0x46, 0x7b, 0x56, 0x8a, 0xb6,
-	0xa1, 0x42, 0x1d, 0x8b, 0x78, 0x72, 0x85, 0x4e, 0x9e, 0xbf, 0x8d, 0x38, 0xbd, 0xfa, 0x5f, 0x25,
-	0x28, 0x87, 0xf3, 0x87, 0xee, 0xc3, 0x98, 0x65, 0xb6, 0x4d, 0xea, 0x2b, 0xa5, 0xc5, 0xe1, 0x9b,
-	0x95, 0xe5, 0x1b, 0x39, 0x93, 0xbc, 0xb4, 0xc5, 0x31, 0x36, 0x6c, 0xea, 0x9d, 0x6a, 0x12, 0x1d,
-	0x7d, 0x00, 0x13, 0x1e, 0xf9, 0x7e, 0x87, 0x04, 0x7b, 0x4a, 0x65, 0xf9, 0xc5, 0x3c, 0x52, 0x4d,
-	0xe2, 0x08, 0xe2, 0x90, 0x64, 0xe1, 0x5d, 0xa8, 0xc4, 0x6a, 0x65, 0x8b, 0xe7, 0x88, 0x9c, 0xf2,
-	0x05, 0x58, 0xd6, 0xd8, 0x23, 0x63, 0x7f, 0xbe, 0x47, 0xcb, 0x25, 0x26, 0x5e, 0x1e, 0x0c, 0xbd,
-	0x53, 0x5a, 0x78, 0x0f, 0x6a, 0x89, 0x5a, 0xcf, 0x43, 0xac, 0xfe, 0xce, 0x38, 0xd4, 0xd6, 0x1c,
-	0x8f, 0xac, 0xef, 0x34, 0x06, 0x5a, 0xff, 0x2a, 0x54, 0x75, 0x51, 0xcd, 0x26, 0x5f, 0xe2, 0xa2,
-	0xa1, 0x04, 0x8c, 0x4b, 0x75, 0xf1, 0xbe, 0x1f, 0x0a, 0x86, 0x18, 0x04, 0x2d, 0x01, 0x92, 0x6f,
-	0x7b, 0x56, 0xa7, 0x69, 0xda, 0x9b, 0x31, 0x61, 0x91, 0x53, 0x92, 0x59, 0xdd, 0xa3, 0x7d, 0xaf,
-	0xee, 0xb4, 0x0c, 0x1a, 0xeb, 0x22, 0x83, 0xb2, 0xfb, 0xc3, 0xf8, 0x00, 0xfb, 0x43, 0x42, 0xa6,
-	0x4c, 0x14, 0x96, 0x29, 0x5b, 0x30, 0xe5, 0x39, 0x96, 0x65, 0xda, 0xcd, 0x6d, 0x7c, 0xd2, 0xe8,
-	0x78, 0x4d, 0x22, 0x05, 0xd2, 0xf5, 0x64, 0x2f, 0x36, 0x6d, 0xba, 0xeb, 0x89, 0x7e, 0x3c, 0x74,
-	0xbc, 0xbd, 0x55, 0x5e, 0x4f, 0x9a, 0x14, 0x7d, 0x01, 0x73, 0x11, 0xe8, 0x33, 0x1b, 0x1f, 0x63,
-	0xd3, 0x62, 0x53, 0x2a, 0x77, 0xbe, 0x22, 0x75, 0xe6, 0x57, 0xd0, 0x53, 0x22, 0x55, 0x9e, 0x87,
-	0x44, 0xaa, 0x7e, 0x0d, 0x12, 0xa9, 0x36, 0xa0, 0x44, 0xfa, 0x02, 0x16, 0xd7, 0xc9, 0x21, 0xee,
-	0x58, 0x74, 0xcf, 0x31, 0xd6, 0x4d, 0xdf, 0xeb, 0xb8, 0xac, 0x60, 0xb5, 0x63, 0x34, 0x09, 0x1d,
-	0x64, 0x95, 0xaa, 0x9f, 0xc3, 0xbc, 0xac, 0x39, 0xe4, 0x2e, 0x59, 0x5f, 0x5c, 0x7c, 0x89, 0x0a,
-	0xf3, 0xc4, 0x57, 0x20, 0x67, 0x04, 0x51, 0x24, 0xbe, 0xd4, 0xff, 0x28, 0xc3, 0xcc, 0x06, 0xd7,
-	0xca, 0x3f, 0xc6, 0x94, 0x3c, 0xc5, 0xa7, 0xb2, 0xda, 0x87, 0x50, 0xc7, 0x1d, 0xea, 0xf8, 0x3a,
-	0xb6, 0xc8, 0x46, 0xe1, 0xfe, 0x66, 0x68, 0x98, 0x78, 0x09, 0x61, 0xdb, 0xf8, 0x44, 0x6a, 0xc0,
-	0x09, 0x58, 0x12, 0xc7, 0xb4, 0xa5, 0x36, 0x9c, 0x80, 0xa1, 0x57, 0x61, 0x52, 0x77, 0x6c, 0x9b,
-	0xe8, 0x74, 0xdf, 0x6c, 0x13, 0xa7, 0x43, 0xa5, 0x78, 0x49, 0x41, 0xd1, 0x03, 0x18, 0xd6, 0xdd,
-	0x8e, 0x94, 0x28, 0x2f, 0x47, 0x23, 0xd1, 0x5d, 0x13, 0xe3, 0xd3, 0xc8, 0x88, 0xd0, 0xb7, 0xa0,
-	0x66, 0x78, 0xd8, 0xb4, 0xd7, 0xa5, 0x91, 0xc4, 0xa5, 0x49, 0x65, 0xf9, 0x72, 0xe6, 0x83, 0x03,
-	0x04, 0x2d, 0x89, 0x1f, 0x9f, 0xdb, 0xf1, 0xe2, 0x12, 0x78, 0x19, 0x86, 0x89, 0x7d, 0x5c, 0x54,
-	0xc5, 0xd1, 0x18, 0x32, 0x7a, 0x1b, 0xc6, 0x2c, 0xc6, 0xc9, 0x81, 0x4a, 0x73, 0x2d, 0x22, 0x93,
-	0xf3, 0xc8, 0x19, 0x3d, 0x98, 0x6f, 0x89, 0x9c, 0x11, 0xbc, 0xd0, 0xb7, 0xe0, 0xcd, 0x0a, 0xd4,
-	0xca, 0x00, 0x02, 0xf5, 0x17, 0x47, 0x07, 0x7a, 0x1d, 0x46, 0x5d, 0xc7, 0xa3, 0x4c, 0xfb, 0x61,
-	0xaa, 0xc6, 0x5c, 0x54, 0xfb, 0x1e, 0x03, 0xcb, 0xf9, 0x12, 0x38, 0xc9, 0x7d, 0x66, 0xaa, 0xf0,
-	0x3e, 0xf3, 0x3e, 0xd4, 0x7c, 0xa2, 0x7b, 0x84, 0x3e, 0x76, 0xac, 0x4e, 0x9b, 0xf8, 0x4a, 0x9d,
-	0xb7, 0x35, 0x1f, 0x91, 0x36, 0x62, 0xc5, 0x5a, 0x12, 0x19, 0xed, 0x01, 0xf2, 0x89, 0x77, 0x6c,
-	0xea, 0x24, 0x3e, 0xbb, 0xd3, 0x05, 0xb9, 0x33, 0x87, 0x16, 0x21, 0x18, 0xa1, 0xa7, 0x2e, 0x51,
-	0x10, 0x5f, 0xb1, 0xfc, 0x19, 0xbd, 0x0e, 0x23, 0x3f, 0x38, 0x76, 0x6d, 0x65, 0x86, 0xd7, 0x7b,
-	0x29, 0xaa, 0xf7, 0x2b, 0xe2, 0x39, 0x8f, 0xf7, 0x76, 0xe4, 0x40, 0x70, 0xa4, 0xb4, 0x98, 0x9e,
-	0x1d, 0x50, 0x4c, 0xff, 0xac, 0x04, 0x68, 0xc3, 0x3e, 0x76, 0x4e, 0xb7, 0x09, 0xf5, 0x4c, 0x7d,
-	0x30, 0x7f, 0x04, 0x82, 0x91, 0x96, 0xe3, 0x53, 0xa9, 0x37, 0xf1, 0x67, 0x06, 0x63, 0x13, 0xc8,
-	0x05, 0xd9, 0xa8, 0xc6, 0x9f, 0xd1, 0x2a, 0x54, 0xa8, 0xe5, 0x37, 0x08, 0xa5, 0xa6, 0xdd, 0x2c,
-	0xee, 0x65, 0x88, 0x13, 0x31, 0x83, 0x83, 0xea, 0xee, 0xa7, 0x84, 0xb8, 0xd8, 0x32, 0x8f, 0x49,
-	0x51, 0xbd, 0x49, 0x4b, 0x50, 0xa9, 0xff, 0x38, 0x06, 0xd5, 0x8f, 0xb1, 0x65, 0x91, 0xd3, 0x41,
-	0x1d, 0x31, 0x66, 0x4c, 0x63, 0x94, 0x46, 0xe1, 0x5d, 0x18, 0x69, 0x13, 0xbf, 0xa5, 0x0c, 0x73,
-	0x96, 0x5b, 0x8c, 0x8b, 0xa5, 0xa8, 0xc5, 0xa5, 0x6d, 0xe2, 0xb7, 0x84, 0x22, 0xcd, 0xb1, 0x7b,
-	0xae, 0xff, 0x91, 0xe7, 0xb1, 0xfe, 0x47, 0x9f, 0xc5, 0xfa, 0x2f, 0xaa, 0xb0, 0x26, 0x96, 0xfe,
-	0x78, 0xe1, 0xa5, 0xbf, 0x0a, 0x93, 0x62, 0x7e, 0x56, 0x6c, 0x6c, 0x9d, 0xfa, 0x66, 0xa0, 0x9e,
-	0x9e, 0x35, 0xa3, 0x29, 0x8a, 0xff, 0x35, 0x6a, 0x6a, 0x4a, 0x2a, 0x54, 0x06, 0x93, 0x0a, 0x0b,
-	0xf7, 0xa1, 0x1c, 0xb2, 0xe5, 0xb9, 0x2c, 0xb1, 0x0f, 0x60, 0x26, 0x67, 0xcf, 0x65, 0x55, 0x60,
-	0xd7, 0x0d, 0xaa, 0xc0, 0xae, 0xcb, 0x57, 0x8c, 0x4f, 0x4d, 0x27, 0x5c, 0x31, 0xec, 0x45, 0xfd,
-	0xb7, 0x12, 0x4c, 0x4a, 0xfa, 0x80, 0x74, 0x07, 0x66, 0x78, 0xd9, 0x13, 0xc2, 0x35, 0xb3, 0xa6,
-	0x28, 0x95, 0x8b, 0x33, 0xb6, 0xd5, 0xe7, 0x28, 0x6e, 0x1a, 0xe2, 0x94, 0x1b, 0x71, 0xc2, 0xf8,
-	0x02, 0x1f, 0x2a, 0xbe, 0xc0, 0xbf, 0x0d, 0xb3, 0xa2, 0x17, 0xa6, 0x9d, 0xe8, 0xc6, 0x48, 0x7a,
-	0xe2, 0xa4, 0x5b, 0x37, 0xd9, 0x0f, 0xf1, 0x05, 0x9b, 0x09, 0x52, 0xf5, 0x3f, 0x15, 0xa8, 0x7e,
-	0x6c, 0x39, 0x07, 0xd8, 0x92, 0x5f, 0x7a, 0x13, 0x46, 0xb0, 0xa7, 0xb7, 0xe4, 0xa7, 0xcd, 0x46,
-	0x75, 0x46, 0x5e, 0x55, 0x8d, 0x63, 0xa0, 0x4f, 0xa1, 0xaa, 0x13, 0x8f, 0x9a, 0x87, 0xa6, 0x8e,
-	0x29, 0xf1, 0x95, 0x9b, 0xe7, 0x9a, 0x6e, 0x2d, 0x41, 0xcc, 0xdd, 0x90, 0xbc, 0xf2, 0xd0, 0x85,
-	0x28, 0xe7, 0x24, 0x0d, 0x46, 0x6f, 0xc2, 0x8c, 0x00, 0x69, 0x8e, 0x43, 0x23, 0xec, 0x65, 0x8e,
-	0x9d, 0x57, 0xc4, 0x34, 0x67, 0x01, 0x7e, 0x8c, 0x2d, 0xd3, 0x10, 0x8a, 0xe4, 0x70, 0x6f, 0xcd,
-	0x39, 0x4d, 0x83, 0xfe, 0x1f, 0x5c, 0xd1, 0x1d, 0x9b, 0x7a, 0x8e, 0xb5, 0x67, 0x61, 0x9b, 0x34,
-	0x88, 0xde, 0xf1, 0x4c, 0x7a, 0x1a, 0x28, 0xe3, 0x23, 0x3d, 0xab, 0x3c, 0x8b, 0x1c, 0x3d, 0x82,
-	0x1b, 0x86, 0x30, 0x28, 0xc4, 0x28, 0x3f, 0x36, 0x7d, 0xf3, 0xc0, 0xb4, 0x4c, 0x7a, 0x1a, 0x6e,
-	0x51, 0x77, 0xb9, 0x53, 0xae, 0x17, 0x1a, 0x7a, 0x0c, 0x33, 0x12, 0x65, 0x27, 0xae, 0x5a, 0x8e,
-	0x9d, 0x43, 0x1d, 0xcc, 0xab, 0x00, 0xd9, 0xb0, 0x60, 0x74, 0x35, 0xa6, 0xa4, 0x48, 0xbc, 0x15,
-	0x55, 0xdf, 0xcb, 0xf0, 0xe2, 0x0d, 0x9d, 0x51, 0x23, 0xda, 0x82, 0x19, 0xc3, 0xf4, 0xd9, 0xe8,
-	0x08, 0xf7, 0xde, 0x5a, 0x8b, 0xe8, 0x47, 0x45, 0xe4, 0x67, 0x1e, 0x19, 0xda, 0x83, 0xba, 0x91,
-	0x32, 0xd8, 0xa4, 0x14, 0x5d, 0xcc, 0xf4, 0x39, 0x65, 0xd2, 0xf1, 0x9e, 0x66, 0xa8, 0x23, 0xd1,
-	0xfe, 0x88, 0x58, 0xed, 0x7d, 0xe2, 0x53, 0x29, 0x41, 0x0b, 0x88, 0xf6, 0x80, 0x02, 0x7d, 0x04,
-	0x35, 0x01, 0xd9, 0xf7, 0xb0, 0x6e, 0xda, 0x81, 0xcb, 0xf2, 0xac, 0x2a, 0x92, 0x04, 0x81, 0xbb,
-	0xb8, 0x1a, 0xb9, 0x8b, 0x6f, 0xc2, 0x14, 0xdf, 0xfa, 0xf7, 0xa2, 0x53, 0x83, 0x9a, 0x58, 0x4b,
-	0x29, 0x30, 0x6a, 0x40, 0x3d, 0x04, 0x09, 0x0d, 0xd4, 0x57, 0x5e, 0x39, 0xdf, 0x32, 0xce, 0x54,
-	0xc0, 0x0c, 0x43, 0x2e, 0x69, 0xa2, 0xb5, 0x39, 0x29, 0x0c, 0xc3, 0x24, 0x14, 0xed, 0xc0, 0xb4,
-	0xe5, 0xe8, 0x98, 0xb1, 0xee, 0xd6, 0x81, 0x64, 0x5e, 0xa9, 0x53, 0xf7, 0x56, 0xa0, 0xb2, 0xa4,
-	0x68, 0x05, 0xe0, 0xe8, 0x1d, 0x5f, 0xca, 0x37, 0xa5, 0x9e, 0xb6, 0xbc, 0x3f, 0xed, 0x1c, 0x10,
-	0xcf, 0x26, 0x94, 0xf8, 0x89, 0x43, 0x2f, 0x2d, 0x46, 0x84, 0xde, 0x81, 0xb2, 0xe5, 0x34, 0x57,
-	0xfc, 0x4f, 0x7c, 0xc7, 0x56, 0x5e, 0xee, 0x39, 0x13, 0x11, 0x32, 0xba, 0x0f, 0xe3, 0x96, 0xd3,
-	0x6c, 0xb2, 0x4f, 0x98, 0xce, 0xd8, 0x7f, 0x5c, 0xbe, 0x6e, 0x89, 0x62, 0xd9, 0x6a, 0x80, 0x8d,
-	0xd6, 0xa0, 0xc6, 0x14, 0xae, 0x8d, 0x13, 0x17, 0xdb, 0x3e, 0x93, 0x4c, 0x28, 0x4d, 0xbe, 0x1d,
-	0x2f, 0x96, 0xe4, 0x49, 0x1a, 0x34, 0x0f, 0x63, 0x0c, 0xb0, 0xb9, 0xae, 0xbc, 0xcd, 0x87, 0x5a,
-	0xbe, 0x31, 0xf5, 0x94, 0x3d, 0xed, 0x10, 0xfa, 0xd4, 0xf1, 0x8e, 0x7c, 0xa9, 0xdb, 0x17, 0x50,
-	0x4f, 0xe3, 0x54, 0x6c, 0x42, 0xdb, 0x8e, 0x6d, 0x52, 0x87, 0x21, 0x31, 0xa3, 0x88, 0xeb, 0xfb,
-	0x35, 0x2d, 0x05, 0x65, 0x5b, 0x47, 0x9b, 0x5a, 0xbe, 0x32, 0x97, 0xde, 0x3a, 0xb6, 0xf7, 0xb7,
-	0x1a, 0xc1, 0xd6, 0xc1, 0x30, 0xd0, 0x47, 0x50, 0x6d, 0x77, 0x2c, 0x6a, 0xca, 0x83, 0x1b, 0x65,
-	0x9e, 0x53, 0x5c, 0x8d, 0x51, 0xc4, 0x4a, 0x25, 0x65, 0x82, 0x02, 0x29, 0x30, 0x6e, 0x8b, 0xfe,
-	0x29, 0xaf, 0xf1, 0x4f, 0x0e, 0x5e, 0xd1, 0x3d, 0x98, 0x77, 0x1d, 0x63, 0x7d, 0xa7, 0xd1, 0x20,
-	0x6c, 0x9b, 0x8a, 0x9d, 0x55, 0xbd, 0xce, 0xc5, 0x67, 0x97, 0x52, 0xf4, 0x5d, 0xb8, 0xea, 0xb4,
-	0x4d, 0xda, 0x30, 0x0d, 0xa2, 0x63, 0x6f, 0xd3, 0xfe, 0x1e, 0x17, 0x7a, 0xa2, 0xf1, 0x6d, 0xec,
-	0x2a, 0xaf, 0xf6, 0x64, 0x87, 0x33, 0xe9, 0xd1, 0x87, 0x50, 0x75, 0xec, 0xe8, 0x84, 0x4c, 0xb9,
-	0xd4, 0xb3, 0xbe, 0x04, 0x3e, 0xd2, 0x60, 0xde, 0x71, 0x99, 0x6e, 0xe4, 0x78, 0xe2, 0x94, 0xe9,
-	0x73, 0x72, 0xd0, 0x72, 0x9c, 0x23, 0x5f, 0xf9, 0x46, 0xcf, 0x9a, 0xba, 0x50, 0xa2, 0xef, 0xc0,
-	0x9c, 0xd3, 0xa1, 0x07, 0x4e, 0xc7, 0x36, 0xf6, 0x3d, 0x7c, 0x78, 0x68, 0xea, 0x52, 0x5e, 0x28,
-	0xbc, 0xca, 0x57, 0xa2, 0x09, 0xd9, 0xcd, 0x43, 0x93, 0x33, 0x93, 0x5f, 0x07, 0x13, 0xdf, 0x6e,
-	0x24, 0x80, 0x1f, 0x62, 0xd3, 0xda, 0x75, 0x89, 0xad, 0x5c, 0xee, 0x2d, 0xbe, 0x73, 0xc8, 0x98,
-	0x50, 0x13, 0xe0, 0x68, 0x04, 0x17, 0x84, 0x50, 0x4b, 0x81, 0xd1, 0x9b, 0x30, 0xed, 0x7a, 0xa6,
-	0xc3, 0xf6, 0xd6, 0x35, 0x0b, 0xfb, 0x3e, 0x3f, 0xd3, 0xbc, 0xc2, 0x70, 0xb9, 0x1c, 0xcf, 0x16,
-	0x32, 0x95, 0xc2, 0xf5, 0x9c, 0x36, 0xa1, 0x2d, 0xd2, 0xf1, 0xa3, 0xfa, 0xdf, 0x12, 0x2a, 0x45,
-	0x4e, 0x11, 0x77, 0x1a, 0x78, 0xce, 0xc9, 0xa9, 0x72, 0x95, 0x7f, 0x4d, 0xdc, 0x69, 0xc0, 0xc0,
-	0xa1, 0xd3, 0x80, 0xbd, 0xa0, 0xfb, 0x50, 0xe6, 0x0f, 0x9b, 0xb6, 0x49, 0x95, 0x6b, 0xd2, 0x83,
-	0x95, 0x24, 0x60, 0x45, 0x92, 0x28, 0xc2, 0x45, 0xaf, 0xc0, 0xb0, 0x6f, 0xf8, 0xca, 0xf5, 0xb4,
-	0xb1, 0xd1, 0x58, 0x0f, 0x96, 0x13, 0x2b, 0x0f, 0x8e, 0x07, 0x6f, 0x44, 0xc7, 0x83, 0x4b, 0x80,
-	0x28, 0xb1, 0x48, 0x9b, 0x50, 0x2f, 0x36, 0x5e, 0x8b, 0xc2, 0xfd, 0x9f, 0x2d, 0x41, 0x4b, 0x30,
-	0x46, 0x3d, 0xac, 0x13, 0x4f, 0x79, 0x91, 0xb7, 0x15, 0x73, 0x4c, 0xec, 0x73, 0x78, 0xe0, 0xb5,
-	0x12, 0x58, 0x68, 0x11, 0x2a, 0xd4, 0xeb, 0xf8, 0x74, 0xdd, 0x69, 0x63, 0xd3, 0x56, 0x54, 0x5e,
-	0x71, 0x1c, 0xc4, 0x7b, 0x10, 0xbd, 0xae, 0x58, 0x26, 0xf6, 0x89, 0xaf, 0xdc, 0xe2, 0x2b, 0x30,
-	0xa7, 0x04, 0x2d, 0xc3, 0x58, 0xc7, 0x27, 0xdb, 0x6b, 0x7b, 0xca, 0x4b, 0x3d, 0xf9, 0x43, 0x62,
-	0xa2, 0xf7, 0xa1, 0xc2, 0xb7, 0x14, 0x8d, 0xb4, 0x1d, 0x4a, 0x94, 0x37, 0x7a, 0x12, 0xc6, 0xd1,
-	0xd1, 0x63, 0x50, 0x74, 0x8f, 0x60, 0x4a, 0xc4, 0x7b, 0xe3, 0x58, 0xdf, 0xb0, 0x0d, 0xd7, 0x31,
-	0x6d, 0xea, 0x2b, 0xdf, 0xec, 0x59, 0x55, 0x57, 0x5a, 0x26, 0x47, 0x3c, 0x0e, 0xdd, 0x33, 0x2d,
-	0x87, 0xae, 0x71, 0xb4, 0x18, 0x82, 0xb2, 0xd4, 0x5b, 0x8e, 0x9c, 0x45, 0xcf, 0x98, 0x55, 0x96,
-	0x73, 0xbe, 0x5f, 0x31, 0x0c, 0xbe, 0xdf, 0xdd, 0x16, 0xcc, 0x9a, 0x53, 0xc4, 0xe6, 0x22, 0x56,
-	0x63, 0x40, 0xf0, 0xa6, 0xe0, 0x86, 0x6c, 0x09, 0x93, 0xa0, 0x02, 0xba, 0x1f, 0x70, 0x4a, 0x40,
-	0x73, 0x87, 0xd3, 0x74, 0x29, 0x65, 0x5c, 0xc4, 0x07, 0xd8, 0x50, 0xee, 0xa5, 0xb9, 0x68, 0x93,
-	0xc3, 0x03, 0x2e, 0x12, 0x58, 0xea, 0x3a, 0x54, 0xe3, 0xf0, 0x3e, 0x1d, 0xf1, 0xaf, 0xc3, 0x4c,
-	0xce, 0x06, 0xcb, 0x4c, 0x3b, 0x8b, 0x5f, 0x02, 0x10, 0xe6, 0x9e, 0x78, 0x51, 0x7f, 0x7f, 0x06,
-	0x66, 0xf3, 0x8c, 0xa3, 0x5f, 0x48, 0xef, 0xfa, 0x47, 0x50, 0xd3, 0x3b, 0x3e, 0x75, 0xda, 0x0d,
-	0xe1, 0x21, 0x94, 0x16, 0xc2, 0x99, 0xba, 0x67, 0x82, 0x80, 0x0d, 0xb2, 0x41, 0x0e, 0x3a, 0x4d,
-	0x79, 0xaf, 0x44, 0xbc, 0x30, 0x6d, 0xc4, 0x10, 0x82, 0x41, 0xdc, 0x24, 0x91, 0x6f, 0x59, 0x6f,
-	0x7e, 0xb9, 0x7f, 0x6f, 0x3e, 0x9c, 0xdb, 0x9b, 0x5f, 0x39, 0x8f, 0x37, 0x7f, 0x11, 0x2a, 0xe4,
-	0x84, 0x12, 0xcf, 0xc6, 0xd6, 0xe6, 0x9e, 0xaf, 0x54, 0xb9, 0xdc, 0x8a, 0x83, 0xd0, 0x83, 0x84,
-	0xb6, 0x59, 0xeb, 0xd9, 0x9d, 0xb8, 0x9a, 0xb9, 0x0e, 0x53, 0xd1, 0xdb, 0x23, 0x4a, 0xdd, 0xe0,
-	0xe8, 0xfd, 0xac, 0x0a, 0xd2, 0x24, 0xb1, 0x13, 0x87, 0xa9, 0xf3, 0x9c, 0x38, 0xbc, 0x0a, 0x93,
-	0x96, 0x83, 0x8d, 0x55, 0x6c, 0x61, 0x5b, 0x27, 0xde, 0xe6, 0x1e, 0x57, 0x95, 0xcb, 0x5a, 0x0a,
-	0x8a, 0x1e, 0x80, 0x12, 0x87, 0x34, 0xb8, 0xd1, 0xa3, 0x61, 0xbb, 0x49, 0x7c, 0x65, 0x9a, 0x8f,
-	0x47, 0xd7, 0x72, 0xb4, 0x01, 0x28, 0xa1, 0xa0, 0x72, 0x4f, 0xba, 0x82, 0xce, 0x72, 0xb0, 0xe7,
-	0x10, 0x64, 0x0e, 0x47, 0x66, 0x2e, 0xf0, 0x70, 0x64, 0xf6, 0x19, 0x1e, 0x8e, 0xcc, 0x3d, 0x0f,
-	0xe7, 0xe8, 0xfc, 0x33, 0x3d, 0x1c, 0xb9, 0x54, 0xe0, 0x70, 0x24, 0xed, 0x49, 0x55, 0xba, 0x78,
-	0x52, 0x57, 0xe3, 0x9e, 0xd4, 0xcb, 0xe7, 0x98, 0x87, 0x98, 0x5b, 0xf5, 0x2d, 0xa1, 0x1a, 0x2d,
-	0xa4, 0xad, 0xbc, 0xa4, 0x70, 0x6f, 0x18, 0x7e, 0x5c, 0x51, 0xca, 0x1c, 0xc3, 0x5c, 0x19, 0xfc,
-	0x18, 0xe6, 0xea, 0x05, 0x1c, 0xc3, 0x5c, 0x8b, 0x1d, 0xc3, 0xdc, 0x93, 0xc7, 0x30, 0x42, 0xe9,
-	0x53, 0xbb, 0x7d, 0xd9, 0x57, 0xc7, 0xae, 0x9d, 0x38, 0x91, 0xc9, 0xf1, 0x11, 0xdf, 0x78, 0x06,
-	0x3e, 0xe2, 0xc5, 0x41, 0x7d, 0xc4, 0xb7, 0xa0, 0x8e, 0x5d, 0xce, 0x0c, 0x34, 0x14, 0x0c, 0x2f,
-	0xf2, 0xef, 0xcf, 0xc0, 0xd1, 0x5d, 0x98, 0x0b, 0x44, 0x6e, 0xd2, 0x3c, 0x11, 0x0a, 0x67, 0x7e,
-	0x61, 0xda, 0x0b, 0xfd, 0xd2, 0x60, 0x5e, 0x68, 0xf4, 0x29, 0x54, 0xa5, 0xbb, 0x55, 0x74, 0xf6,
-	0xe5, 0x73, 0xba, 0x39, 0xe3, 0xc4, 0xe8, 0x3b, 0x30, 0x8b, 0x0d, 0xc3, 0x64, 0x35, 0x73, 0x8f,
-	0x2b, 0xc5, 0xa6, 0x4d, 0xbc, 0x73, 0x3b, 0x5d, 0x72, 0x2b, 0x41, 0xdb, 0x50, 0x93, 0x3e, 0x4b,
-	0xc9, 0xde, 0xaf, 0x9e, 0xaf, 0xd6, 0x24, 0x35, 0x33, 0x58, 0x13, 0xfe, 0xdd, 0xd7, 0x7a, 0x1b,
-	0xac, 0x09, 0x97, 0xee, 0x1b, 0xe2, 0xf6, 0xee, 0xcd, 0x9e, 0x64, 0xfc, 0xde, 0xee, 0x1f, 0x94,
-	0xe0, 0x52, 0x97, 0xc5, 0x7b, 0xa1, 0xc7, 0x61, 0x89, 0x63, 0x9c, 0xe1, 0xa2, 0xc7, 0x38, 0x6a,
-	0x0b, 0x94, 0x6e, 0x0b, 0xb0, 0xcf, 0xee, 0xcd, 0xc3, 0x98, 0xdf, 0x39, 0x3c, 0x34, 0x4f, 0x64,
-	0xff, 0xe4, 0x9b, 0xfa, 0x39, 0xdc, 0x88, 0x5c, 0x55, 0x1b, 0xf6, 0xf1, 0xb6, 0x79, 0x42, 0xbc,
-	0x15, 0x03, 0xbb, 0x74, 0xb0, 0x7b, 0xa5, 0xea, 0x5f, 0x96, 0xe0, 0x52, 0x17, 0x27, 0x58, 0x9f,
-	0x9f, 0xf0, 0x3e, 0x54, 0xa4, 0x3b, 0x93, 0xeb, 0x30, 0xbd, 0x4f, 0x32, 0xe2, 0xe8, 0x4c, 0xc7,
-	0x92, 0xa7, 0x10, 0xdc, 0x42, 0x17, 0x97, 0xd8, 0xe2, 0x20, 0xd5, 0x00, 0xb4, 0xe5, 0x60, 0xa3,
-	0xd1, 0x22, 0x86, 0x11, 0x69, 0xf6, 0xb7, 0xa0, 0x6e, 0x61, 0x4a, 0x6c, 0xfd, 0x74, 0xbf, 0xe5,
-	0x11, 0xbf, 0xe5, 0x58, 0x86, 0x54, 0xf2, 0x33, 0x70, 0xa4, 0xc2, 0x48, 0xdb, 0x31, 0x04, 0x0b,
-	0x4c, 0x2e, 0x4f, 0x46, 0x13, 0xcd, 0xa0, 0x1a, 0x2f, 0x53, 0x3d, 0x80, 0xc8, 0x41, 0xd5, 0xe7,
-	0x48, 0x2c, 0xc1, 0x08, 0x53, 0xdf, 0x0b, 0x0c, 0x01, 0xc7, 0x53, 0x7f, 0x0d, 0x66, 0x72, 0xdc,
-	0x7a, 0x7d, 0x36, 0x2e, 0x6c, 0xe7, 0xcd, 0xad, 0xd5, 0x02, 0xcd, 0x4b, 0x4c, 0xf5, 0xbf, 0x87,
-	0xe0, 0x2a, 0xe7, 0xac, 0x98, 0x15, 0xc7, 0x59, 0x2c, 0xe0, 0x88, 0x5d, 0xa8, 0x1d, 0x85, 0xcc,
-	0xc2, 0xf4, 0x67, 0xd1, 0xa1, 0x6f, 0xe4, 0x39, 0x54, 0x73, 0xb9, 0x54, 0x4b, 0xd2, 0xa3, 0x87,
-	0x00, 0x91, 0x27, 0x45, 0xf6, 0xf4, 0xd5, 0x84, 0x1b, 0x44, 0x96, 0xe5, 0x54, 0x15, 0xa3, 0x44,
-	0xf7, 0x61, 0xd4, 0xa7, 0x86, 0xe9, 0xc8, 0xc5, 0x1b, 0xdb, 0xfb, 0x1b, 0x0c, 0x9c, 0x43, 0x2d,
-	0xf0, 0xd1, 0x26, 0x54, 0x7c, 0x8a, 0xf5, 0x23, 0xc3, 0x33, 0x8f, 0x49, 0xce, 0x09, 0x76, 0x23,
-	0x2a, 0xcc, 0xa9, 0x24, 0x4e, 0x8b, 0x56, 0x61, 0xb2, 0xe3, 0x93, 0x00, 0x41, 0x5b, 0xf7, 0xa5,
-	0x01, 0x76, 0xa6, 0xe7, 0x3f, 0x49, 0xa1, 0xfe, 0x6c, 0x08, 0x2e, 0xf3, 0x76, 0x02, 0x63, 0xfd,
-	0x97, 0xc3, 0xff, 0x75, 0x0e, 0xff, 0xdf, 0x96, 0xa0, 0xc2, 0xdb, 0x91, 0x03, 0xfe, 0x16, 0x8c,
-	0x09, 0x47, 0xa2, 0x1c, 0xe9, 0x2b, 0x31, 0x67, 0x74, 0x34, 0x4b, 0x81, 0x2d, 0x25, 0x50, 0xd1,
-	0xfb, 0x50, 0x0e, 0xbd, 0x69, 0x72, 0x4c, 0xaf, 0xa7, 0xe8, 0xc2, 0xf5, 0x15, 0xb8, 0xf7, 0x42,
-	0x02, 0xb4, 0x0a, 0x13, 0x58, 0xce, 0xba, 0x1c, 0xcd, 0x57, 0xbb, 0x11, 0x27, 0xb9, 0x43, 0x0b,
-	0xe9, 0xd4, 0x9f, 0x8e, 0xc0, 0x74, 0xa6, 0x7f, 0x3f, 0x77, 0xde, 0x0c, 0xe9, 0xa5, 0x18, 0xe9,
-	0xc7, 0x4b, 0x11, 0x93, 0x89, 0xa3, 0x7d, 0x6c, 0xfe, 0x63, 0xf1, 0xcd, 0xff, 0x62, 0x2f, 0x1d,
-	0xa7, 0xed, 0x9d, 0x89, 0x2e, 0xf6, 0xce, 0xb7, 0x62, 0xf3, 0x2c, 0x5c, 0x1e, 0x2f, 0xe5, 0x32,
-	0x57, 0xb7, 0x49, 0x46, 0x1a, 0xcc, 0xfb, 0xc4, 0x67, 0xfb, 0x44, 0x60, 0xa9, 0x6d, 0x14, 0x76,
-	0x83, 0x74, 0xa1, 0x4c, 0xea, 0x41, 0x95, 0xc2, 0x7a, 0xd0, 0xbf, 0x03, 0xcc, 0xe6, 0xf1, 0x75,
-	0x2e, 0xcb, 0x0d, 0x5d, 0x00, 0xcb, 0x0d, 0x17, 0x60, 0xb9, 0x91, 0xee, 0x2c, 0x37, 0x3a, 0x20,
-	0xcb, 0x8d, 0x9d, 0xdb, 0xcf, 0x34, 0x7e, 0x1e, 0x3f, 0x53, 0xc8, 0xa6, 0x13, 0x71, 0x36, 0xfd,
-	0x08, 0xaa, 0x96, 0x83, 0x0d, 0x5f, 0xea, 0x3d, 0x92, 0x69, 0x62, 0xc7, 0x63, 0x59, 0xad, 0x48,
-	0x4b, 0x50, 0xfc, 0xdc, 0x5e, 0x2b, 0x4d, 0x2f, 0x99, 0x6a, 0xd7, 0xe8, 0x80, 0x8c, 0x35, 0x3b,
-	0xf5, 0x0c, 0xac, 0xd9, 0xfa, 0xa0, 0xd6, 0x6c, 0x74, 0x6c, 0x31, 0x5d, 0xf8, 0xd8, 0x82, 0xbb,
-	0xe3, 0x5d, 0xc7, 0xa3, 0xab, 0x98, 0xea, 0xad, 0x6d, 0x7c, 0xb2, 0x6f, 0xb6, 0x83, 0xab, 0x98,
-	0x39, 0x25, 0xcc, 0x0a, 0x4e, 0x42, 0x37, 0x6c, 0xea, 0x99, 0x44, 0x9c, 0xe6, 0xd6, 0xb4, 0xfc,
-	0xc2, 0xe4, 0xfa, 0xae, 0x15, 0xbe, 0xae, 0xd6, 0x5d, 0xd4, 0x4c, 0xf6, 0x2d, 0x6a, 0x7a, 0xb9,
-	0xcb, 0x66, 0x9f, 0x87, 0xbb, 0x6c, 0xee, 0x6b, 0x88, 0x5e, 0x98, 0x1f, 0xf0, 0x5a, 0xac, 0x05,
-	0x28, 0x7b, 0x10, 0xde, 0xa7, 0x91, 0xb0, 0x08, 0x15, 0x19, 0x3d, 0xc9, 0xad, 0x2d, 0x61, 0x73,
-	0xc6, 0x41, 0xea, 0x0f, 0x47, 0x60, 0x6a, 0xc7, 0x31, 0xc8, 0x4a, 0x93, 0xd8, 0x74, 0x40, 0x83,
-	0x84, 0x4b, 0xc2, 0xa1, 0xbe, 0x24, 0xe1, 0x70, 0x5c, 0x12, 0xa6, 0xe5, 0xd8, 0x48, 0xdf, 0x72,
-	0x2c, 0x35, 0x35, 0xa3, 0x03, 0x7a, 0x85, 0x7a, 0xf1, 0xf4, 0xd8, 0xf3, 0xe0, 0xe9, 0xf1, 0x67,
-	0xc0, 0xd3, 0xea, 0x6f, 0x96, 0xe0, 0xca, 0x19, 0xa7, 0xff, 0xe8, 0xc3, 0x84, 0x89, 0x7d, 0xab,
-	0xd0, 0x95, 0x81, 0xa5, 0xed, 0xc8, 0xfc, 0xbe, 0x09, 0x23, 0xec, 0x0d, 0xd5, 0xa0, 0xbc, 0xb2,
-	0xb5, 0xb5, 0xfb, 0xf9, 0x93, 0x95, 0x9d, 0x2f, 0xeb, 0x2f, 0xa0, 0x69, 0xa8, 0x69, 0x1b, 0x1f,
-	0x6f, 0x36, 0xf6, 0xb5, 0x2f, 0x9f, 0xec, 0xee, 0x6c, 0x7d, 0x59, 0x2f, 0xa9, 0x3f, 0x9d, 0x82,
-	0x8a, 0x38, 0x14, 0x1d, 0x84, 0x39, 0x9f, 0x89, 0xa2, 0xd2, 0x45, 0xef, 0x4d, 0x2b, 0x33, 0x23,
-	0x39, 0xca, 0xcc, 0x39, 0x82, 0x76, 0x73, 0x34, 0xda, 0xbb, 0x30, 0xee, 0x8b, 0x1b, 0x27, 0x45,
-	0x02, 0x5c, 0x24, 0x2a, 0x7a, 0x19, 0x6a, 0xfc, 0x24, 0xbf, 0x81, 0xdb, 0x2e, 0xdb, 0xd5, 0xb8,
-	0xfa, 0x51, 0xd2, 0x92, 0xc0, 0x7e, 0x03, 0x75, 0x73, 0xae, 0x72, 0x42, 0xfe, 0x55, 0x4e, 0xa9,
-	0xa3, 0x55, 0xfa, 0xd1, 0xd1, 0xd2, 0x92, 0xa1, 0xda, 0xb7, 0x64, 0xd0, 0xe1, 0xc6, 0x51, 0x70,
-	0x15, 0x9f, 0xa9, 0x0c, 0xc4, 0x3b, 0xe6, 0xb2, 0xd6, 0x26, 0x3a, 0x6b, 0x78, 0xa5, 0x49, 0xe4,
-	0xfe, 0x79, 0xc6, 0x41, 0x65, 0xaf, 0x1a, 0xd0, 0x16, 0xd4, 0x0d, 0xe2, 0x5a, 0xce, 0x69, 0x9b,
-	0xd8, 0x54, 0x9c, 0xcb, 0xc9, 0x1d, 0xb5, 0xb7, 0x7c, 0xcc, 0x50, 0x66, 0xee, 0x75, 0x4d, 0xf5,
-	0x75, 0xaf, 0xab, 0x97, 0x0c, 0xab, 0x3f, 0x0f, 0x19, 0x36, 0xfd, 0x2c, 0xf6, 0xe5, 0x77, 0xa0,
-	0xac, 0x87, 0x17, 0xb9, 0x50, 0xef, 0x7b, 0x7d, 0x21, 0x32, 0xba, 0x07, 0xe3, 0xd2, 0x81, 0x2f,
-	0x4f, 0x1f, 0x63, 0x5a, 0x38, 0x97, 0x45, 0xc9, 0xcb, 0x84, 0x01, 0x72, 0x4c, 0x31, 0x9c, 0x2d,
-	0xac, 0x18, 0xca, 0x6d, 0x73, 0xee, 0x3c, 0xdb, 0x66, 0xe4, 0xb6, 0x98, 0x4f, 0xbb, 0x2d, 0x78,
-	0xf7, 0x72, 0xdd, 0x16, 0x39, 0xda, 0xb5, 0xf2, 0x0c, 0xb4, 0xeb, 0xcb, 0x17, 0x1c, 0x4f, 0xb0,
-	0x30, 0xe0, 0x9e, 0xbd, 0x0d, 0x35, 0xec, 0xba, 0xb1, 0x0b, 0x81, 0x57, 0xce, 0x79, 0x3e, 0x92,
-	0xa0, 0x46, 0x2d, 0x78, 0x51, 0xec, 0x29, 0x7b, 0x6c, 0x4a, 0x75, 0xc7, 0x6a, 0xd8, 0x26, 0xe3,
-	0x40, 0xf6, 0x5d, 0xc1, 0xde, 0x27, 0x8f, 0x07, 0xcf, 0x9a, 0xfd, 0xde, 0x95, 0xa0, 0x43, 0x58,
-	0xec, 0x8a, 0xb4, 0x69, 0x8b, 0x86, 0xae, 0xf5, 0x6c, 0xa8, 0x67, 0x1d, 0x39, 0xb6, 0xde, 0xf5,
-	0x01, 0x6c, 0xbd, 0x6f, 0x41, 0x55, 0xac, 0x23, 0x71, 0x35, 0x40, 0x1e, 0x47, 0xa6, 0x19, 0x74,
-	0x2d, 0x86, 0xa2, 0x25, 0x08, 0xd4, 0xbf, 0x2e, 0x01, 0xca, 0xae, 0x31, 0x7e, 0xbf, 0x58, 0x00,
-	0x82, 0x7b, 0x2b, 0x25, 0x79, 0xbf, 0x38, 0x01, 0x45, 0x9f, 0xc1, 0x9c, 0x19, 0x12, 0x52, 0xc6,
-	0x61, 0xc4, 0xdb, 0x8e, 0x34, 0x95, 0x58, 0x3a, 0x82, 0x5c, 0x34, 0x2d, 0x9f, 0x9a, 0xed, 0xe9,
-	0x41, 0x81, 0x85, 0x7d, 0x5f, 0xea, 0xab, 0x09, 0x98, 0xba, 0x09, 0xd3, 0x99, 0xd5, 0xd7, 0xe7,
-	0xa9, 0xcd, 0x8f, 0x4a, 0x30, 0x95, 0xf6, 0xb5, 0xf4, 0xa7, 0xf8, 0xbc, 0x0e, 0x43, 0xc7, 0x77,
-	0xa4, 0xaa, 0x13, 0x9b, 0x85, 0xb0, 0xf2, 0xc7, 0x77, 0xa4, 0x98, 0x18, 0x3a, 0xbe, 0xc3, 0x91,
-	0x97, 0xa5, 0x57, 0x32, 0x17, 0x79, 0x39, 0x44, 0x5e, 0x66, 0x9f, 0x9b, 0xa9, 0xa5, 0xcf, 0xcf,
-	0xfd, 0x49, 0x29, 0x5e, 0xd7, 0xf2, 0x40, 0x1f, 0xbc, 0x91, 0xe3, 0xf2, 0x7e, 0x25, 0xf7, 0x5b,
-	0x22, 0xef, 0x77, 0x8e, 0xc7, 0xfb, 0x51, 0xd2, 0x71, 0x9d, 0xf1, 0xd4, 0xc6, 0xea, 0xe1, 0x3e,
-	0xec, 0x75, 0x8e, 0x97, 0xe3, 0xb7, 0x56, 0x1b, 0x70, 0xe5, 0x8c, 0x46, 0xfb, 0x1c, 0xb1, 0xbf,
-	0x1f, 0x82, 0xab, 0x67, 0x75, 0xa1, 0xcf, 0xc1, 0xbb, 0x1b, 0x5d, 0x68, 0x2f, 0x10, 0xa1, 0x14,
-	0xdc, 0x66, 0x7f, 0x00, 0x10, 0x5d, 0x0a, 0x2f, 0x10, 0x64, 0x13, 0xc3, 0x46, 0xf7, 0x60, 0x82,
-	0x3a, 0xae, 0x63, 0x39, 0xcd, 0xd3, 0x02, 0xb1, 0x34, 0x21, 0x2e, 0x7a, 0xc4, 0xaf, 0xca, 0x1d,
-	0x9a, 0xcd, 0xdd, 0x63, 0xe2, 0x79, 0xa6, 0x51, 0x3c, 0x0a, 0x33, 0x45, 0xa7, 0x6e, 0xc8, 0x65,
-	0x1b, 0x97, 0x49, 0xe8, 0x4d, 0x98, 0xf1, 0x3b, 0x07, 0xbe, 0xee, 0x99, 0x07, 0xc4, 0x88, 0x42,
-	0x47, 0x84, 0xcc, 0xc9, 0x2b, 0x52, 0xbf, 0x0f, 0x95, 0xd8, 0xed, 0x18, 0x84, 0x60, 0xc4, 0x66,
-	0x26, 0xb7, 0xa0, 0xe0, 0xcf, 0x61, 0x3c, 0xea, 0x50, 0x2c, 0x1e, 0x75, 0x01, 0x26, 0x98, 0x5e,
-	0xba, 0x17, 0xc5, 0xa9, 0x86, 0xef, 0xe8, 0x3a, 0x80, 0xc8, 0x3d, 0xc4, 0x4b, 0x47, 0x78, 0x69,
-	0x0c, 0xa2, 0xfe, 0xcb, 0x38, 0xd4, 0x33, 0xfc, 0x14, 0xde, 0x70, 0x8d, 0x4a, 0x82, 0x4e, 0x16,
-	0xe0, 0x84, 0xae, 0xb4, 0x7d, 0x06, 0xaf, 0xa5, 0xed, 0x9c, 0xe1, 0x2e, 0x76, 0x8e, 0x8c, 0x67,
-	0x19, 0xc9, 0xa4, 0x3f, 0x1a, 0x8d, 0xee, 0x37, 0x5f, 0x65, 0x96, 0x09, 0x25, 0x76, 0x98, 0x13,
-	0xa0, 0xac, 0x45, 0x80, 0x8c, 0x69, 0x30, 0xde, 0xb7, 0x69, 0xb0, 0x02, 0x93, 0xbe, 0xee, 0x61,
-	0xae, 0xb5, 0x10, 0xef, 0x18, 0x5b, 0x32, 0xf8, 0xe8, 0x0c, 0x4b, 0x20, 0x45, 0xc0, 0xfd, 0x2e,
-	0x8e, 0x4d, 0xc9, 0x09, 0xdd, 0xc3, 0xb4, 0xc5, 0xcd, 0xa8, 0xb2, 0x16, 0x07, 0xc5, 0x55, 0x4c,
-	0x48, 0xab, 0x98, 0xd9, 0x24, 0x6d, 0x91, 0x8a, 0xf9, 0x1e, 0x8c, 0xcb, 0x3b, 0x45, 0xd2, 0x82,
-	0x7a, 0x31, 0xef, 0x38, 0x4f, 0xee, 0x86, 0x01, 0xb1, 0xa4, 0x40, 0x1f, 0xc2, 0x84, 0x2f, 0x03,
-	0xd0, 0xa4, 0xe9, 0xa4, 0xe6, 0x53, 0x0b, 0x9c, 0xe0, 0x48, 0x22, 0xa0, 0xb9, 0xe0, 0x3c, 0x1d,
-	0x3d, 0x4d, 0x91, 0xc9, 0xe7, 0x61, 0x8a, 0x4c, 0x3d, 0x0b, 0x53, 0x24, 0x61, 0x54, 0xd7, 0x0b,
-	0x9f, 0xbb, 0xfc, 0x59, 0x09, 0xae, 0x9e, 0x75, 0x46, 0xdb, 0xa7, 0x94, 0xdf, 0x85, 0xb9, 0xb6,
-	0x08, 0xb9, 0xdf, 0x38, 0x71, 0x4d, 0xef, 0x34, 0xbc, 0x9a, 0x3b, 0xd4, 0x8b, 0xcf, 0xf3, 0xe9,
-	0xd4, 0x3d, 0x50, 0xba, 0x71, 0x4f, 0x9f, 0xfb, 0xdb, 0x8f, 0x4b, 0x70, 0xa9, 0x0b, 0x3b, 0xa7,
-	0x93, 0x0c, 0x96, 0xfa, 0x49, 0x32, 0xb8, 0x11, 0x13, 0xbb, 0x43, 0xe9, 0x43, 0xf6, 0x4c, 0xc3,
-	0x3b, 0x12, 0x35, 0x58, 0x10, 0x01, 0xa9, 0x7a, 0x04, 0x37, 0x7a, 0x20, 0xf7, 0x9f, 0xce, 0x20,
-	0xdc, 0x2a, 0x6a, 0x62, 0xab, 0x50, 0xff, 0xa8, 0x06, 0x95, 0x58, 0xa0, 0x49, 0xbc, 0xe6, 0x97,
-	0x8a, 0xd7, 0xfc, 0x32, 0xd4, 0xb0, 0xae, 0x13, 0xdf, 0xdf, 0x72, 0x9a, 0x0f, 0x4d, 0x2b, 0xd8,
-	0xa1, 0x92, 0x40, 0x74, 0x13, 0xa6, 0x22, 0x80, 0xe3, 0xb5, 0x71, 0x90, 0x59, 0x21, 0x0d, 0x46,
-	0x9b, 0x30, 0x1d, 0x82, 0x36, 0x6c, 0xdd, 0x31, 0x02, 0x1d, 0x60, 0x32, 0xae, 0x42, 0x66, 0x50,
-	0xb4, 0x2c, 0x15, 0xdb, 0xef, 0x70, 0x87, 0x3a, 0x22, 0x8a, 0x4a, 0xee, 0x05, 0x31, 0x08, 0xeb,
-	0xba, 0x74, 0x5d, 0xcb, 0x10, 0x14, 0xb1, 0x39, 0x24, 0x81, 0xe8, 0x0d, 0x98, 0xd6, 0x9d, 0xb6,
-	0xeb, 0xd8, 0xc4, 0xa6, 0x5b, 0x41, 0x52, 0x40, 0xb1, 0x5d, 0x64, 0x0b, 0xa4, 0xa4, 0xd6, 0x3b,
-	0x9e, 0x47, 0x6c, 0xfd, 0x94, 0xef, 0x1a, 0x35, 0x2d, 0x0e, 0x62, 0xdb, 0x81, 0x61, 0xfb, 0x1a,
-	0x39, 0xf4, 0x88, 0xdf, 0xd2, 0x30, 0x25, 0x05, 0xb6, 0x83, 0x24, 0x41, 0x14, 0x33, 0xca, 0x33,
-	0x85, 0x75, 0xda, 0xae, 0x74, 0xac, 0x15, 0x88, 0x19, 0x0d, 0x28, 0xd0, 0x0e, 0xcc, 0x90, 0x58,
-	0xb2, 0x8c, 0xc0, 0x0a, 0xca, 0x6c, 0x1e, 0xd9, 0x8c, 0x1a, 0x5a, 0x1e, 0x21, 0xfa, 0x10, 0x2a,
-	0x1c, 0xdc, 0xa0, 0x98, 0xfa, 0x86, 0xdc, 0x4c, 0xce, 0xae, 0x27, 0x4e, 0xc0, 0x34, 0x24, 0x99,
-	0xff, 0x51, 0x1a, 0x92, 0xe2, 0xa2, 0xa4, 0x88, 0x48, 0xcd, 0x2b, 0x62, 0x3c, 0x15, 0x80, 0xf7,
-	0xe4, 0x95, 0x72, 0x19, 0xa1, 0x9a, 0x02, 0x47, 0x5e, 0xcf, 0xc9, 0xb8, 0xd7, 0xf3, 0x4d, 0x98,
-	0x31, 0xed, 0x6c, 0x8b, 0x53, 0xa2, 0xc5, 0x9c, 0x22, 0x1e, 0x13, 0x6b, 0x27, 0x5b, 0xac, 0xcb,
-	0x98, 0xd8, 0x24, 0x18, 0x2d, 0x01, 0x3a, 0xea, 0x1c, 0x90, 0x63, 0xd3, 0xa3, 0xa1, 0xc4, 0x10,
-	0xd9, 0x56, 0xca, 0x5a, 0x4e, 0x49, 0x22, 0xe1, 0x24, 0x4a, 0x26, 0x9c, 0x64, 0xea, 0xb0, 0xeb,
-	0x99, 0xc7, 0xa6, 0x45, 0x9a, 0xc4, 0x90, 0x0e, 0xa4, 0x33, 0xd5, 0xe1, 0x08, 0x1b, 0xad, 0xc2,
-	0x55, 0x8f, 0x60, 0xc3, 0xb4, 0x89, 0xef, 0x6f, 0xda, 0x26, 0x35, 0xb1, 0xb5, 0x4e, 0x2c, 0x7c,
-	0xda, 0x20, 0xba, 0x63, 0x1b, 0xbe, 0x8c, 0xc1, 0x3c, 0x13, 0x47, 0x44, 0xf2, 0xc8, 0xf2, 0x3d,
-	0xe2, 0x99, 0x8e, 0x11, 0x50, 0xcf, 0x71, 0xea, 0x2e, 0xa5, 0xe8, 0x7d, 0xb8, 0x1c, 0x96, 0x3c,
-	0xc4, 0xa6, 0xd5, 0xf1, 0x48, 0x74, 0xd7, 0x6e, 0x9e, 0x93, 0x76, 0x47, 0x60, 0x8b, 0xd7, 0xa7,
-	0x98, 0x76, 0xf8, 0x9d, 0x57, 0x1e, 0xe7, 0x58, 0xd3, 0x62, 0x90, 0xe4, 0x16, 0xa8, 0x9c, 0xc3,
-	0xaf, 0x1c, 0x04, 0xa9, 0x5d, 0xe6, 0x32, 0xa5, 0x1e, 0xd1, 0x08, 0x78, 0x18, 0x9e, 0xf6, 0x00,
-	0x14, 0x57, 0x7a, 0x39, 0xd6, 0x09, 0x15, 0x4e, 0xd8, 0x20, 0x8c, 0x46, 0x04, 0x0d, 0x76, 0x2d,
-	0x47, 0xfb, 0x30, 0xc7, 0x79, 0x7b, 0x25, 0x90, 0x49, 0xc1, 0xf2, 0xba, 0x92, 0xf6, 0x66, 0x6d,
-	0x24, 0xd0, 0x82, 0x58, 0xc8, 0x5c, 0x62, 0xb4, 0x0c, 0xb3, 0x92, 0xb3, 0x03, 0xa7, 0x8e, 0xe0,
-	0xd8, 0xab, 0xbc, 0x37, 0xb9, 0x65, 0xd9, 0x70, 0x99, 0x6b, 0xe7, 0x0c, 0x97, 0xc9, 0xc6, 0x10,
-	0x5d, 0xcf, 0x8d, 0x21, 0xfa, 0x36, 0xcc, 0xbb, 0xd8, 0x23, 0x36, 0x6d, 0xb4, 0x3a, 0xd4, 0x70,
-	0x9e, 0x46, 0x2d, 0x2e, 0xf6, 0x6a, 0xb1, 0x0b, 0xa1, 0xfa, 0xeb, 0x43, 0x30, 0x9b, 0x37, 0x3e,
-	0xcf, 0x28, 0xa5, 0x4f, 0x59, 0x9a, 0x50, 0x1b, 0x79, 0x29, 0x7d, 0x5e, 0xea, 0x36, 0x65, 0x31,
-	0xd4, 0x67, 0x91, 0xd5, 0xe7, 0x5f, 0x4b, 0x70, 0xb9, 0x6b, 0x83, 0xac, 0xfb, 0xfc, 0xd8, 0x4c,
-	0x5a, 0x85, 0xec, 0x99, 0xef, 0x57, 0x96, 0x49, 0x6c, 0xba, 0x16, 0xdd, 0xa3, 0x96, 0xdf, 0x9c,
-	0x2d, 0xe0, 0x79, 0x81, 0x3d, 0xf3, 0x18, 0x53, 0xf2, 0x29, 0x39, 0x0d, 0x72, 0x40, 0x46, 0x10,
-	0x3e, 0xfd, 0x78, 0x2d, 0x7e, 0x83, 0x3b, 0x08, 0x21, 0x4b, 0x40, 0x99, 0x79, 0xe5, 0xdb, 0x66,
-	0x60, 0x5e, 0xf9, 0xb6, 0xc9, 0x84, 0xa5, 0xdf, 0x39, 0x60, 0x1b, 0xed, 0x8a, 0x25, 0xf2, 0x68,
-	0x28, 0x63, 0x3c, 0xe2, 0x27, 0x0d, 0x56, 0xbf, 0x0b, 0x53, 0xa9, 0xf8, 0xd5, 0x48, 0x62, 0x97,
-	0xba, 0x5e, 0xbb, 0x1e, 0x2d, 0xac, 0xf6, 0xae, 0xc1, 0xa5, 0x2e, 0x19, 0xf3, 0x58, 0xb7, 0x75,
-	0xb7, 0x13, 0x64, 0x73, 0xd1, 0xdd, 0x8e, 0x88, 0x82, 0x6f, 0x3b, 0xf2, 0x2a, 0x1e, 0x8f, 0x82,
-	0x67, 0x6f, 0xea, 0x1f, 0x0e, 0x41, 0x39, 0x0c, 0x99, 0xed, 0x93, 0x03, 0x15, 0x18, 0xef, 0x18,
-	0x3e, 0x37, 0xe1, 0x44, 0xe5, 0xc1, 0x2b, 0xfa, 0x10, 0xaa, 0x1d, 0x9f, 0xec, 0x30, 0x15, 0xc8,
-	0xfa, 0xe4, 0x29, 0x2d, 0xe0, 0xf4, 0x48, 0xe0, 0xa3, 0x47, 0x30, 0xdd, 0xf1, 0xc9, 0xbe, 0xd7,
-	0xf1, 0xe9, 0x53, 0xc7, 0xa3, 0xad, 0x53, 0x56, 0x49, 0x6f, 0xff, 0x47, 0x96, 0x08, 0xdd, 0x83,
-	0x51, 0xea, 0x1c, 0x11, 0xbb, 0x30, 0xbf, 0x0a, 0x74, 0xf5, 0x57, 0xa0, 0x1a, 0x8f, 0x7b, 0x61,
-	0xd6, 0x75, 0x9b, 0x99, 0xe2, 0xfc, 0x6b, 0xc5, 0xf8, 0x46, 0x80, 0xd0, 0x9d, 0x31, 0x14, 0x73,
-	0x67, 0x30, 0x89, 0xcf, 0x6b, 0x88, 0xdd, 0xe4, 0x8e, 0x41, 0xd4, 0xdf, 0x1b, 0x87, 0xc9, 0x8b,
-	0x30, 0x06, 0x32, 0x4e, 0x84, 0xa1, 0x5e, 0x87, 0xa5, 0x89, 0xdb, 0x04, 0x0f, 0x58, 0x37, 0xad,
-	0xc3, 0x86, 0xd9, 0xb4, 0x0b, 0xe5, 0x6b, 0x89, 0x61, 0xa7, 0x43, 0x9e, 0x47, 0xb3, 0x21, 0xcf,
-	0xab, 0x30, 0x61, 0xd8, 0x3e, 0x5b, 0x5a, 0x62, 0xb9, 0x24, 0x9c, 0x84, 0xc9, 0xaf, 0x5f, 0x5a,
-	0x97, 0x88, 0x32, 0x77, 0x6d, 0x40, 0xc7, 0x53, 0xd5, 0x70, 0xb7, 0xcb, 0x36, 0xf1, 0x5b, 0x32,
-	0xd8, 0x65, 0xbc, 0x40, 0xaa, 0x9a, 0x14, 0x0d, 0xfa, 0x02, 0x2e, 0x8b, 0x21, 0x8b, 0xce, 0x2b,
-	0x56, 0x4f, 0x65, 0x72, 0x93, 0x02, 0x09, 0x54, 0xba, 0x13, 0xa3, 0x4f, 0x00, 0xe9, 0x26, 0xc5,
-	0x06, 0xb1, 0x1e, 0x11, 0x6c, 0xd1, 0x16, 0x8f, 0xd2, 0x2f, 0xa0, 0xc4, 0xe6, 0x50, 0x5d, 0xe0,
-	0x2d, 0xb5, 0x7e, 0x62, 0x3c, 0xb3, 0xa7, 0x1d, 0xd5, 0x81, 0x32, 0x94, 0x4f, 0x3d, 0x75, 0xbc,
-	0x23, 0xcb, 0xc1, 0x06, 0x9b, 0xca, 0x7d, 0x6a, 0x05, 0x2a, 0x6d, 0x0a, 0x7c, 0xc1, 0x49, 0x97,
-	0x17, 0xde, 0x83, 0x5a, 0x82, 0x9b, 0xce, 0x95, 0x29, 0xeb, 0x8f, 0x4b, 0x50, 0xbb, 0x78, 0x93,
-	0x5a, 0x85, 0x6a, 0x10, 0x9a, 0xb5, 0x17, 0x99, 0xae, 0x09, 0x58, 0x28, 0x46, 0x86, 0x93, 0x5e,
-	0xd1, 0x74, 0x5a, 0x42, 0xf5, 0x47, 0x65, 0x98, 0xcb, 0xcd, 0xa9, 0xd1, 0xa7, 0x04, 0x39, 0x73,
-	0x65, 0x0c, 0x0d, 0xb2, 0x32, 0x8a, 0xdd, 0x60, 0xea, 0x9f, 0xc7, 0xbf, 0x84, 0x19, 0x9b, 0x1c,
-	0x13, 0x39, 0x0c, 0x7d, 0xe6, 0xfe, 0xd5, 0xf2, 0xea, 0xe0, 0x61, 0x69, 0xd6, 0x53, 0x7c, 0xea,
-	0xa7, 0xea, 0xae, 0x9e, 0x37, 0x2c, 0x2d, 0xa7, 0x92, 0x9e, 0xbe, 0xbd, 0xda, 0xf3, 0xf0, 0xed,
-	0x4d, 0x7e, 0x1d, 0xa9, 0x04, 0xa7, 0xba, 0xde, 0x6e, 0x9d, 0xf1, 0xc8, 0x53, 0xcf, 0xa4, 0x64,
-	0xc5, 0x75, 0x1f, 0xed, 0xef, 0xef, 0xed, 0x79, 0xce, 0x41, 0x70, 0x1b, 0xf5, 0xcc, 0xcc, 0x28,
-	0x39, 0x64, 0xa9, 0x5d, 0x6d, 0xfa, 0xbc, 0xbb, 0x9a, 0xc9, 0x67, 0x8b, 0x7f, 0x88, 0x5c, 0x78,
-	0x71, 0x10, 0xd2, 0x98, 0xa9, 0xcd, 0x5e, 0x49, 0x42, 0x54, 0x16, 0xcd, 0x24, 0x94, 0x47, 0x9c,
-	0x54, 0x06, 0x67, 0x0b, 0x1b, 0x80, 0x8f, 0x60, 0xd2, 0x39, 0x48, 0xf0, 0x67, 0xd1, 0xab, 0x12,
-	0x29, 0xba, 0x8b, 0xbe, 0xa7, 0xf9, 0xdb, 0x25, 0xb8, 0xd4, 0x25, 0xfa, 0xa5, 0x4f, 0x29, 0xf5,
-	0x21, 0x54, 0x9d, 0x0e, 0x75, 0x3b, 0x54, 0xe6, 0xa2, 0x1a, 0x2a, 0x90, 0x2c, 0x28, 0x86, 0xaf,
-	0xfe, 0xd6, 0x10, 0x5c, 0x3b, 0x33, 0xa0, 0xa6, 0xcf, 0x7e, 0xbd, 0xc5, 0xe3, 0xdc, 0x5a, 0xb2,
-	0x3f, 0x37, 0x72, 0xa3, 0x77, 0x56, 0x3a, 0x34, 0x4a, 0x14, 0xd8, 0xa1, 0x2d, 0xf4, 0x6e, 0x68,
-	0xb8, 0xe7, 0xc4, 0x0c, 0x85, 0x64, 0xb9, 0x89, 0x66, 0x36, 0xf8, 0x1d, 0x02, 0x4a, 0x4e, 0xe8,
-	0xc7, 0x1e, 0x76, 0x5b, 0x52, 0x67, 0xcb, 0xaf, 0x60, 0x2d, 0x86, 0xa8, 0x25, 0xc8, 0xd4, 0x3f,
-	0x2d, 0xc1, 0x5c, 0x6e, 0x0f, 0xd1, 0x2a, 0x4c, 0x62, 0xd7, 0x5d, 0xf3, 0x88, 0x41, 0x6c, 0x6a,
-	0x62, 0xcb, 0x2f, 0x30, 0x1a, 0x29, 0x0a, 0x66, 0x77, 0x60, 0xd7, 0x64, 0x46, 0x98, 0xb4, 0x3b,
-	0xc4, 0x1b, 0x5a, 0x8a, 0x02, 0xc6, 0x75, 0x3d, 0x54, 0xa8, 0xc5, 0xee, 0x90, 0x53, 0xa2, 0xfe,
-	0x2a, 0xe3, 0xa2, 0xdc, 0xf1, 0xe8, 0x73, 0xb6, 0xde, 0x80, 0x69, 0x1f, 0xb7, 0x5d, 0x7e, 0xe7,
-	0xe3, 0x00, 0x8b, 0x2c, 0x81, 0x72, 0x33, 0xce, 0x16, 0xa8, 0xbb, 0x89, 0xe6, 0xe3, 0xa3, 0xd9,
-	0xa7, 0xe7, 0xfe, 0x6f, 0x4a, 0x50, 0x4d, 0x7c, 0xc5, 0x7d, 0x18, 0x37, 0x30, 0xc5, 0x86, 0xd3,
-	0xcc, 0x66, 0xce, 0x14, 0x88, 0xeb, 0xa2, 0x38, 0x38, 0xad, 0x92, 0xd8, 0xe8, 0x03, 0x28, 0x5b,
-	0x66, 0xb3, 0x45, 0x7d, 0x4a, 0xdc, 0x2c, 0xef, 0x09, 0xd2, 0x2d, 0x86, 0xd0, 0xa0, 0xc4, 0x0d,
-	0x02, 0xad, 0x42, 0x0a, 0x74, 0x17, 0xc6, 0x7e, 0x60, 0xba, 0x47, 0x66, 0x90, 0xf6, 0xf1, 0x6a,
-	0x9a, 0xf6, 0x2b, 0x5e, 0x1a, 0xf0, 0x9e, 0xc0, 0x55, 0x6f, 0xc3, 0x4c, 0x4e, 0xa7, 0x98, 0x25,
-	0x88, 0x65, 0x3a, 0x1c, 0xa1, 0x62, 0x05, 0xaf, 0xea, 0x9f, 0x97, 0x60, 0x2e, 0xb7, 0x2f, 0xdd,
-	0x69, 0x98, 0x00, 0x16, 0xde, 0xef, 0x7d, 0x6e, 0xb9, 0xc9, 0x6b, 0xd9, 0x31, 0x10, 0xff, 0xd5,
-	0x03, 0xab, 0x33, 0xce, 0x3d, 0x31, 0x08, 0x5a, 0x86, 0x31, 0x7e, 0x2a, 0x47, 0x0a, 0x18, 0x34,
-	0x12, 0x53, 0x5d, 0x02, 0x94, 0xfd, 0xf0, 0x33, 0xbe, 0xec, 0xc7, 0x63, 0x50, 0x93, 0xf9, 0x06,
-	0x07, 0x62, 0xc8, 0x77, 0xa2, 0xa3, 0xce, 0x4c, 0xb4, 0x9c, 0xac, 0xbf, 0xcb, 0x61, 0xe7, 0xdb,
-	0x30, 0xf6, 0x3d, 0x4c, 0x9a, 0xa1, 0x0c, 0xb9, 0x96, 0x21, 0xfc, 0x84, 0x17, 0x07, 0x73, 0x28,
-	0x90, 0x2f, 0xf0, 0xfe, 0xf8, 0x02, 0x4c, 0xb8, 0x9e, 0x73, 0x6c, 0x1a, 0xc4, 0x93, 0xc6, 0x5f,
-	0xf8, 0x8e, 0xee, 0x44, 0x27, 0xb1, 0x63, 0xe9, 0xec, 0xd9, 0x5d, 0xce, 0x5f, 0xdf, 0x0e, 0x59,
-	0x72, 0xbc, 0xcb, 0xf7, 0xe4, 0xf1, 0x24, 0x5a, 0x01, 0x70, 0x5c, 0x62, 0xeb, 0xc4, 0xf6, 0x3b,
-	0x41, 0x26, 0xcc, 0x17, 0x33, 0xa4, 0xbb, 0x21, 0x4a, 0x70, 0x9d, 0x25, 0x22, 0x2a, 0x70, 0x20,
-	0xfd, 0x8b, 0xa3, 0xb0, 0xa5, 0xf4, 0x80, 0xa9, 0x01, 0xf5, 0x80, 0x7f, 0x28, 0xc1, 0xa5, 0x2e,
-	0x53, 0x10, 0x5c, 0x6b, 0x28, 0x65, 0xae, 0x35, 0x0c, 0x45, 0xd7, 0x1a, 0x1e, 0x41, 0x99, 0x9c,
-	0xb8, 0x8e, 0x17, 0x8b, 0x08, 0xbd, 0x75, 0xc6, 0xe4, 0x6e, 0x04, 0xb8, 0x81, 0xc4, 0x0b, 0x89,
-	0x93, 0x29, 0x56, 0x46, 0xfb, 0x4a, 0xb1, 0xa2, 0x1e, 0xc2, 0x62, 0xaf, 0x26, 0x99, 0xb5, 0x18,
-	0xbf, 0x1b, 0x55, 0xd8, 0x5a, 0x8c, 0xdf, 0x8a, 0xfa, 0x49, 0x09, 0x66, 0xf3, 0x16, 0x7f, 0x9f,
-	0x32, 0x26, 0x65, 0xc0, 0x0e, 0xf5, 0x63, 0xc0, 0x86, 0xbf, 0xb4, 0x1b, 0x8e, 0xff, 0xd2, 0xae,
-	0x9f, 0xdf, 0xd1, 0xfd, 0xc9, 0x90, 0xd8, 0x45, 0x52, 0x02, 0xaa, 0x10, 0x3b, 0xbc, 0x17, 0xfa,
-	0x33, 0x87, 0xd3, 0x8e, 0xec, 0x44, 0x95, 0xdb, 0x1c, 0x29, 0x90, 0x14, 0x82, 0x84, 0xfb, 0x70,
-	0x5d, 0x6c, 0x37, 0xa8, 0xe3, 0xe1, 0x26, 0x61, 0x5d, 0x94, 0xee, 0xdf, 0x34, 0x98, 0x0d, 0xb3,
-	0x4b, 0x3c, 0xdf, 0xf4, 0x69, 0x91, 0x00, 0x5b, 0x89, 0x8a, 0x6e, 0x41, 0xdd, 0x17, 0x95, 0x44,
-	0x49, 0x16, 0xc5, 0xd1, 0x6a, 0x06, 0xce, 0x4f, 0x73, 0xf9, 0x8e, 0xc6, 0xaf, 0x5f, 0xca, 0x7f,
-	0xd0, 0x45, 0x10, 0xf5, 0x01, 0x5c, 0xee, 0xfa, 0x41, 0xe8, 0x1a, 0x40, 0x1b, 0x9f, 0x3c, 0xe1,
-	0x0a, 0xa1, 0x2f, 0xff, 0xe3, 0x57, 0x6e, 0xe3, 0x13, 0xbe, 0x81, 0xf9, 0xea, 0x5f, 0x45, 0x03,
-	0x9c, 0xd8, 0xcc, 0x8a, 0x0c, 0xf0, 0x1b, 0x30, 0xed, 0x32, 0x13, 0xaa, 0x41, 0xb1, 0x47, 0x3b,
-	0x2e, 0x3f, 0x3a, 0x93, 0x71, 0x1c, 0xd9, 0x02, 0x74, 0x15, 0xca, 0xdf, 0xef, 0x10, 0xef, 0x34,
-	0xbc, 0x82, 0x55, 0xd3, 0x22, 0x40, 0x9f, 0x0e, 0x6e, 0xa4, 0x42, 0xf5, 0x7b, 0xf8, 0x18, 0xef,
-	0xba, 0xd4, 0x7f, 0x44, 0xb0, 0x2b, 0xb2, 0xcf, 0x6b, 0x09, 0x18, 0xdb, 0x7a, 0xda, 0xf8, 0xa4,
-	0xe1, 0x62, 0x19, 0xaf, 0x5c, 0xd3, 0xc2, 0x77, 0xf4, 0x36, 0x8c, 0xb0, 0x6d, 0xaa, 0xeb, 0x56,
-	0x20, 0xc6, 0x64, 0xc7, 0x31, 0x82, 0x1d, 0x88, 0xa3, 0xab, 0xdf, 0x0c, 0x05, 0x55, 0x1a, 0x01,
-	0x21, 0x18, 0xd1, 0xdd, 0x4e, 0x30, 0xd2, 0xfc, 0x59, 0xfd, 0xdd, 0x12, 0xcc, 0x7c, 0x6a, 0x62,
-	0xcb, 0xbc, 0x10, 0x27, 0xee, 0x15, 0x28, 0x33, 0xed, 0xe5, 0xc9, 0xa1, 0x69, 0x05, 0x2e, 0xa9,
-	0x09, 0x06, 0x90, 0xd7, 0x0d, 0xea, 0xf2, 0x0c, 0xe3, 0xc9, 0x11, 0x39, 0x15, 0x38, 0xc3, 0xf2,
-	0x4f, 0x7f, 0xe1, 0xd9, 0x06, 0xc3, 0x54, 0x7f, 0x56, 0x82, 0x59, 0xde, 0xa9, 0x75, 0xec, 0xb7,
-	0x0e, 0x1c, 0xec, 0x05, 0x79, 0x04, 0x93, 0xde, 0xe8, 0x52, 0xda, 0x1b, 0xcd, 0x76, 0xc0, 0x8e,
-	0x4f, 0x3c, 0x1b, 0xb7, 0x49, 0xa4, 0xb4, 0xc7, 0x41, 0xe8, 0x65, 0xa8, 0xb9, 0xd8, 0xf7, 0xdd,
-	0x96, 0x87, 0xfd, 0xd8, 0xe9, 0x4a, 0x12, 0xc8, 0x8c, 0xb4, 0x63, 0x93, 0x3c, 0xdd, 0xb5, 0xad,
-	0x53, 0xce, 0xd8, 0xbd, 0xf5, 0xaf, 0x04, 0x3e, 0xeb, 0x67, 0xd3, 0xc3, 0x87, 0xd8, 0xc6, 0x9f,
-	0x69, 0x5b, 0xc1, 0x8f, 0x1d, 0x23, 0x08, 0x63, 0x38, 0xa1, 0xc6, 0xb0, 0x62, 0x79, 0xcb, 0x2d,
-	0x04, 0xa8, 0x3f, 0x2c, 0x01, 0xe2, 0x9f, 0x7f, 0x11, 0x42, 0x73, 0x31, 0x2b, 0x34, 0xcb, 0x49,
-	0x91, 0x58, 0x17, 0xc2, 0x2f, 0xf8, 0x07, 0xa1, 0x15, 0x13, 0x92, 0x23, 0x31, 0x21, 0xa9, 0xfe,
-	0xc5, 0x38, 0x54, 0x78, 0xb7, 0x06, 0x0d, 0xd2, 0x12, 0x3e, 0xed, 0x75, 0xd2, 0x76, 0xc4, 0xe1,
-	0x44, 0x91, 0x20, 0xad, 0x34, 0x4d, 0x20, 0x05, 0x86, 0x33, 0x52, 0x60, 0x24, 0x92, 0x02, 0x45,
-	0x03, 0xb0, 0xba, 0x64, 0x89, 0x1d, 0xeb, 0x9e, 0x25, 0xf6, 0xdd, 0xd8, 0x25, 0xbb, 0x8c, 0x9a,
-	0x97, 0xb3, 0x9e, 0x62, 0xf7, 0xeb, 0xde, 0x87, 0xb2, 0x11, 0xb0, 0xb5, 0x5c, 0xdc, 0xd7, 0x53,
-	0xb4, 0x29, 0xb6, 0xd7, 0x22, 0x82, 0xf8, 0x95, 0xc2, 0x4c, 0xec, 0x78, 0x96, 0x67, 0x22, 0x2d,
-	0x3b, 0xa5, 0x1b, 0x4e, 0x65, 0x75, 0xc3, 0x5f, 0xfe, 0xaf, 0xe8, 0xff, 0xd8, 0x3f, 0x1b, 0xff,
-	0x69, 0x1c, 0xc6, 0xf8, 0xea, 0xf1, 0xd1, 0x07, 0x50, 0x61, 0x62, 0xb8, 0x2d, 0xfe, 0x61, 0x9a,
-	0xcd, 0x83, 0x92, 0xf9, 0xc1, 0xa9, 0x16, 0xc7, 0x47, 0xaf, 0xc0, 0xb0, 0x6e, 0x9b, 0x72, 0x99,
-	0xc6, 0xf6, 0xbe, 0xf0, 0xcf, 0xb9, 0x1a, 0x2b, 0x47, 0xef, 0x41, 0x95, 0xe7, 0x7f, 0xd5, 0x1d,
-	0x8f, 0x18, 0xe1, 0x9f, 0x81, 0x63, 0x16, 0x53, 0xe2, 0x1f, 0x8a, 0x5a, 0x02, 0x19, 0x2d, 0xc1,
-	0x58, 0x93, 0xff, 0xb6, 0x46, 0x0a, 0xdb, 0xf9, 0xfc, 0xdf, 0xd9, 0x68, 0x12, 0x0b, 0xdd, 0x85,
-	0x09, 0x99, 0x50, 0x2a, 0xd8, 0x94, 0x95, 0x4c, 0x96, 0xcc, 0x30, 0xdf, 0x46, 0x80, 0xc9, 0x5b,
-	0xe1, 0x29, 0x65, 0xa5, 0x39, 0x37, 0x9f, 0xce, 0xe5, 0x1e, 0xb6, 0xc2, 0xdf, 0xd0, 0x03, 0x18,
-	0x97, 0x62, 0xbb, 0x70, 0xf6, 0x87, 0x80, 0x00, 0xbd, 0x0e, 0xa3, 0x6d, 0xf3, 0x84, 0x04, 0x3f,
-	0x54, 0x9d, 0x4b, 0x65, 0x06, 0x09, 0x72, 0xe8, 0x70, 0x1c, 0x74, 0x1f, 0xca, 0x6c, 0x0d, 0xe1,
-	0x26, 0xb1, 0x69, 0x98, 0x3d, 0x35, 0x24, 0x48, 0x05, 0x6f, 0x6b, 0x11, 0x2e, 0xcf, 0xe3, 0x68,
-	0x5a, 0x4e, 0xf0, 0x87, 0x82, 0xb9, 0xdc, 0x20, 0x1c, 0x4d, 0xe0, 0xb0, 0x56, 0xa2, 0xac, 0x36,
-	0x97, 0xd2, 0xad, 0x9c, 0x91, 0xd0, 0xe6, 0x41, 0x22, 0xe0, 0x22, 0xf8, 0x93, 0x41, 0xce, 0x65,
-	0xca, 0x9c, 0x28, 0x8b, 0xbb, 0x99, 0x0b, 0xc9, 0x4a, 0xb7, 0xd3, 0xd3, 0x98, 0x98, 0xfc, 0x1c,
-	0xe6, 0xfd, 0xe4, 0xe1, 0x90, 0xcc, 0x6d, 0x2e, 0xd7, 0x5e, 0xdc, 0x43, 0x99, 0x77, 0x88, 0xa4,
-	0x75, 0x21, 0x67, 0x26, 0x3d, 0x95, 0x7f, 0x64, 0x98, 0x4c, 0x33, 0x68, 0xc2, 0x13, 0xa2, 0x05,
-	0x78, 0x6c, 0x8c, 0x8f, 0x98, 0x6c, 0x95, 0x66, 0xe4, 0x5c, 0x4a, 0xe4, 0x06, 0x63, 0xcc, 0x71,
-	0x90, 0x02, 0xe3, 0xc7, 0x4c, 0x93, 0x76, 0x6c, 0x79, 0x0f, 0x2d, 0x78, 0xe5, 0x5b, 0x9f, 0xfc,
-	0x9f, 0x71, 0xa8, 0x4f, 0x4e, 0x17, 0xd8, 0xfa, 0x52, 0x34, 0xaa, 0x02, 0xf3, 0xf9, 0xbc, 0xa7,
-	0xde, 0x80, 0x6b, 0x67, 0x0a, 0x09, 0x75, 0x1e, 0x66, 0xf3, 0x42, 0xf5, 0xd4, 0xff, 0x0f, 0xb5,
-	0xc4, 0xcf, 0xc0, 0x2e, 0x36, 0xf3, 0xdd, 0xad, 0xdb, 0xe2, 0x4a, 0x0c, 0xaa, 0xc2, 0x84, 0xfc,
-	0x81, 0x87, 0x51, 0x7f, 0x81, 0xbd, 0x59, 0x4e, 0xf3, 0x89, 0x63, 0x5b, 0xa7, 0xf5, 0x12, 0xaa,
-	0xb0, 0x16, 0x0f, 0x1d, 0x4f, 0x27, 0xf5, 0xa1, 0x5b, 0xef, 0x76, 0x89, 0xf0, 0x62, 0x58, 0xeb,
-	0x1b, 0x0f, 0x57, 0x3e, 0xdb, 0xda, 0xaf, 0xbf, 0x80, 0x00, 0xc6, 0x1a, 0xfb, 0xda, 0xe6, 0xda,
-	0x7e, 0xbd, 0x84, 0xc6, 0x61, 0x78, 0xf7, 0xe1, 0xc3, 0xfa, 0xd0, 0xad, 0xd7, 0x72, 0xee, 0xaa,
-	0xa2, 0x09, 0x18, 0xf9, 0xa4, 0xb1, 0xbb, 0x53, 0x7f, 0x81, 0x3d, 0xed, 0x6f, 0x7c, 0xb1, 0x5f,
-	0x2f, 0xdd, 0x7a, 0x33, 0x70, 0x5e, 0xb3, 0x7a, 0x84, 0x17, 0xa6, 0xfe, 0x02, 0xaa, 0xc5, 0x1c,
-	0x92, 0xa2, 0x57, 0xd2, 0x55, 0x59, 0x1f, 0x5a, 0x85, 0xaf, 0xc2, 0xdf, 0xbd, 0x1f, 0x8c, 0xf1,
-	0x71, 0x78, 0xeb, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x1b, 0xcd, 0x51, 0xa2, 0x2d, 0x7e, 0x00,
-	0x00,
+	// 6974 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x4b, 0x6c, 0x1c, 0x47,
+	0x7a, 0xb0, 0x67, 0xf8, 0x9c, 0x6f, 0x38, 0xe4, 0xb0, 0xf8, 0x50, 0xeb, 0x65, 0xd1, 0xed, 0x97,
+	0x56, 0xf6, 0x52, 0x96, 0x2c, 0xcb, 0xb2, 0xec, 0xf5, 0x9a, 0x2f, 0x59, 0xb4, 0x49, 0x91, 0xdb,
+	0x43, 0xcb, 0x8f, 0xfd, 0xff, 0xd5, 0x5f, 0xec, 0x2e, 0x0e, 0x7b, 0xd9, 0xd3, 0xd5, 0xdb, 0x5d,
+	0x43, 0x91, 0x0b, 0xfc, 0x08, 0x82, 0x1c, 0x72, 0x48, 0x80, 0x20, 0xc1, 0x22, 0xb9, 0xe4, 0x81,
+	0x4d, 0x36, 0xc8, 0x29, 0xc8, 0x21, 0x87, 0x5c, 0x72, 0x4c, 0x80, 0x00, 0x41, 0xae, 0x41, 0x90,
+	0x43, 0x80, 0x1c, 0x13, 0x60, 0x0f, 0x39, 0x67, 0x81, 0x04, 0xf5, 0xe8, 0x77, 0x0f, 0xa7, 0x39,
+	0xa4, 0xac, 0x4d, 0x76, 0x6f, 0xdd, 0x5f, 0x7d, 0x5f, 0x75, 0x75, 0x57, 0xd5, 0x57, 0xdf, 0xbb,
+	0xe1, 0x86, 0x77, 0xd0, 0xbe, 0x89, 0x3d, 0x3b, 0xb8, 0x69, 0x07, 0xcc, 0xa6, 0x37, 0x0f, 0x6f,
+	0x61, 0xc7, 0xdb, 0xc7, 0xb7, 0x6e, 0x1e, 0x62, 0xa7, 0x4b, 0x82, 0x27, 0xec, 0xd8, 0x23, 0xc1,
+	0xa2, 0xe7, 0x53, 0x46, 0xd1, 0x78, 0xd8, 0x78, 0xe9, 0xc5, 0x36, 0xa5, 0x6d, 0x87, 0xdc, 0x14,
+	0xf0, 0xdd, 0xee, 0xde, 0x4d, 0xab, 0xeb, 0x63, 0x66, 0x53, 0x57, 0x62, 0x5e, 0xd2, 0x0f, 0xee,
+	0x05, 0x8b, 0x36, 0xe5, 0x1d, 0xdf, 0x34, 0xa9, 0x4f, 0x6e, 0x1e, 0xde, 0xba, 0xd9, 0x26, 0x2e,
+	0xf1, 0x31, 0x23, 0x96, 0xc2, 0xf9, 0xa8, 0x6d, 0xb3, 0xfd, 0xee, 0xee, 0xa2, 0x49, 0x3b, 0x37,
+	0xdb, 0xb4, 0x4d, 0xe3, 0xce, 0xa2, 0x8b, 0xec, 0x53, 0x9e, 0xfa, 0xd8, 0xf3, 0x88, 0xaf, 0xc6,
+	0xa3, 0xff, 0x53, 0x05, 0xd0, 0x92, 0x65, 0x51, 0x77, 0xdd, 0x6d, 0xfb, 0x24, 0x08, 0x56, 0xa8,
+	0xbb, 0x67, 0xb7, 0xd1, 0x1d, 0x18, 0x23, 0x2e, 0xde, 0x75, 0x88, 0xa5, 0x55, 0x16, 0x2a, 0xd7,
+	0xeb, 0xb7, 0x2f, 0x2d, 0xca, 0x8e, 0x16, 0xc3, 0x8e, 0x16, 0x97, 0x29, 0x75, 0x1e, 0xf3, 0x17,
+	0x34, 0x42, 0x54, 0x34, 0x0b, 0x23, 0xfb, 0x34, 0x60, 0x81, 0x56, 0x5d, 0x18, 0xba, 0x5e, 0x33,
+	0xe4, 0x0d, 0x5a, 0x86, 0x3a, 0x76, 0x5d, 0xca, 0xc4, 0xcb, 0x05, 0xda, 0x90, 0xe8, 0x6f, 0x61,
+	0x31, 0xfc, 0x10, 0x8b, 0x3b, 0xc7, 0x1e, 0xd9, 0xc4, 0x5e, 0x8b, 0xf9, 0xb6, 0xdb, 0x5e, 0x77,
+	0x19, 0xf1, 0xf7, 0xb0, 0x49, 0x8c, 0x24, 0x11, 0xba, 0x0d, 0x43, 0xcc, 0x09, 0xb4, 0xe1, 0x92,
+	0xb4, 0x1c, 0x59, 0x37, 0x00, 0x96, 0x7c, 0x73, 0x5f, 0xbd, 0xd1, 0x2c, 0x8c, 0xe0, 0x8e, 0x75,
+	0xf7, 0x8e, 0x78, 0x9f, 0x86, 0x21, 0x6f, 0x90, 0x06, 0x63, 0x9e, 0x67, 0xde, 0xbd, 0xe3, 0x10,
+	0xad, 0x2a, 0xe0, 0xe1, 0x2d, 0xc7, 0x0f, 0xde, 0x7e, 0xef, 0xad, 0x23, 0x31, 0xde, 0x86, 0x21,
+	0x6f, 0xf4, 0xbf, 0x1d, 0x82, 0xda, 0xca, 0xa3, 0xf5, 0x33, 0x7d, 0xa5, 0x26, 0x0c, 0xed, 0x77,
+	0x77, 0xc5, 0xf3, 0x6a, 0x06, 0xbf, 0xe4, 0x10, 0x86, 0xdb, 0xe2, 0x49, 0x35, 0x83, 0x5f, 0xf2,
+	0xa7, 0xdb, 0x1d, 0xdc, 0x26, 0xe2, 0x8d, 0x6b, 0x86, 0xbc, 0x41, 0x2f, 0x02, 0x78, 0x5d, 0xc7,
+	0xd9, 0xa6, 0x8e, 0x6d, 0x1e, 0x6b, 0x23, 0xa2, 0x29, 0x01, 0x41, 0x57, 0xa0, 0x66, 0xba, 0xf6,
+	0xb2, 0xed, 0xae, 0xda, 0xbe, 0x36, 0x2a, 0x9a, 0x63, 0x00, 0xa7, 0x36, 0x5d, 0x9b, 0x0f, 0x9d,
+	0x37, 0x8f, 0x49, 0xea, 0x18, 0x82, 0xae, 0xc3, 0x94, 0xba, 0x7b, 0x60, 0x3b, 0xe4, 0x11, 0xee,
+	0x10, 0x6d, 0x5c, 0x20, 0x65, 0xc1, 0xe8, 0x4d, 0x98, 0x26, 0x47, 0xa6, 0xd3, 0xb5, 0xc4, 0x6d,
+	0xe0, 0x61, 0x93, 0x04, 0x5a, 0x4d, 0xcc, 0x79, 0xbe, 0x01, 0x6d, 0xc0, 0xa4, 0x47, 0xad, 0xa5,
+	0xc4, 0x12, 0x80, 0x72, 0xd3, 0xb8, 0x5c, 0xd5, 0x2a, 0x46, 0x86, 0x16, 0x5d, 0x87, 0xa6, 0x17,
+	0x78, 0x4f, 0x4c, 0xa7, 0x1b, 0x30, 0xe2, 0x3f, 0xf1, 0xa9, 0x43, 0xb4, 0xba, 0x18, 0xe6, 0xa4,
+	0x17, 0x78, 0x2b, 0x12, 0x6c, 0x50, 0x87, 0xa0, 0x4b, 0x30, 0xee, 0xd0, 0xf6, 0x06, 0x39, 0x24,
+	0x8e, 0x36, 0x21, 0x30, 0xa2, 0x7b, 0xfd, 0x0b, 0xb8, 0xb4, 0xb2, 0xfd, 0xd9, 0x0e, 0xf6, 0xdb,
+	0x84, 0x7d, 0xc6, 0x6c, 0xc7, 0xfe, 0xa1, 0xe8, 0x5e, 0xcd, 0xeb, 0x7d, 0xd0, 0x98, 0x68, 0x5a,
+	0x3a, 0x24, 0x3e, 0x6e, 0x93, 0x04, 0x86, 0x98, 0xe8, 0x11, 0xa3, 0x67, 0xbb, 0xfe, 0x6b, 0xa3,
+	0x30, 0xbd, 0x42, 0x7c, 0xb6, 0x89, 0x5d, 0xdc, 0x26, 0xfe, 0x73, 0x5a, 0x29, 0xaf, 0xc1, 0x84,
+	0x4f, 0x3c, 0xc7, 0x36, 0xf1, 0x0a, 0xed, 0xba, 0x4c, 0xac, 0x95, 0x86, 0xf8, 0x9e, 0x29, 0x38,
+	0xa7, 0x26, 0x1d, 0x6c, 0x3b, 0x6a, 0xb5, 0xc8, 0x1b, 0xbe, 0x8e, 0xc8, 0x11, 0xf3, 0xf1, 0x92,
+	0xdf, 0x0e, 0xb4, 0x31, 0x31, 0xaf, 0x31, 0x00, 0x3d, 0x84, 0x09, 0x97, 0x5a, 0xa4, 0x45, 0x1c,
+	0x62, 0x32, 0xea, 0x6b, 0xe3, 0xa7, 0x98, 0xcd, 0x14, 0x25, 0x7a, 0x07, 0x6a, 0x3e, 0x09, 0x68,
+	0xd7, 0x97, 0xeb, 0x87, 0x77, 0x33, 0x13, 0x77, 0x63, 0x84, 0x4d, 0x82, 0x32, 0xc6, 0x44, 0x3a,
+	0x4c, 0x78, 0xd4, 0x5a, 0x75, 0x03, 0xb5, 0x11, 0x40, 0x8c, 0x3d, 0x05, 0x43, 0xab, 0x21, 0x8e,
+	0x9c, 0x00, 0xad, 0x5e, 0x6e, 0x90, 0x46, 0x8a, 0x0a, 0x51, 0xb8, 0x22, 0x96, 0x1f, 0xb3, 0x97,
+	0xf6, 0xf6, 0x6c, 0xd7, 0x66, 0xc7, 0x1b, 0x78, 0x97, 0x38, 0xd1, 0xab, 0x4f, 0x88, 0x5e, 0x5f,
+	0x4f, 0xf7, 0xda, 0x72, 0x6c, 0x93, 0x6c, 0xed, 0xf5, 0xf8, 0x02, 0x27, 0x76, 0x88, 0x9e, 0xc2,
+	0x42, 0xa6, 0x7d, 0x87, 0xf8, 0x9d, 0xf4, 0x43, 0x1b, 0xa7, 0x7f, 0x68, 0xdf, 0x4e, 0xd1, 0x26,
+	0xd4, 0x19, 0x75, 0x88, 0xaf, 0x76, 0xe8, 0xe4, 0xe9, 0x9f, 0x91, 0xa4, 0xd7, 0xff, 0xb3, 0x02,
+	0xb5, 0x68, 0xfe, 0xd0, 0xbb, 0x30, 0xea, 0xd8, 0x1d, 0x9b, 0x05, 0x5a, 0x65, 0x61, 0xe8, 0x7a,
+	0xfd, 0xf6, 0xb5, 0x82, 0x49, 0x5e, 0xdc, 0x10, 0x18, 0x6b, 0x2e, 0xf3, 0x8f, 0x0d, 0x85, 0x8e,
+	0xbe, 0x05, 0xe3, 0x3e, 0xf9, 0x41, 0x97, 0x84, 0x67, 0x4a, 0xfd, 0xf6, 0x4b, 0x45, 0xa4, 0x86,
+	0xc2, 0x91, 0xc4, 0x11, 0xc9, 0xa5, 0xf7, 0xa0, 0x9e, 0xe8, 0x95, 0x6f, 0x9e, 0x03, 0x72, 0x2c,
+	0x36, 0x60, 0xcd, 0xe0, 0x97, 0x7c, 0xf9, 0x8b, 0x33, 0x5a, 0x6d, 0x31, 0x79, 0x73, 0xbf, 0x7a,
+	0xaf, 0x72, 0xe9, 0x7d, 0x68, 0xa4, 0x7a, 0x3d, 0x0d, 0xb1, 0xfe, 0xdb, 0x63, 0xd0, 0x58, 0xa1,
+	0x3e, 0x59, 0x7d, 0xd4, 0x3a, 0xd3, 0xfe, 0xd7, 0x61, 0xc2, 0x94, 0xdd, 0xac, 0x8b, 0x2d, 0x2e,
+	0x1f, 0x94, 0x82, 0x09, 0xae, 0x2e, 0xef, 0x77, 0x22, 0xc6, 0x90, 0x80, 0xa0, 0x45, 0x40, 0xea,
+	0x6e, 0xdb, 0xe9, 0xb6, 0x6d, 0x77, 0x3d, 0xc1, 0x2c, 0x0a, 0x5a, 0x72, 0xbb, 0x7b, 0x64, 0xe0,
+	0xdd, 0x9d, 0xe5, 0x41, 0xa3, 0x3d, 0x78, 0x50, 0xfe, 0x7c, 0x18, 0x3b, 0xc3, 0xf9, 0x90, 0xe2,
+	0x29, 0xe3, 0xa5, 0x79, 0xca, 0x06, 0x4c, 0xf9, 0xd4, 0x71, 0x6c, 0xb7, 0xbd, 0x89, 0x8f, 0x5a,
+	0x5d, 0xbf, 0x4d, 0x14, 0x43, 0x7a, 0x31, 0x3d, 0x8a, 0x75, 0x97, 0x6d, 0xf9, 0x72, 0x1c, 0x0f,
+	0xa8, 0xbf, 0xbd, 0x2c, 0xfa, 0xc9, 0x92, 0xa2, 0x2f, 0x60, 0x2e, 0x06, 0x7d, 0xe6, 0xe2, 0x43,
+	0x6c, 0x3b, 0x7c, 0x4a, 0x35, 0x28, 0xdd, 0x67, 0x71, 0x07, 0x7d, 0x39, 0x52, 0xfd, 0x79, 0x70,
+	0xa4, 0x89, 0xaf, 0x81, 0x23, 0x35, 0xce, 0xc8, 0x91, 0xbe, 0x80, 0x85, 0x55, 0xb2, 0x87, 0xbb,
+	0x0e, 0xdb, 0xa6, 0xd6, 0xaa, 0x1d, 0xf8, 0x5d, 0x8f, 0x37, 0x2c, 0x77, 0xad, 0x36, 0x61, 0x67,
+	0xd9, 0xa5, 0xfa, 0xe7, 0x30, 0xaf, 0x7a, 0x8e, 0x56, 0x97, 0xea, 0x2f, 0xc9, 0xbe, 0x64, 0x87,
+	0x45, 0xec, 0x2b, 0xe4, 0x33, 0x92, 0x28, 0x66, 0x5f, 0xfa, 0x7f, 0xd4, 0x60, 0x66, 0x4d, 0x48,
+	0xe5, 0x1f, 0x63, 0x46, 0x9e, 0xe2, 0x63, 0xd5, 0xed, 0x03, 0x68, 0xe2, 0x2e, 0xa3, 0x81, 0x89,
+	0x1d, 0xb2, 0x56, 0x7a, 0xbc, 0x39, 0x1a, 0xce, 0x5e, 0x22, 0xd8, 0x26, 0x3e, 0x52, 0x12, 0x70,
+	0x0a, 0x96, 0xc6, 0xb1, 0x5d, 0x25, 0x0d, 0xa7, 0x60, 0xe8, 0x35, 0x98, 0x34, 0xa9, 0xeb, 0x12,
+	0x93, 0xed, 0xd8, 0x1d, 0x42, 0xbb, 0x4c, 0xb1, 0x97, 0x0c, 0x14, 0xdd, 0x87, 0x21, 0xd3, 0xeb,
+	0x2a, 0x8e, 0xf2, 0x4a, 0xfc, 0x25, 0x7a, 0x4b, 0x62, 0x62, 0x1a, 0x39, 0x11, 0xfa, 0x36, 0x34,
+	0x2c, 0x1f, 0xdb, 0xee, 0xaa, 0x52, 0x92, 0x04, 0x37, 0xa9, 0xdf, 0xbe, 0x98, 0x7b, 0xe1, 0x10,
+	0xc1, 0x48, 0xe3, 0x27, 0xe7, 0x76, 0xac, 0x3c, 0x07, 0xbe, 0x0d, 0x43, 0xc4, 0x3d, 0x2c, 0x2b,
+	0xe2, 0x18, 0x1c, 0x19, 0xbd, 0x03, 0xa3, 0x0e, 0x5f, 0xc9, 0xa1, 0x48, 0x73, 0x35, 0x26, 0x53,
+	0xf3, 0x28, 0x16, 0x7a, 0x38, 0xdf, 0x0a, 0x39, 0xc7, 0x78, 0x61, 0x60, 0xc6, 0x9b, 0x67, 0xa8,
+	0xf5, 0x33, 0x30, 0xd4, 0x5f, 0x1c, 0x19, 0xe8, 0x0d, 0x18, 0xf1, 0xa8, 0xcf, 0xb8, 0xf4, 0xc3,
+	0x45, 0x8d, 0xb9, 0xb8, 0xf7, 0x6d, 0x0e, 0x56, 0xf3, 0x25, 0x71, 0xd2, 0xe7, 0xcc, 0x54, 0xe9,
+	0x73, 0xe6, 0x03, 0x68, 0x04, 0xc4, 0xf4, 0x09, 0x7b, 0x4c, 0x9d, 0x6e, 0x87, 0x04, 0x5a, 0x53,
+	0x3c, 0x6b, 0x3e, 0x26, 0x6d, 0x25, 0x9a, 0x8d, 0x34, 0x32, 0xda, 0x06, 0x14, 0x10, 0xff, 0xd0,
+	0x36, 0x49, 0x72, 0x76, 0xa7, 0x4b, 0xae, 0xce, 0x02, 0x5a, 0x84, 0x60, 0x98, 0x9b, 0x27, 0x34,
+	0x24, 0x76, 0xac, 0xb8, 0x46, 0x6f, 0xc0, 0xf0, 0x0f, 0x0f, 0x3d, 0x57, 0x9b, 0x11, 0xfd, 0x5e,
+	0x88, 0xfb, 0xfd, 0x8a, 0xf8, 0xf4, 0xf1, 0xf6, 0x23, 0xf5, 0x21, 0x04, 0x52, 0x96, 0x4d, 0xcf,
+	0x9e, 0x91, 0x4d, 0xff, 0xac, 0x02, 0x68, 0xcd, 0x3d, 0xa4, 0xc7, 0x9b, 0x84, 0xf9, 0xb6, 0x79,
+	0x36, 0x7b, 0x04, 0x82, 0x61, 0x6e, 0x82, 0x50, 0x72, 0x93, 0xb8, 0xe6, 0x30, 0x3e, 0x81, 0x82,
+	0x91, 0x8d, 0x18, 0xe2, 0x9a, 0x5b, 0x28, 0x98, 0x13, 0xb4, 0x08, 0x63, 0xb6, 0xdb, 0x2e, 0x6f,
+	0x65, 0x48, 0x12, 0x71, 0x85, 0x83, 0x99, 0xde, 0xa7, 0x84, 0x78, 0xd8, 0xb1, 0x0f, 0x49, 0x59,
+	0xb9, 0xc9, 0x48, 0x51, 0xe9, 0xff, 0x30, 0x0a, 0x13, 0x1f, 0x63, 0xc7, 0x21, 0xc7, 0x67, 0x35,
+	0xc4, 0xd8, 0x09, 0x89, 0x51, 0xde, 0xa0, 0x3b, 0x30, 0xdc, 0x21, 0xc1, 0xbe, 0x36, 0xb4, 0x30,
+	0x94, 0x1e, 0x5a, 0xf2, 0x89, 0x8b, 0x9b, 0x24, 0xd8, 0x97, 0x82, 0xb4, 0xc0, 0xee, 0xbb, 0xff,
+	0x87, 0x9f, 0xc7, 0xfe, 0x1f, 0x79, 0x16, 0xfb, 0xbf, 0xac, 0xc0, 0x9a, 0xda, 0xfa, 0x63, 0xa5,
+	0xb7, 0xfe, 0x32, 0x4c, 0xca, 0xf9, 0x59, 0x72, 0xb1, 0x73, 0x1c, 0xd8, 0xa1, 0x78, 0x7a, 0xd2,
+	0x8c, 0x66, 0x28, 0xfe, 0xc7, 0x88, 0xa9, 0x19, 0xae, 0x50, 0x3f, 0x1b, 0x57, 0xb8, 0xf4, 0x2e,
+	0xd4, 0xa2, 0x65, 0x79, 0x2a, 0x4d, 0xec, 0x5b, 0x30, 0x53, 0x70, 0xe6, 0xf2, 0x2e, 0xb0, 0xe7,
+	0x85, 0x5d, 0x60, 0xcf, 0x13, 0x3b, 0x26, 0x60, 0x36, 0x8d, 0x76, 0x0c, 0xbf, 0xd1, 0xff, 0xad,
+	0x02, 0x93, 0x8a, 0x3e, 0x24, 0x7d, 0x04, 0x33, 0xa2, 0xed, 0x09, 0x11, 0x92, 0x59, 0x5b, 0xb6,
+	0x6a, 0x95, 0xec, 0x51, 0x5f, 0x20, 0xb8, 0x19, 0x48, 0x50, 0xae, 0x25, 0x09, 0x93, 0x1b, 0xbc,
+	0x5a, 0x7e, 0x83, 0x7f, 0x07, 0x66, 0xe5, 0x28, 0x6c, 0x37, 0x35, 0x8c, 0xe1, 0xec, 0xc4, 0xad,
+	0xbb, 0x05, 0xe3, 0x90, 0x6f, 0xb0, 0x9e, 0x22, 0xd5, 0x7f, 0xe3, 0x12, 0x4c, 0x7c, 0xec, 0xd0,
+	0x5d, 0xec, 0xa8, 0x37, 0xbd, 0x0e, 0xc3, 0xd8, 0x37, 0xf7, 0xd5, 0xab, 0xcd, 0xc6, 0x7d, 0xc6,
+	0x56, 0x55, 0x43, 0x60, 0xa0, 0x4f, 0x61, 0xc2, 0x24, 0x3e, 0xb3, 0xf7, 0x6c, 0x13, 0x33, 0x12,
+	0x68, 0xd7, 0x4f, 0x35, 0xdd, 0x46, 0x8a, 0x58, 0x98, 0x21, 0x45, 0xe7, 0x91, 0x09, 0x51, 0xcd,
+	0x49, 0x16, 0x8c, 0xde, 0x82, 0x19, 0x09, 0x32, 0x28, 0x65, 0x31, 0xf6, 0x6d, 0x81, 0x5d, 0xd4,
+	0xc4, 0x25, 0x67, 0x09, 0x7e, 0x8c, 0x1d, 0xdb, 0x92, 0x82, 0xe4, 0x50, 0x7f, 0xc9, 0x39, 0x4b,
+	0x83, 0xfe, 0x0f, 0x5c, 0x36, 0xa9, 0xcb, 0x7c, 0xea, 0x6c, 0x3b, 0xd8, 0x25, 0x2d, 0x62, 0x76,
+	0x7d, 0x9b, 0x1d, 0x87, 0xc2, 0xf8, 0x70, 0xdf, 0x2e, 0x4f, 0x22, 0x47, 0x0f, 0xe1, 0x9a, 0x25,
+	0x15, 0x0a, 0xf9, 0x95, 0x1f, 0xdb, 0x81, 0xbd, 0x6b, 0x3b, 0x36, 0x3b, 0x8e, 0x8e, 0xa8, 0x3b,
+	0xc2, 0x28, 0xd7, 0x0f, 0x0d, 0x3d, 0x86, 0x19, 0x85, 0xf2, 0x28, 0x29, 0x5a, 0x8e, 0x9e, 0x42,
+	0x1c, 0x2c, 0xea, 0x00, 0xb9, 0x70, 0xc9, 0xea, 0xa9, 0x4c, 0x29, 0x96, 0x78, 0x23, 0xee, 0xbe,
+	0x9f, 0xe2, 0x25, 0x1e, 0x74, 0x42, 0x8f, 0x68, 0x03, 0x66, 0x2c, 0x3b, 0xe0, 0x5f, 0x47, 0x9a,
+	0xf7, 0x56, 0xf6, 0x89, 0x79, 0x50, 0x86, 0x7f, 0x16, 0x91, 0xa1, 0x6d, 0x68, 0x5a, 0x19, 0x85,
+	0x4d, 0xab, 0x65, 0x3f, 0x49, 0xb1, 0x4a, 0x27, 0x46, 0x9a, 0xa3, 0x8e, 0x59, 0xfb, 0x43, 0xe2,
+	0x74, 0x76, 0x48, 0xc0, 0x34, 0xe8, 0x3b, 0xb4, 0x0c, 0x05, 0xfa, 0x08, 0x1a, 0x12, 0xb2, 0xe3,
+	0x63, 0xd3, 0x76, 0x43, 0x93, 0xe5, 0x49, 0x5d, 0xa4, 0x09, 0x42, 0x73, 0xf1, 0x44, 0x6c, 0x2e,
+	0xbe, 0x0e, 0x53, 0xe2, 0xe8, 0xdf, 0x8e, 0xbd, 0x06, 0x0d, 0xb9, 0x97, 0x32, 0x60, 0xd4, 0x82,
+	0x66, 0x04, 0x92, 0x12, 0x68, 0xa0, 0xbd, 0x7a, 0xba, 0x6d, 0x9c, 0xeb, 0x80, 0x2b, 0x86, 0x82,
+	0xd3, 0xc4, 0x7b, 0x73, 0x52, 0x2a, 0x86, 0x69, 0x28, 0x7a, 0x04, 0xd3, 0x0e, 0x35, 0x31, 0x5f,
+	0xba, 0x1b, 0xbb, 0x6a, 0xf1, 0x6a, 0x53, 0xd9, 0x19, 0xe9, 0x21, 0x40, 0xe5, 0x49, 0xd1, 0x12,
+	0xc0, 0xc1, 0xbd, 0x40, 0xf1, 0x37, 0xad, 0x99, 0xd5, 0xbc, 0x3f, 0xed, 0xee, 0x12, 0xdf, 0x25,
+	0x8c, 0x04, 0x29, 0xa7, 0x97, 0x91, 0x20, 0x42, 0xf7, 0xa0, 0xe6, 0xd0, 0xf6, 0x52, 0xf0, 0x49,
+	0x40, 0x5d, 0xed, 0x95, 0xbe, 0x33, 0x11, 0x23, 0xa3, 0x77, 0x61, 0xcc, 0xa1, 0xed, 0x36, 0x7f,
+	0x85, 0xe9, 0x9c, 0xfe, 0x27, 0xf8, 0xeb, 0x86, 0x6c, 0x56, 0x4f, 0x0d, 0xb1, 0xd1, 0x0a, 0x34,
+	0xb8, 0xc0, 0xb5, 0x76, 0xe4, 0x61, 0x37, 0xe0, 0x9c, 0x09, 0x65, 0xc9, 0x37, 0x93, 0xcd, 0x8a,
+	0x3c, 0x4d, 0x83, 0xe6, 0x61, 0x94, 0x03, 0xd6, 0x57, 0xb5, 0x77, 0xc4, 0xa7, 0x56, 0x77, 0x5c,
+	0x3c, 0xe5, 0x57, 0x8f, 0x08, 0x7b, 0x4a, 0xfd, 0x83, 0x40, 0x9b, 0x29, 0xf9, 0x75, 0x53, 0x54,
+	0x7c, 0x42, 0x3b, 0xd4, 0xb5, 0x19, 0xe5, 0x48, 0x5c, 0x29, 0x12, 0xf2, 0x7e, 0xc3, 0xc8, 0x40,
+	0xf9, 0xd1, 0xd1, 0xe1, 0xfe, 0xba, 0xb9, 0xec, 0xd1, 0xb1, 0xb9, 0xb3, 0xd1, 0x0a, 0x8f, 0x0e,
+	0x8e, 0x81, 0x3e, 0x82, 0x89, 0x4e, 0xd7, 0x61, 0xb6, 0x72, 0xdc, 0x68, 0xf3, 0x82, 0xe2, 0x4a,
+	0x82, 0x22, 0xd1, 0xaa, 0x28, 0x53, 0x14, 0xdc, 0x85, 0xe7, 0xca, 0xf1, 0x69, 0xaf, 0x8b, 0x57,
+	0x0e, 0x6f, 0xd1, 0x5d, 0x98, 0xe7, 0xd6, 0xfc, 0x47, 0xad, 0x16, 0xe1, 0xc7, 0x54, 0xc2, 0x57,
+	0xf5, 0x86, 0x60, 0x9f, 0x3d, 0x5a, 0xd1, 0xf7, 0xe0, 0x0a, 0xed, 0xd8, 0xac, 0x65, 0x5b, 0xc4,
+	0xc4, 0xfe, 0xba, 0xfb, 0x7d, 0xc1, 0xf4, 0xe4, 0xc3, 0x37, 0xb1, 0xa7, 0xbd, 0xd6, 0x77, 0x39,
+	0x9c, 0x48, 0x8f, 0x3e, 0x84, 0x09, 0xea, 0xc6, 0x1e, 0x32, 0xed, 0x42, 0xdf, 0xfe, 0x52, 0xf8,
+	0xc8, 0x80, 0x79, 0xea, 0x11, 0x1f, 0x33, 0xea, 0x4b, 0x2f, 0xd3, 0xe7, 0x64, 0x77, 0x9f, 0xd2,
+	0x83, 0x40, 0xfb, 0x46, 0xdf, 0x9e, 0x7a, 0x50, 0xa2, 0xef, 0xc2, 0x1c, 0xed, 0xb2, 0x5d, 0xda,
+	0x75, 0xad, 0x1d, 0x1f, 0xef, 0xed, 0xd9, 0xa6, 0xe2, 0x17, 0x9a, 0xe8, 0xf2, 0xd5, 0x78, 0x42,
+	0xb6, 0x8a, 0xd0, 0xd4, 0xcc, 0x14, 0xf7, 0xc1, 0xd9, 0xb7, 0x17, 0x33, 0xe0, 0x07, 0xd8, 0x76,
+	0xb6, 0x3c, 0xe2, 0x6a, 0x17, 0xfb, 0xb3, 0xef, 0x02, 0x32, 0xce, 0xd4, 0x24, 0x38, 0xfe, 0x82,
+	0x97, 0x24, 0x53, 0xcb, 0x80, 0xd1, 0x5b, 0x30, 0xed, 0xf9, 0x36, 0xe5, 0x67, 0xeb, 0x8a, 0x83,
+	0x83, 0x80, 0xb7, 0x68, 0x97, 0x39, 0xae, 0xe0, 0xe3, 0xf9, 0x46, 0x2e, 0x52, 0x78, 0x3e, 0xed,
+	0x10, 0xb6, 0x4f, 0xba, 0x41, 0xdc, 0xff, 0xdb, 0x52, 0xa4, 0x28, 0x68, 0x12, 0x46, 0x03, 0x9f,
+	0x1e, 0x1d, 0x6b, 0x57, 0x16, 0x2a, 0x19, 0xa3, 0x01, 0x07, 0x47, 0x46, 0x03, 0x7e, 0x83, 0xde,
+	0x85, 0x9a, 0xb8, 0x58, 0x77, 0x6d, 0xa6, 0x5d, 0x55, 0x16, 0xac, 0x34, 0x01, 0x6f, 0x52, 0x44,
+	0x31, 0x2e, 0x7a, 0x15, 0x86, 0x02, 0x2b, 0xd0, 0x5e, 0xcc, 0x2a, 0x1b, 0xad, 0xd5, 0x70, 0x3b,
+	0xf1, 0xf6, 0xd0, 0x3d, 0x78, 0x2d, 0x76, 0x0f, 0x2e, 0x02, 0x62, 0xc4, 0x21, 0x1d, 0xc2, 0xfc,
+	0xc4, 0xf7, 0x5a, 0x10, 0x08, 0x05, 0x2d, 0x68, 0x11, 0x46, 0x99, 0x8f, 0x4d, 0xe2, 0x6b, 0x2f,
+	0x2d, 0x54, 0xd2, 0x86, 0x89, 0x1d, 0x01, 0x0f, 0xad, 0x56, 0x12, 0x0b, 0x2d, 0x40, 0x9d, 0xf9,
+	0xdd, 0x80, 0xad, 0xd2, 0x0e, 0xb6, 0x5d, 0x4d, 0x17, 0x1d, 0x27, 0x41, 0x62, 0x04, 0xf1, 0xed,
+	0x92, 0x63, 0xe3, 0x80, 0x04, 0xda, 0x0d, 0xb1, 0x03, 0x0b, 0x5a, 0xd0, 0x6d, 0x18, 0xed, 0x06,
+	0x64, 0x73, 0x65, 0x5b, 0x7b, 0xb9, 0xef, 0xfa, 0x50, 0x98, 0xe8, 0x03, 0xa8, 0x8b, 0x23, 0xc5,
+	0x20, 0x1d, 0xca, 0x88, 0xf6, 0x66, 0x5f, 0xc2, 0x24, 0x3a, 0x7a, 0x0c, 0x9a, 0xe9, 0x13, 0xcc,
+	0x88, 0xbc, 0x6f, 0x1d, 0x9a, 0x6b, 0xae, 0xe5, 0x51, 0xdb, 0x65, 0x81, 0xf6, 0xcd, 0xbe, 0x5d,
+	0xf5, 0xa4, 0xe5, 0x7c, 0xc4, 0x17, 0xd0, 0x6d, 0xdb, 0xa1, 0x6c, 0x45, 0xa0, 0x25, 0x10, 0xb4,
+	0xc5, 0xfe, 0x7c, 0xe4, 0x24, 0x7a, 0xbe, 0x58, 0x55, 0xbb, 0x58, 0xf7, 0x4b, 0x96, 0x25, 0xce,
+	0xbb, 0x9b, 0x72, 0xb1, 0x16, 0x34, 0xf1, 0xb9, 0x48, 0xf4, 0x18, 0x12, 0xbc, 0x25, 0x57, 0x43,
+	0xbe, 0x85, 0x73, 0x50, 0x09, 0xdd, 0x09, 0x57, 0x4a, 0x48, 0x73, 0x4b, 0xd0, 0xf4, 0x68, 0xe5,
+	0xab, 0x48, 0x7c, 0x60, 0x4b, 0xbb, 0x9b, 0x5d, 0x45, 0xeb, 0x02, 0x1e, 0xae, 0x22, 0x89, 0x85,
+	0xd6, 0xa1, 0x7e, 0x70, 0x2f, 0xd8, 0x3a, 0x24, 0xbe, 0x83, 0x8f, 0x03, 0xed, 0xdd, 0xd3, 0x09,
+	0x1e, 0x49, 0x5a, 0xb4, 0x06, 0x93, 0xc2, 0x55, 0xbd, 0x89, 0x5d, 0x7b, 0x4f, 0x58, 0xde, 0xef,
+	0x2d, 0x0c, 0xa5, 0x8f, 0xd1, 0xb5, 0x64, 0xbb, 0x1a, 0x49, 0x86, 0x48, 0x5f, 0x85, 0x89, 0xe4,
+	0x48, 0x07, 0x74, 0x0d, 0xbc, 0x01, 0x33, 0x05, 0x47, 0x3e, 0x57, 0x36, 0x1d, 0x11, 0x96, 0x20,
+	0x15, 0x50, 0x79, 0xa3, 0xff, 0xde, 0x0c, 0xcc, 0x16, 0xa9, 0x6b, 0xbf, 0x90, 0xf6, 0xfe, 0x8f,
+	0xa0, 0x61, 0x76, 0x03, 0x46, 0x3b, 0x2d, 0x69, 0xb3, 0xd4, 0x46, 0xfb, 0xbe, 0x70, 0x9a, 0x80,
+	0x7f, 0x64, 0x8b, 0xec, 0x76, 0xdb, 0x2a, 0xd2, 0x45, 0xde, 0x70, 0xf9, 0xc8, 0x92, 0xac, 0x4a,
+	0xc6, 0xb6, 0xa8, 0xbb, 0xbc, 0x7f, 0xa1, 0x36, 0xb8, 0x7f, 0x01, 0x4e, 0xed, 0x5f, 0xa8, 0x9f,
+	0xc6, 0xbf, 0xb0, 0x00, 0x75, 0x72, 0xc4, 0x88, 0xef, 0x62, 0x67, 0x7d, 0x3b, 0xd0, 0x26, 0x04,
+	0x27, 0x4d, 0x82, 0xd0, 0xfd, 0x94, 0xfc, 0xdb, 0xe8, 0x3b, 0x9c, 0x04, 0x36, 0x5a, 0x85, 0xa9,
+	0xf8, 0xee, 0x21, 0x63, 0x5e, 0x18, 0x0c, 0x70, 0x52, 0x07, 0x59, 0x92, 0x84, 0x0f, 0x64, 0xea,
+	0x34, 0x3e, 0x90, 0xd7, 0x60, 0xd2, 0xa1, 0xd8, 0x5a, 0xc6, 0x0e, 0x76, 0x4d, 0xe2, 0xaf, 0x6f,
+	0x0b, 0xe1, 0xbd, 0x66, 0x64, 0xa0, 0x3c, 0x40, 0x27, 0x09, 0x69, 0x09, 0x35, 0xcc, 0xc0, 0x6e,
+	0x9b, 0x70, 0x6b, 0x38, 0xff, 0x1e, 0x3d, 0xdb, 0xd1, 0x1a, 0xa0, 0x94, 0xc8, 0x2c, 0x6c, 0xfb,
+	0x1a, 0x3a, 0xc9, 0xe4, 0x5f, 0x40, 0x90, 0x73, 0xd7, 0xcc, 0x9c, 0xa3, 0xbb, 0x66, 0xf6, 0x19,
+	0xba, 0x6b, 0xe6, 0x9e, 0x87, 0xb9, 0x76, 0xfe, 0x99, 0xba, 0x6b, 0x2e, 0x94, 0x70, 0xd7, 0x64,
+	0x6d, 0xbb, 0x5a, 0x0f, 0xdb, 0xee, 0x72, 0xd2, 0xb6, 0x7b, 0xf1, 0x14, 0xf3, 0x10, 0x93, 0xa1,
+	0xb7, 0xa5, 0xb0, 0x76, 0x29, 0xab, 0x77, 0xa6, 0x99, 0x7b, 0xcb, 0x0a, 0x92, 0xa2, 0x5b, 0xce,
+	0x31, 0x74, 0xf9, 0xec, 0x8e, 0xa1, 0x2b, 0xe7, 0xe0, 0x18, 0xba, 0x9a, 0x70, 0x0c, 0xdd, 0x55,
+	0x8e, 0x21, 0x29, 0x86, 0xea, 0xbd, 0xde, 0xec, 0xab, 0x43, 0xcf, 0x4d, 0xf9, 0x88, 0x0a, 0xac,
+	0xd6, 0xd7, 0x9e, 0x81, 0xd5, 0x7a, 0xe1, 0xac, 0x56, 0xeb, 0x1b, 0xd0, 0xc4, 0x9e, 0x58, 0x0c,
+	0x2c, 0x62, 0x0c, 0x2f, 0x89, 0xf7, 0xcf, 0xc1, 0xd1, 0x1d, 0x98, 0x0b, 0x59, 0x6e, 0x5a, 0x61,
+	0x92, 0x22, 0x70, 0x71, 0x63, 0xd6, 0x2e, 0xfe, 0xf2, 0xd9, 0xec, 0xe2, 0xdc, 0xf0, 0xaa, 0x0c,
+	0xc0, 0x72, 0xb0, 0xaf, 0x9c, 0xd2, 0xf0, 0x9a, 0x24, 0x46, 0xdf, 0x85, 0x59, 0x6c, 0x59, 0x36,
+	0xef, 0x59, 0xd8, 0x80, 0x19, 0xb6, 0x5d, 0xe2, 0x9f, 0xda, 0x0c, 0x54, 0xd8, 0x09, 0xda, 0x84,
+	0x86, 0xb2, 0xa2, 0xaa, 0xe5, 0xfd, 0xda, 0xe9, 0x7a, 0x4d, 0x53, 0x73, 0x15, 0x3a, 0x65, 0x71,
+	0x7e, 0xbd, 0xbf, 0x0a, 0x9d, 0xc4, 0x47, 0x6f, 0xca, 0x78, 0xe2, 0xeb, 0x7d, 0xc9, 0x38, 0x9a,
+	0xfe, 0xfb, 0x15, 0xb8, 0xd0, 0x63, 0xf3, 0x9e, 0xab, 0x83, 0x2e, 0xe5, 0x58, 0x1a, 0x2a, 0xeb,
+	0x58, 0xd2, 0xf7, 0x41, 0xeb, 0xb5, 0x01, 0x07, 0x1c, 0xde, 0x3c, 0x8c, 0x06, 0xdd, 0xbd, 0x3d,
+	0xfb, 0x48, 0x8d, 0x4f, 0xdd, 0xe9, 0x9f, 0xc3, 0xb5, 0xd8, 0x78, 0xb6, 0xe6, 0x1e, 0x6e, 0xda,
+	0x47, 0xc4, 0x5f, 0xb2, 0xb0, 0xc7, 0xce, 0x16, 0xe9, 0xaa, 0xff, 0x45, 0x05, 0x2e, 0xf4, 0x30,
+	0xcb, 0x0d, 0xf8, 0x0a, 0x1f, 0x40, 0x5d, 0x19, 0x58, 0x85, 0x0c, 0xd3, 0xdf, 0xb7, 0x92, 0x44,
+	0xe7, 0x32, 0x96, 0xf2, 0x8b, 0x08, 0x9b, 0x81, 0x0c, 0xab, 0x4b, 0x82, 0x74, 0x0b, 0xd0, 0x06,
+	0xc5, 0x56, 0x6b, 0x9f, 0x58, 0x56, 0x2c, 0xd9, 0xdf, 0x80, 0xa6, 0x83, 0x19, 0x71, 0xcd, 0xe3,
+	0x9d, 0x7d, 0x9f, 0x04, 0xfb, 0xd4, 0xb1, 0x94, 0x90, 0x9f, 0x83, 0x23, 0x1d, 0x86, 0x3b, 0xd4,
+	0x92, 0x4b, 0x60, 0xf2, 0xf6, 0x64, 0x3c, 0xd1, 0x1c, 0x6a, 0x88, 0x36, 0xdd, 0x07, 0x88, 0x4d,
+	0x66, 0x03, 0x7e, 0x89, 0x45, 0x18, 0xe6, 0xe2, 0x7b, 0x89, 0x4f, 0x20, 0xf0, 0xf4, 0x5f, 0x81,
+	0x99, 0x02, 0x43, 0xe3, 0x80, 0x0f, 0x97, 0xda, 0xfc, 0xfa, 0xc6, 0x72, 0x89, 0xc7, 0x2b, 0x4c,
+	0xfd, 0xbf, 0xaa, 0x70, 0x45, 0xac, 0xac, 0x84, 0x5e, 0x29, 0x96, 0x58, 0xb8, 0x22, 0xb6, 0xa0,
+	0x71, 0x10, 0x2d, 0x16, 0x2e, 0x3f, 0xcb, 0x01, 0x7d, 0xa3, 0xc8, 0xc4, 0x5b, 0xb8, 0x4a, 0x8d,
+	0x34, 0x3d, 0x7a, 0x00, 0x10, 0xdb, 0x76, 0xd4, 0x48, 0x5f, 0x4b, 0x19, 0x66, 0x54, 0x5b, 0x41,
+	0x57, 0x09, 0x4a, 0xf4, 0x2e, 0x8c, 0x04, 0xcc, 0xb2, 0xa9, 0x36, 0x94, 0x3d, 0xfb, 0x5b, 0x1c,
+	0x5c, 0x40, 0x2d, 0xf1, 0xb9, 0x02, 0x1c, 0x30, 0x6c, 0x1e, 0x58, 0xbe, 0x7d, 0x48, 0x0a, 0x7c,
+	0xea, 0xad, 0xb8, 0xb1, 0xa0, 0x93, 0x24, 0x2d, 0xf7, 0x45, 0x74, 0x03, 0x12, 0x22, 0x18, 0xab,
+	0x81, 0x36, 0xd2, 0xf7, 0xcb, 0x67, 0x28, 0xf4, 0x9f, 0x55, 0xe1, 0xa2, 0x78, 0x4e, 0x68, 0x3e,
+	0xf8, 0xe5, 0xe7, 0xff, 0x3a, 0x3f, 0xff, 0xdf, 0x54, 0xa0, 0x2e, 0x9e, 0xa3, 0x3e, 0xf8, 0xdb,
+	0x30, 0x2a, 0x4d, 0x9b, 0xea, 0x4b, 0x5f, 0x4e, 0x98, 0xc7, 0xe3, 0x59, 0x0a, 0x75, 0x29, 0x89,
+	0x8a, 0x3e, 0x80, 0x5a, 0x64, 0xdf, 0xd3, 0xaa, 0x59, 0xd1, 0x28, 0xbd, 0xbf, 0x14, 0x69, 0x4c,
+	0x80, 0x96, 0x61, 0x1c, 0xab, 0x59, 0xd7, 0x86, 0xb2, 0x13, 0x72, 0xd2, 0xe6, 0x34, 0x22, 0x3a,
+	0xfd, 0xa7, 0xc3, 0x30, 0x9d, 0x1b, 0xdf, 0xcf, 0x9d, 0x35, 0x43, 0x59, 0x29, 0x86, 0x07, 0xb1,
+	0x52, 0x24, 0x78, 0xe2, 0xc8, 0x00, 0x87, 0xff, 0x68, 0xf2, 0xf0, 0x3f, 0xdf, 0x30, 0xe8, 0xac,
+	0xbe, 0x33, 0xde, 0x43, 0xdf, 0xf9, 0x76, 0x62, 0x9e, 0xa5, 0xc9, 0xe3, 0xe5, 0xc2, 0xc5, 0xd5,
+	0x6b, 0x92, 0xb9, 0x33, 0x22, 0x20, 0x01, 0x3f, 0x27, 0x42, 0x4d, 0x6d, 0xad, 0xb4, 0x19, 0xa4,
+	0x07, 0x65, 0x5a, 0x0e, 0xaa, 0x97, 0x96, 0x83, 0xfe, 0x1d, 0x60, 0xb6, 0x68, 0x5d, 0x17, 0x2e,
+	0xb9, 0xea, 0x39, 0x2c, 0xb9, 0xa1, 0x12, 0x4b, 0x6e, 0xb8, 0xf7, 0x92, 0x1b, 0x39, 0xe3, 0x92,
+	0x1b, 0x3d, 0xb5, 0x9d, 0x69, 0xec, 0x34, 0x76, 0xa6, 0x68, 0x99, 0x8e, 0x27, 0x97, 0xe9, 0x47,
+	0x30, 0xc1, 0x4d, 0x2b, 0x81, 0x92, 0x7b, 0xb4, 0x5a, 0xd6, 0x61, 0x97, 0x97, 0x8a, 0x8c, 0x14,
+	0xc5, 0xcf, 0x6d, 0xa0, 0x6b, 0x76, 0xcb, 0x4c, 0xf4, 0xcc, 0x57, 0xc8, 0x69, 0xb3, 0x53, 0xcf,
+	0x40, 0x9b, 0x6d, 0x9e, 0x55, 0x9b, 0x8d, 0x1d, 0x29, 0xd3, 0xa5, 0x1d, 0x29, 0xc2, 0x41, 0xe0,
+	0x51, 0x9f, 0x2d, 0x63, 0x66, 0xee, 0x6f, 0xe2, 0x23, 0x6e, 0xcb, 0x55, 0xc1, 0xa1, 0x05, 0x2d,
+	0x5c, 0x0b, 0x4e, 0x43, 0x79, 0x88, 0x96, 0x4d, 0xa4, 0x7f, 0xb9, 0x61, 0x14, 0x37, 0xa6, 0xf7,
+	0x77, 0xa3, 0x74, 0x00, 0x5d, 0x6f, 0x56, 0x33, 0x39, 0x30, 0xab, 0xe9, 0x67, 0x2e, 0x9b, 0x7d,
+	0x1e, 0xe6, 0xb2, 0xb9, 0xaf, 0x21, 0x9f, 0x62, 0xfe, 0x8c, 0x81, 0xba, 0x0e, 0xa0, 0xbc, 0x6b,
+	0x7e, 0x40, 0x25, 0x61, 0x01, 0xea, 0x2a, 0x9f, 0x53, 0x68, 0x5b, 0x52, 0xe7, 0x4c, 0x82, 0xf4,
+	0x1f, 0x0d, 0xc3, 0x14, 0x8f, 0x40, 0x5a, 0x6a, 0x13, 0x97, 0x9d, 0x51, 0x21, 0x11, 0x9c, 0xb0,
+	0x3a, 0x10, 0x27, 0x1c, 0x4a, 0x72, 0xc2, 0x2c, 0x1f, 0x1b, 0x1e, 0x98, 0x8f, 0x65, 0xa6, 0x66,
+	0xe4, 0x8c, 0x56, 0xa1, 0x7e, 0x6b, 0x7a, 0xf4, 0x79, 0xac, 0xe9, 0xb1, 0x67, 0xb0, 0xa6, 0xf5,
+	0x5f, 0xaf, 0xc0, 0xe5, 0x13, 0xe2, 0x11, 0xd0, 0x87, 0x29, 0x15, 0xfb, 0x46, 0xa9, 0x20, 0x86,
+	0xc5, 0xcd, 0x58, 0xfd, 0xbe, 0x0e, 0xc3, 0xfc, 0x0e, 0x35, 0xa0, 0xb6, 0xb4, 0xb1, 0xb1, 0xf5,
+	0xf9, 0x93, 0xa5, 0x47, 0x5f, 0x36, 0x5f, 0x40, 0xd3, 0xd0, 0x30, 0xd6, 0x3e, 0x5e, 0x6f, 0xed,
+	0x18, 0x5f, 0x3e, 0xd9, 0x7a, 0xb4, 0xf1, 0x65, 0xb3, 0xa2, 0xff, 0x74, 0x0a, 0xea, 0xd2, 0x4d,
+	0x7b, 0x96, 0xc5, 0xf9, 0x4c, 0x04, 0x95, 0x1e, 0x72, 0x6f, 0x56, 0x98, 0x19, 0x2e, 0x10, 0x66,
+	0x4e, 0x91, 0x46, 0x5c, 0x20, 0xd1, 0xde, 0x81, 0xb1, 0x40, 0xc6, 0xc0, 0x94, 0x49, 0xb9, 0x51,
+	0xa8, 0xe8, 0x15, 0x68, 0x88, 0xd8, 0x82, 0x16, 0xee, 0x78, 0xfc, 0x54, 0x13, 0xe2, 0x47, 0xc5,
+	0x48, 0x03, 0x07, 0x4d, 0x1d, 0x2e, 0x08, 0x2e, 0x85, 0xe2, 0xe0, 0x52, 0x25, 0xa3, 0xd5, 0x07,
+	0x91, 0xd1, 0xb2, 0x9c, 0x61, 0x62, 0x60, 0xce, 0x60, 0xc2, 0xb5, 0x83, 0x30, 0x39, 0x80, 0x8b,
+	0x0c, 0xc4, 0x3f, 0x14, 0xbc, 0xd6, 0x25, 0x26, 0x7f, 0xf0, 0x52, 0x9b, 0x68, 0x8d, 0x7e, 0x8e,
+	0xca, 0x7e, 0x3d, 0xa0, 0x0d, 0x1e, 0x0f, 0xe9, 0x39, 0xf4, 0xb8, 0x43, 0x5c, 0x26, 0xfd, 0x72,
+	0xda, 0x64, 0xb9, 0x21, 0x1b, 0x39, 0xca, 0x5c, 0xa4, 0xd9, 0xd4, 0x40, 0x91, 0x66, 0xfd, 0x78,
+	0x58, 0xf3, 0x79, 0xf0, 0xb0, 0xe9, 0x67, 0x71, 0x2e, 0xdf, 0x83, 0x9a, 0x19, 0x85, 0x96, 0xa1,
+	0xfe, 0x91, 0x86, 0x11, 0x32, 0xba, 0x0b, 0x63, 0xca, 0x80, 0xaf, 0xcd, 0x64, 0xa5, 0x70, 0xc1,
+	0x8b, 0xd2, 0xe1, 0x8d, 0x21, 0x72, 0x42, 0x30, 0x9c, 0x2d, 0x2d, 0x18, 0xaa, 0x63, 0x73, 0xee,
+	0x34, 0xc7, 0x66, 0x6c, 0xb6, 0x98, 0xcf, 0x9a, 0x2d, 0xc4, 0xf0, 0x0a, 0xcd, 0x16, 0x05, 0xd2,
+	0xb5, 0xf6, 0x0c, 0xa4, 0xeb, 0x8b, 0xe7, 0x9c, 0xe1, 0x70, 0xe9, 0x8c, 0x67, 0xf6, 0x26, 0x34,
+	0xb0, 0xe7, 0x25, 0x42, 0x14, 0x2f, 0x9f, 0xd2, 0x3f, 0x92, 0xa2, 0x46, 0xfb, 0xf0, 0x92, 0x3c,
+	0x53, 0xb6, 0xf9, 0x94, 0x9a, 0xd4, 0x69, 0xb9, 0xf6, 0xde, 0x9e, 0x7c, 0xaf, 0xf0, 0xec, 0xd3,
+	0xae, 0xf4, 0x9d, 0xfd, 0xfe, 0x9d, 0xa0, 0x3d, 0x58, 0xe8, 0x89, 0xb4, 0xee, 0xca, 0x07, 0x5d,
+	0xed, 0xfb, 0xa0, 0xbe, 0x7d, 0x14, 0xe8, 0x7a, 0x2f, 0x9e, 0x41, 0xd7, 0xfb, 0x36, 0xcf, 0xac,
+	0xe7, 0xeb, 0x4e, 0x86, 0x06, 0x68, 0xd7, 0x0a, 0x17, 0xe8, 0x4a, 0x02, 0xc5, 0x48, 0x11, 0xe8,
+	0x7f, 0x55, 0x01, 0x94, 0xdf, 0x63, 0x22, 0xe2, 0x59, 0x02, 0xc2, 0xb8, 0x95, 0x8a, 0x8a, 0x78,
+	0x4e, 0x41, 0xd1, 0x67, 0x30, 0x67, 0x47, 0x84, 0x8c, 0xaf, 0x30, 0xe2, 0x6f, 0xc6, 0x92, 0x4a,
+	0xa2, 0x40, 0x42, 0x21, 0x9a, 0x51, 0x4c, 0xcd, 0xcf, 0xf4, 0xb0, 0xc1, 0xc1, 0x41, 0xa0, 0xe4,
+	0xd5, 0x14, 0x4c, 0x5f, 0x87, 0xe9, 0xdc, 0xee, 0x1b, 0xd0, 0x6b, 0xf3, 0xe3, 0x0a, 0x4c, 0x65,
+	0x6d, 0x2d, 0x83, 0x09, 0x3e, 0x6f, 0x40, 0xf5, 0xf0, 0x96, 0x56, 0xcd, 0xce, 0x42, 0xd4, 0xf9,
+	0xe3, 0x5b, 0x8a, 0x4d, 0x54, 0x0f, 0x6f, 0x09, 0xe4, 0xdb, 0xda, 0x50, 0x6f, 0xe4, 0xdb, 0x11,
+	0xf2, 0x6d, 0xfe, 0xba, 0xb9, 0x5e, 0x06, 0x7c, 0xdd, 0x7f, 0xae, 0xc0, 0x74, 0xee, 0x21, 0x03,
+	0xbe, 0xf0, 0x5a, 0x81, 0xc9, 0xfb, 0xd5, 0xc2, 0x77, 0x89, 0xad, 0xdf, 0x05, 0x16, 0xef, 0x87,
+	0x69, 0xc3, 0x75, 0xce, 0x52, 0x9b, 0xe8, 0x47, 0xd8, 0xb0, 0x57, 0x05, 0x5e, 0x81, 0xdd, 0x5a,
+	0x6f, 0xc1, 0xe5, 0x13, 0x1e, 0x3a, 0xe0, 0x17, 0xfb, 0xbb, 0x2a, 0x5c, 0x39, 0x69, 0x08, 0x03,
+	0x7e, 0xbc, 0x3b, 0x71, 0x88, 0x7d, 0x89, 0x9c, 0x29, 0x85, 0xca, 0xa3, 0xa2, 0xe2, 0x30, 0xf5,
+	0x12, 0x69, 0x3f, 0x09, 0x6c, 0x74, 0x17, 0xc6, 0x19, 0xf5, 0xa8, 0x43, 0xdb, 0xc7, 0x25, 0xb2,
+	0x7b, 0x22, 0x5c, 0xf4, 0x50, 0x84, 0xca, 0xed, 0xd9, 0x6d, 0x1e, 0x9f, 0xe8, 0xdb, 0x56, 0xf9,
+	0xbc, 0xd0, 0x0c, 0x9d, 0xbe, 0xa6, 0xb6, 0x6d, 0x92, 0x27, 0xf1, 0x08, 0xd0, 0xa0, 0xbb, 0x1b,
+	0x98, 0xbe, 0xbd, 0x4b, 0xac, 0x38, 0x99, 0x45, 0xf2, 0x9c, 0xa2, 0x26, 0xfd, 0x07, 0x50, 0x4f,
+	0x44, 0xc7, 0xf0, 0x28, 0x0f, 0x97, 0xab, 0xdc, 0x92, 0x42, 0x5c, 0x47, 0x19, 0xb2, 0xd5, 0x44,
+	0x86, 0xec, 0x25, 0x18, 0xe7, 0x72, 0xe9, 0x76, 0x9c, 0x39, 0x1b, 0xdd, 0xf3, 0x0a, 0x24, 0xb2,
+	0x1a, 0x92, 0x68, 0x1d, 0x16, 0xad, 0x09, 0x88, 0xfe, 0x2f, 0x63, 0xd0, 0xcc, 0xad, 0xa7, 0x28,
+	0xe6, 0x36, 0x6e, 0x09, 0x07, 0x59, 0x62, 0x25, 0xf4, 0xa4, 0x1d, 0x30, 0x9d, 0x2e, 0xab, 0xe7,
+	0x0c, 0xf5, 0xd0, 0x73, 0x54, 0x86, 0xcd, 0x70, 0xae, 0x20, 0xd3, 0x48, 0x1c, 0x71, 0x7d, 0x85,
+	0x6b, 0x26, 0x8c, 0xb8, 0x51, 0x95, 0x82, 0x9a, 0x11, 0x03, 0x72, 0xaa, 0xc1, 0xd8, 0xc0, 0xaa,
+	0xc1, 0x12, 0x4c, 0x06, 0xa6, 0x8f, 0x85, 0xd4, 0x42, 0xfc, 0x43, 0xec, 0x68, 0xe3, 0xfd, 0x34,
+	0x81, 0x0c, 0x81, 0xb0, 0xbb, 0x50, 0x97, 0x91, 0x23, 0xb6, 0x8d, 0xd9, 0xbe, 0x56, 0x53, 0x76,
+	0x97, 0x18, 0x94, 0x14, 0x31, 0x21, 0x2b, 0x62, 0xe6, 0xcb, 0xc6, 0xc5, 0x22, 0xe6, 0xfb, 0x30,
+	0xa6, 0x62, 0x8a, 0xb4, 0x7a, 0xd6, 0x17, 0x17, 0xcf, 0x9a, 0x3a, 0x0d, 0x43, 0x62, 0x45, 0x81,
+	0x3e, 0x84, 0xf1, 0x40, 0xa5, 0xc4, 0x69, 0x13, 0xd9, 0x50, 0xa3, 0x24, 0xb5, 0xc4, 0x09, 0x5d,
+	0x12, 0x21, 0xcd, 0x39, 0x57, 0x0e, 0xe9, 0xab, 0x8a, 0x4c, 0x3e, 0x0f, 0x55, 0x64, 0xea, 0x59,
+	0xa8, 0x22, 0x29, 0xa5, 0xba, 0x59, 0xda, 0xef, 0xf2, 0xa7, 0x15, 0xb8, 0x72, 0x92, 0x8f, 0x76,
+	0x40, 0x2e, 0xbf, 0x05, 0x73, 0x1d, 0x59, 0x04, 0x60, 0xed, 0xc8, 0xb3, 0xfd, 0xe3, 0x28, 0x34,
+	0xb7, 0xda, 0x6f, 0x9d, 0x17, 0xd3, 0xe9, 0xdb, 0xa0, 0xf5, 0x5a, 0x3d, 0x03, 0x9e, 0x6f, 0x3f,
+	0xa9, 0xc0, 0x85, 0x1e, 0xcb, 0x39, 0x5b, 0xf6, 0xb0, 0x32, 0x48, 0xd9, 0xc3, 0xb5, 0x04, 0xdb,
+	0xad, 0x66, 0x9d, 0xec, 0xb9, 0x07, 0x3f, 0x52, 0xa8, 0xe1, 0x86, 0x08, 0x49, 0xf5, 0x03, 0xb8,
+	0xd6, 0x07, 0x79, 0xf0, 0x02, 0x0b, 0xd1, 0x51, 0xd1, 0x90, 0x47, 0x85, 0xfe, 0x87, 0x0d, 0xa8,
+	0x27, 0x52, 0x5f, 0x92, 0x3d, 0xbf, 0x5c, 0xbe, 0xe7, 0x57, 0xa0, 0x81, 0x4d, 0x93, 0x04, 0xc1,
+	0x06, 0x6d, 0xf3, 0xba, 0x83, 0xea, 0x84, 0x4a, 0x03, 0xb9, 0x39, 0x27, 0x06, 0x50, 0xbf, 0x83,
+	0xc3, 0x5a, 0x0f, 0x59, 0x30, 0x5a, 0x87, 0xe9, 0x08, 0xb4, 0xe6, 0x9a, 0xd4, 0x0a, 0x65, 0x80,
+	0xc9, 0xa4, 0x08, 0x99, 0x43, 0x31, 0xf2, 0x54, 0xfc, 0xbc, 0xc3, 0x5d, 0x46, 0x65, 0x5e, 0x97,
+	0x3a, 0x0b, 0x12, 0x10, 0x3e, 0x74, 0x65, 0xba, 0x56, 0x49, 0x31, 0xf2, 0x70, 0x48, 0x03, 0x79,
+	0x0d, 0x45, 0x93, 0x76, 0x3c, 0xea, 0x72, 0xcb, 0x49, 0x58, 0xa6, 0x50, 0x1e, 0x17, 0xf9, 0x06,
+	0xc5, 0xa9, 0xcd, 0xae, 0xef, 0xf3, 0x18, 0x22, 0x71, 0x6a, 0x34, 0x8c, 0x24, 0x88, 0x1f, 0x07,
+	0x96, 0x1b, 0x18, 0x64, 0x8f, 0x87, 0x17, 0x19, 0x98, 0x91, 0x12, 0xc7, 0x41, 0x9a, 0x20, 0xce,
+	0x62, 0x15, 0xb5, 0xcb, 0xba, 0x1d, 0x4f, 0xab, 0xf5, 0x9d, 0xb0, 0x0c, 0x05, 0x4f, 0x8f, 0x27,
+	0x89, 0xf2, 0x1d, 0xa1, 0x16, 0x94, 0x3b, 0x3c, 0xf2, 0x35, 0x3e, 0x8c, 0x22, 0x42, 0xf4, 0x21,
+	0x0f, 0xe3, 0x3a, 0xa4, 0xc7, 0x2d, 0x86, 0x59, 0x60, 0x69, 0xf5, 0x12, 0xfd, 0x24, 0x09, 0xb8,
+	0x84, 0xa4, 0x2a, 0x52, 0x2a, 0x45, 0x52, 0x06, 0x4a, 0xca, 0x1c, 0xd9, 0xa2, 0x26, 0xbe, 0xa6,
+	0x42, 0xf0, 0xb6, 0x0a, 0x29, 0x57, 0x39, 0xb3, 0x19, 0x70, 0x6c, 0xf5, 0x9c, 0x4c, 0x5a, 0x3d,
+	0xdf, 0x82, 0x19, 0xdb, 0xcd, 0x3f, 0x71, 0x4a, 0x3e, 0xd1, 0x76, 0x0b, 0x9f, 0x68, 0xbb, 0xa9,
+	0xae, 0x55, 0xd8, 0x7b, 0x16, 0xcc, 0xdd, 0x73, 0x3c, 0x72, 0xe6, 0xd0, 0xf6, 0x59, 0xc4, 0x31,
+	0x64, 0xfd, 0x97, 0x9a, 0x51, 0xd0, 0x92, 0x2a, 0x81, 0x89, 0xd2, 0x25, 0x30, 0xb9, 0x38, 0xec,
+	0xf9, 0xf6, 0xa1, 0xed, 0x90, 0x36, 0xb1, 0xb4, 0x99, 0xbe, 0x33, 0x9d, 0xc0, 0x46, 0xcb, 0x3c,
+	0xb3, 0x09, 0x5b, 0xb6, 0x4b, 0x82, 0x80, 0xe7, 0xa7, 0xd9, 0xd8, 0x59, 0x25, 0x0e, 0x3e, 0x6e,
+	0x11, 0x93, 0xba, 0x56, 0xa0, 0xb2, 0x42, 0x4f, 0xc4, 0x91, 0xb9, 0x45, 0xaa, 0x7d, 0x9b, 0xf8,
+	0x36, 0xb5, 0x42, 0xea, 0x39, 0x41, 0xdd, 0xa3, 0x15, 0x7d, 0x00, 0x17, 0xa3, 0x16, 0x9e, 0x13,
+	0xd8, 0xf5, 0x49, 0x1c, 0x6b, 0x37, 0x2f, 0x48, 0x7b, 0x23, 0xf0, 0xcd, 0x1b, 0x30, 0xcc, 0xba,
+	0x22, 0xe6, 0x55, 0x64, 0x5e, 0x36, 0x8c, 0x04, 0x24, 0x7d, 0x04, 0x6a, 0xa7, 0xb0, 0x2b, 0x87,
+	0x69, 0x73, 0x17, 0x05, 0x4f, 0x69, 0xc6, 0x34, 0x12, 0x1e, 0x25, 0xcc, 0xdd, 0x07, 0xcd, 0x53,
+	0x56, 0x8e, 0x55, 0xc2, 0xa4, 0x11, 0x36, 0x4c, 0xa3, 0x91, 0x69, 0x8c, 0x3d, 0xdb, 0xd1, 0x0e,
+	0xcc, 0x89, 0xb5, 0xbd, 0x14, 0xf2, 0xa4, 0x70, 0x7b, 0x5d, 0xce, 0x5a, 0xb3, 0xd6, 0x52, 0x68,
+	0x61, 0x76, 0x66, 0x21, 0x31, 0xba, 0x0d, 0xb3, 0x6a, 0x65, 0x87, 0x46, 0x1d, 0xb9, 0x62, 0xaf,
+	0x88, 0xd1, 0x14, 0xb6, 0xe5, 0xd3, 0x65, 0xae, 0x9e, 0x32, 0x5d, 0x26, 0x9f, 0x43, 0xf4, 0x62,
+	0x61, 0x0e, 0xd1, 0x77, 0x60, 0xde, 0xc3, 0x3e, 0x71, 0x59, 0x6b, 0xbf, 0xcb, 0x2c, 0xfa, 0x34,
+	0x7e, 0xe2, 0x42, 0xbf, 0x27, 0xf6, 0x20, 0xd4, 0x7f, 0xb5, 0x0a, 0xb3, 0x45, 0xdf, 0xe7, 0x19,
+	0x15, 0x19, 0xaa, 0x29, 0x15, 0x6a, 0xad, 0xa8, 0xc8, 0xd0, 0xcb, 0xbd, 0xa6, 0x2c, 0x81, 0xfa,
+	0x2c, 0xea, 0x0c, 0xfd, 0x6b, 0x05, 0x2e, 0xf6, 0x7c, 0x20, 0x1f, 0xbe, 0x70, 0x9b, 0x29, 0xad,
+	0x90, 0x5f, 0x8b, 0xf3, 0xca, 0xb1, 0xb9, 0xf7, 0x35, 0x8e, 0xa3, 0x56, 0xef, 0x9c, 0x6f, 0xe0,
+	0xdb, 0x8c, 0xb3, 0x0b, 0xcc, 0xc8, 0xa7, 0xe4, 0x58, 0x7d, 0x86, 0x04, 0x44, 0x4c, 0x3f, 0x5e,
+	0x49, 0x46, 0x70, 0x87, 0x29, 0x64, 0x29, 0x28, 0x57, 0xaf, 0x02, 0xd7, 0x0e, 0xd5, 0xab, 0xc0,
+	0xb5, 0x39, 0xb3, 0x0c, 0xba, 0xbb, 0xfc, 0xa0, 0x5d, 0x72, 0x64, 0x65, 0x0f, 0x6d, 0x54, 0x64,
+	0xfc, 0x64, 0xc1, 0xfa, 0xf7, 0x60, 0x2a, 0x93, 0x51, 0x1b, 0x73, 0xec, 0x4a, 0xcf, 0xb0, 0xeb,
+	0x91, 0xd2, 0x62, 0xef, 0x0a, 0x5c, 0xe8, 0x51, 0xc3, 0x0f, 0x35, 0xa5, 0xf3, 0x48, 0x3e, 0x85,
+	0x5f, 0xca, 0xbc, 0xfc, 0x0e, 0x55, 0xa1, 0x78, 0x35, 0x43, 0xdd, 0xe9, 0x7f, 0x50, 0x85, 0x5a,
+	0x94, 0xc4, 0x3b, 0xe0, 0x0a, 0xd4, 0x60, 0xac, 0x6b, 0x05, 0x42, 0x85, 0x93, 0x9d, 0x87, 0xb7,
+	0x3c, 0x4c, 0xbe, 0x1b, 0x90, 0x47, 0x5c, 0x04, 0x72, 0x3e, 0x79, 0xca, 0x4a, 0x18, 0x3d, 0x52,
+	0xf8, 0xe8, 0x21, 0x4c, 0x77, 0x03, 0xb2, 0xc3, 0x93, 0x74, 0x9f, 0x52, 0x9f, 0xed, 0x1f, 0xf3,
+	0x4e, 0xfa, 0xdb, 0x3f, 0xf2, 0x44, 0xe8, 0x2e, 0x8c, 0x30, 0x7a, 0x40, 0xdc, 0xd2, 0xeb, 0x55,
+	0xa2, 0xeb, 0xff, 0x0f, 0x26, 0x92, 0x79, 0x2f, 0x5c, 0xbb, 0xee, 0x70, 0x55, 0x5c, 0xbc, 0xad,
+	0xfc, 0xbe, 0x31, 0x20, 0x32, 0x67, 0x54, 0x13, 0xe6, 0x0c, 0xce, 0xf1, 0x45, 0x0f, 0x89, 0x48,
+	0xee, 0x04, 0x44, 0xff, 0xdd, 0x31, 0x98, 0x3c, 0x0f, 0x65, 0x20, 0x67, 0x44, 0xa8, 0xf6, 0x73,
+	0x96, 0xa6, 0xa2, 0x09, 0xee, 0xf3, 0x61, 0x3a, 0x7b, 0x2d, 0xbb, 0xed, 0x96, 0xaa, 0x20, 0x93,
+	0xc0, 0xce, 0x26, 0x61, 0x8f, 0xe4, 0x93, 0xb0, 0x97, 0x61, 0xdc, 0x72, 0x03, 0xbe, 0xb5, 0xe4,
+	0x76, 0x49, 0x19, 0x09, 0xd3, 0x6f, 0xbf, 0xb8, 0xaa, 0x10, 0x55, 0x35, 0xdd, 0x90, 0x4e, 0x14,
+	0xcf, 0x11, 0x66, 0x17, 0x1e, 0x1d, 0xae, 0x92, 0x5d, 0xc6, 0x4a, 0x14, 0xcf, 0xc9, 0xd0, 0xa0,
+	0x2f, 0xe0, 0xa2, 0xfc, 0x64, 0xb1, 0xbf, 0x62, 0xf9, 0x58, 0x95, 0x5b, 0x29, 0x51, 0xd2, 0xa5,
+	0x37, 0x31, 0xfa, 0x04, 0x90, 0x69, 0x33, 0x6c, 0x11, 0xe7, 0x21, 0xc1, 0x0e, 0xdb, 0x17, 0x75,
+	0x03, 0x4a, 0x08, 0xb1, 0x05, 0x54, 0xe7, 0x18, 0xa5, 0x36, 0x48, 0x8e, 0x67, 0xde, 0xdb, 0x31,
+	0x71, 0xa6, 0x9a, 0xe9, 0x53, 0xdc, 0xab, 0xea, 0x50, 0x6c, 0xf1, 0xa9, 0xdc, 0x61, 0x4e, 0x28,
+	0xd2, 0x66, 0xc0, 0xe7, 0x5c, 0x06, 0x9a, 0x57, 0x51, 0x4e, 0xad, 0xa6, 0x53, 0xd5, 0xee, 0xfa,
+	0xa3, 0x0a, 0x34, 0xce, 0x5f, 0xa5, 0xd6, 0x61, 0x22, 0x4c, 0xcd, 0xda, 0x8e, 0x55, 0xd7, 0x14,
+	0x2c, 0x62, 0x23, 0x43, 0x69, 0xab, 0x68, 0xb6, 0x50, 0xa2, 0xfe, 0xe3, 0x1a, 0xcc, 0x15, 0x56,
+	0xf9, 0x18, 0x90, 0x83, 0x9c, 0xb8, 0x33, 0xaa, 0x67, 0xd9, 0x19, 0xe5, 0x22, 0x98, 0x06, 0x5f,
+	0xe3, 0x5f, 0xc2, 0x8c, 0x4b, 0x0e, 0x89, 0xfa, 0x0c, 0x03, 0x56, 0x23, 0x36, 0x8a, 0xfa, 0x10,
+	0x69, 0x69, 0x0e, 0x2f, 0xc0, 0x96, 0xe9, 0x7b, 0xe2, 0xb4, 0x69, 0x69, 0x05, 0x9d, 0xf4, 0xb5,
+	0xed, 0x35, 0x9e, 0x87, 0x6d, 0x6f, 0xf2, 0xeb, 0x28, 0x6e, 0x38, 0xd5, 0x33, 0xba, 0x75, 0xc6,
+	0x27, 0x4f, 0x7d, 0x9b, 0x91, 0x25, 0xcf, 0x7b, 0xb8, 0xb3, 0xb3, 0xbd, 0xed, 0xd3, 0xdd, 0x30,
+	0x1a, 0xf5, 0xc4, 0x5a, 0x2d, 0x05, 0x64, 0x99, 0x53, 0x6d, 0xfa, 0xb4, 0xa7, 0x9a, 0x2d, 0x66,
+	0x4b, 0xbc, 0x88, 0xda, 0x78, 0x49, 0x10, 0x32, 0x60, 0x46, 0xde, 0x92, 0x14, 0xab, 0x2c, 0x5b,
+	0xdb, 0xa8, 0x88, 0x38, 0x2d, 0x0c, 0xce, 0x96, 0x56, 0x00, 0x1f, 0xc2, 0x24, 0xdd, 0x4d, 0xad,
+	0xcf, 0xb2, 0xa1, 0x12, 0x19, 0xba, 0xf3, 0x8e, 0xd3, 0xfc, 0xad, 0x0a, 0x5c, 0xe8, 0x91, 0xfd,
+	0x32, 0x20, 0x97, 0xe2, 0xe5, 0x8b, 0xba, 0xcc, 0xeb, 0x32, 0x55, 0x1d, 0xab, 0x3f, 0x63, 0x4a,
+	0xe1, 0xeb, 0xbf, 0x59, 0x85, 0xab, 0x27, 0x26, 0xd4, 0x0c, 0x38, 0xae, 0xb7, 0x45, 0x9e, 0xdb,
+	0xbe, 0x1a, 0xcf, 0xb5, 0xc2, 0xec, 0x9d, 0xa5, 0x2e, 0x8b, 0x4b, 0x17, 0x76, 0xd9, 0x3e, 0x7a,
+	0x2f, 0x52, 0xdc, 0x0b, 0x72, 0x86, 0x22, 0xb2, 0xc2, 0xd2, 0x37, 0x6b, 0x30, 0xa1, 0x5c, 0x25,
+	0x1f, 0xfb, 0xd8, 0xdb, 0xd7, 0x86, 0x4f, 0xe8, 0x60, 0x25, 0x81, 0x68, 0xa4, 0xc8, 0xf4, 0x3f,
+	0xa9, 0xc0, 0x5c, 0xe1, 0x08, 0xb9, 0x3d, 0x0e, 0x7b, 0xde, 0x8a, 0x4f, 0x2c, 0xe2, 0x32, 0x1b,
+	0x3b, 0x41, 0x89, 0xaf, 0x91, 0xa1, 0xe0, 0x7a, 0x07, 0xf6, 0x6c, 0xae, 0x84, 0x29, 0xbd, 0x43,
+	0xde, 0x71, 0x4b, 0x52, 0x98, 0xf4, 0x6d, 0x9a, 0x91, 0x40, 0x2d, 0x4f, 0x87, 0x82, 0x16, 0xfd,
+	0xff, 0xc3, 0x85, 0xc4, 0x20, 0x93, 0xdf, 0x63, 0xc0, 0xd9, 0x7a, 0x13, 0xa6, 0x03, 0x1e, 0xcc,
+	0xc7, 0x9d, 0x78, 0xbb, 0x58, 0xd6, 0x2d, 0x54, 0x87, 0x71, 0xbe, 0x41, 0xdf, 0x82, 0x0b, 0x3d,
+	0xbe, 0xe6, 0x80, 0x96, 0xfb, 0xbf, 0xae, 0xc0, 0x44, 0xea, 0x2d, 0xde, 0x85, 0x31, 0x0b, 0x33,
+	0x6c, 0xd1, 0x76, 0xbe, 0x96, 0xa7, 0x44, 0x5c, 0x95, 0xcd, 0xa1, 0xb7, 0x4a, 0x61, 0xa3, 0x6f,
+	0x41, 0xcd, 0xb1, 0xdb, 0xfb, 0x2c, 0x60, 0xc4, 0xcb, 0xaf, 0x3d, 0x49, 0xba, 0xc1, 0x11, 0x5a,
+	0x8c, 0x78, 0x8a, 0x38, 0xa6, 0x40, 0x77, 0x60, 0xf4, 0x87, 0xb6, 0x77, 0x60, 0x87, 0x85, 0x28,
+	0xaf, 0x64, 0x69, 0xbf, 0x12, 0xad, 0xe1, 0xda, 0x93, 0xb8, 0xfa, 0x4d, 0x98, 0x29, 0x18, 0x14,
+	0xd7, 0x04, 0xb1, 0x2a, 0xd0, 0x23, 0x45, 0xac, 0xf0, 0x56, 0xff, 0xb3, 0x0a, 0xcc, 0x15, 0x8e,
+	0xa5, 0x37, 0x0d, 0x67, 0xc0, 0xd2, 0xfa, 0xbd, 0x23, 0x34, 0x37, 0x15, 0x96, 0x9d, 0x00, 0x89,
+	0x9f, 0x4f, 0xf0, 0x3e, 0x93, 0xab, 0x27, 0x01, 0xe1, 0x91, 0x66, 0xc2, 0x2b, 0x47, 0x4a, 0x28,
+	0x34, 0x0a, 0x53, 0x5f, 0x04, 0x94, 0x7f, 0xf1, 0x13, 0xde, 0xec, 0x27, 0xa3, 0xd0, 0x50, 0x15,
+	0x10, 0xcf, 0xb4, 0x20, 0xef, 0xc5, 0xae, 0xce, 0x5c, 0xb6, 0x9c, 0xea, 0xbf, 0x87, 0xb3, 0xf3,
+	0x1d, 0x18, 0xfd, 0x3e, 0x26, 0xed, 0x88, 0x87, 0x5c, 0xcd, 0x11, 0x7e, 0x22, 0x9a, 0xc3, 0x39,
+	0x94, 0xc8, 0xe7, 0x18, 0x3f, 0x7e, 0x09, 0xc6, 0x3d, 0x9f, 0x1e, 0xda, 0x16, 0xf1, 0x95, 0xf2,
+	0x17, 0xdd, 0xa3, 0x5b, 0xb1, 0x27, 0x76, 0x34, 0x5b, 0xcf, 0xbb, 0x87, 0xff, 0xf5, 0x9d, 0x68,
+	0x49, 0x8e, 0xf5, 0x78, 0x9f, 0xa2, 0x35, 0xc9, 0xab, 0x2e, 0x52, 0x8f, 0xb8, 0x26, 0x71, 0x83,
+	0x6e, 0x58, 0x9b, 0xf3, 0xa5, 0x1c, 0xe9, 0x56, 0x84, 0xa2, 0xc8, 0x13, 0x44, 0x25, 0x1c, 0xd2,
+	0xbf, 0x38, 0x02, 0x5b, 0x46, 0x0e, 0x98, 0x3a, 0xa3, 0x1c, 0xf0, 0xf7, 0x15, 0xb8, 0xd0, 0x63,
+	0x0a, 0xc2, 0xb0, 0x86, 0x4a, 0x2e, 0xac, 0xa1, 0x1a, 0x87, 0x35, 0x3c, 0xe4, 0xff, 0x84, 0xf2,
+	0xa8, 0x9f, 0xc8, 0x08, 0xbd, 0x71, 0xc2, 0xe4, 0xae, 0x85, 0xb8, 0x21, 0xc7, 0x8b, 0x88, 0xd3,
+	0x25, 0x56, 0x46, 0x06, 0x2a, 0xb1, 0xa2, 0xef, 0xc1, 0x42, 0xbf, 0x47, 0x72, 0x6d, 0x31, 0x19,
+	0x1b, 0x55, 0x5a, 0x5b, 0x4c, 0x10, 0xf1, 0x90, 0xaf, 0xd9, 0xa2, 0xcd, 0x3f, 0x20, 0x8f, 0xc9,
+	0x28, 0xb0, 0xd5, 0x41, 0x14, 0xd8, 0xe8, 0x27, 0x7b, 0x43, 0xc9, 0x9f, 0xec, 0x0d, 0xf2, 0x83,
+	0xbc, 0x3f, 0xae, 0xc2, 0x4c, 0x01, 0x83, 0x2a, 0xb5, 0x1c, 0xde, 0x8f, 0xec, 0x99, 0x43, 0x59,
+	0x43, 0x76, 0xaa, 0xcb, 0x4d, 0x81, 0x14, 0x72, 0x0a, 0x49, 0x22, 0x6c, 0xb8, 0x1e, 0x76, 0x5b,
+	0x8c, 0xfa, 0xb8, 0x4d, 0xf8, 0x10, 0x95, 0xf9, 0x37, 0x0b, 0xe6, 0x9f, 0xd9, 0x23, 0x7e, 0x60,
+	0x07, 0xac, 0x4c, 0x82, 0xad, 0x42, 0xe5, 0x55, 0x18, 0x02, 0xd9, 0x49, 0x5c, 0xf6, 0x51, 0xba,
+	0x56, 0x73, 0x70, 0xe1, 0xcd, 0x15, 0x27, 0x9a, 0x08, 0xbf, 0x54, 0x7f, 0xc5, 0x8b, 0x21, 0xfa,
+	0x7d, 0xb8, 0xd8, 0xf3, 0x85, 0xd0, 0x55, 0x80, 0x0e, 0x3e, 0x7a, 0x22, 0x04, 0xc2, 0x40, 0xfd,
+	0x59, 0xb0, 0xd6, 0xc1, 0x47, 0x3b, 0x02, 0xa0, 0xff, 0x65, 0xfc, 0x81, 0x53, 0x87, 0x59, 0x99,
+	0x0f, 0xfc, 0x26, 0xaf, 0x5d, 0x49, 0x77, 0x49, 0x8b, 0x61, 0x9f, 0x75, 0x3d, 0xe1, 0x3a, 0x53,
+	0x79, 0x1c, 0xf9, 0x06, 0x6e, 0x16, 0xfd, 0x41, 0x97, 0xf8, 0xc7, 0x51, 0x08, 0x56, 0xc3, 0x88,
+	0x01, 0x03, 0x1a, 0xb8, 0xb9, 0xa9, 0xe4, 0xfb, 0xf8, 0x10, 0x6f, 0x79, 0x2c, 0x78, 0x48, 0xb0,
+	0x27, 0xeb, 0xe1, 0x1b, 0x29, 0x18, 0x3f, 0x7a, 0x3a, 0xf8, 0xa8, 0xe5, 0x61, 0x95, 0xaf, 0xdc,
+	0x30, 0xa2, 0x7b, 0xf4, 0x0e, 0x0c, 0xf3, 0x63, 0xaa, 0xe7, 0x51, 0x20, 0xbf, 0x09, 0x0f, 0x44,
+	0x08, 0x45, 0x72, 0x8e, 0xae, 0x7f, 0x13, 0x2e, 0xf4, 0x40, 0xe0, 0x46, 0x18, 0xd3, 0xeb, 0x86,
+	0x5f, 0x5a, 0x5c, 0xeb, 0xbf, 0x53, 0x81, 0x99, 0x4f, 0x6d, 0xec, 0xd8, 0xe7, 0x62, 0xc4, 0xbd,
+	0x0c, 0x35, 0x2e, 0xbd, 0x3c, 0xd9, 0xb3, 0x9d, 0xd0, 0x24, 0x35, 0xce, 0x01, 0x2a, 0xdc, 0xa0,
+	0xa9, 0x7c, 0x18, 0x4f, 0x0e, 0xc8, 0xb1, 0xc4, 0x19, 0x52, 0xff, 0x1e, 0x8c, 0x7c, 0x1b, 0x1c,
+	0x93, 0xff, 0xc6, 0x62, 0x56, 0x0c, 0x6a, 0x15, 0x07, 0xfb, 0xbb, 0x14, 0xfb, 0x61, 0x1d, 0xc1,
+	0xb4, 0x35, 0xba, 0x92, 0xb5, 0x46, 0xf3, 0x13, 0xb0, 0x1b, 0x10, 0x9f, 0x9b, 0x9c, 0x62, 0xa1,
+	0x3d, 0x09, 0xe2, 0xe1, 0x05, 0x1e, 0x0e, 0x02, 0x6f, 0xdf, 0xc7, 0x41, 0xc2, 0xbb, 0x92, 0x06,
+	0x72, 0x25, 0xed, 0xd0, 0x26, 0x4f, 0xb7, 0x5c, 0xe7, 0x58, 0x2c, 0xec, 0xfe, 0xf2, 0x57, 0x0a,
+	0x9f, 0x8f, 0xb3, 0xed, 0xe3, 0x3d, 0xec, 0xe2, 0xcf, 0x8c, 0x8d, 0xf0, 0x57, 0x93, 0x31, 0x84,
+	0x2f, 0x38, 0x29, 0xc6, 0xf0, 0x66, 0x15, 0xe5, 0x16, 0x01, 0xf4, 0x1f, 0x55, 0x00, 0x89, 0xd7,
+	0x3f, 0x0f, 0xa6, 0xb9, 0x90, 0x67, 0x9a, 0xb5, 0x34, 0x4b, 0x6c, 0x4a, 0xe6, 0x17, 0xfe, 0x15,
+	0xd1, 0x49, 0x30, 0xc9, 0xe1, 0x04, 0x93, 0xd4, 0xff, 0x7c, 0x0c, 0xea, 0x62, 0x58, 0x67, 0x4d,
+	0xd2, 0x92, 0x36, 0xed, 0x55, 0xd2, 0xa1, 0xd2, 0x39, 0x51, 0x26, 0x49, 0x2b, 0x4b, 0x13, 0x72,
+	0x81, 0xa1, 0x1c, 0x17, 0x18, 0x8e, 0xb9, 0x40, 0xd9, 0x04, 0xac, 0x1e, 0x75, 0x6b, 0x47, 0x7b,
+	0xd7, 0xad, 0x7d, 0x2f, 0x11, 0x64, 0x97, 0x13, 0xf3, 0x0a, 0xf6, 0x53, 0x22, 0xbe, 0xee, 0x03,
+	0xa8, 0x59, 0xe1, 0xb2, 0xd6, 0xc6, 0xb3, 0xb2, 0x72, 0xd1, 0xb2, 0x37, 0x62, 0x82, 0x64, 0x48,
+	0x61, 0x2e, 0x77, 0x3c, 0xbf, 0x66, 0x62, 0x29, 0x3b, 0x23, 0x1b, 0x4e, 0xe5, 0x65, 0xc3, 0x5f,
+	0xfe, 0x41, 0xe9, 0x7f, 0xd9, 0x5f, 0x24, 0xff, 0x71, 0x0c, 0x46, 0xc5, 0xee, 0xe1, 0x7f, 0x82,
+	0xac, 0x73, 0x36, 0xdc, 0x91, 0x7f, 0x55, 0xcd, 0xd7, 0x41, 0xc9, 0xfd, 0x72, 0xd5, 0x48, 0xe2,
+	0xf3, 0xfa, 0xc9, 0xa6, 0x6b, 0x6b, 0xd5, 0xec, 0xd9, 0x17, 0xfd, 0xcb, 0xd7, 0xe0, 0xed, 0xe8,
+	0x7d, 0x98, 0x10, 0x15, 0x69, 0x4d, 0xea, 0x13, 0x2b, 0xfa, 0x57, 0x71, 0x42, 0x63, 0x4a, 0xfd,
+	0xd5, 0xd1, 0x48, 0x21, 0xf3, 0xa2, 0xb7, 0x6d, 0xf1, 0x23, 0x1d, 0xc5, 0x6c, 0xe7, 0x8b, 0x7f,
+	0xb0, 0x63, 0x28, 0x2c, 0x74, 0x07, 0xc6, 0x55, 0x41, 0xa9, 0xf0, 0x50, 0xd6, 0x72, 0x55, 0x32,
+	0xa3, 0x7a, 0x1b, 0x21, 0xa6, 0x78, 0x8a, 0x28, 0x29, 0xab, 0x8d, 0xe6, 0x9e, 0x92, 0xf8, 0x7b,
+	0x87, 0xa1, 0xb0, 0xd0, 0x7d, 0x18, 0x53, 0x6c, 0xbb, 0x74, 0xf5, 0x87, 0x90, 0x80, 0x57, 0x58,
+	0xec, 0x70, 0xeb, 0x9c, 0xda, 0xe4, 0x73, 0x99, 0xca, 0x20, 0xea, 0x49, 0x12, 0x87, 0xd7, 0xb6,
+	0xe6, 0x7b, 0x08, 0xb7, 0x89, 0xcb, 0xa2, 0xea, 0xa9, 0x11, 0x41, 0x26, 0x79, 0xdb, 0x88, 0x71,
+	0xf9, 0x53, 0x3c, 0xdb, 0xa1, 0xe1, 0x3f, 0x13, 0xe6, 0x0a, 0x93, 0x70, 0x0c, 0x89, 0xc3, 0x9f,
+	0x12, 0x57, 0xb5, 0xb9, 0x90, 0x7d, 0xca, 0x09, 0x05, 0x6d, 0xee, 0xa7, 0x12, 0x2e, 0xc2, 0x7f,
+	0x2b, 0x14, 0x04, 0x53, 0x16, 0x64, 0x59, 0xdc, 0xc9, 0x05, 0x24, 0x6b, 0xbd, 0xbc, 0xa7, 0x09,
+	0x36, 0xf9, 0x39, 0xcc, 0x07, 0x69, 0xe7, 0x90, 0xaa, 0xb6, 0xae, 0x35, 0xb2, 0x56, 0xa2, 0x42,
+	0x27, 0x92, 0xd1, 0x83, 0x9c, 0xab, 0xf4, 0x4c, 0xfd, 0x23, 0x62, 0x32, 0xbb, 0x40, 0x53, 0x96,
+	0x10, 0x23, 0xc4, 0xe3, 0xdf, 0xf8, 0x80, 0xf3, 0x56, 0x6d, 0x2a, 0xfb, 0x8d, 0x13, 0xe7, 0xa1,
+	0x21, 0x71, 0xb8, 0xad, 0xe5, 0x90, 0x4b, 0xd2, 0xd4, 0x55, 0x71, 0x68, 0xe1, 0xad, 0x38, 0xfa,
+	0xd4, 0x1f, 0x96, 0x23, 0x79, 0x72, 0xba, 0xc4, 0xd1, 0x97, 0xa1, 0xd1, 0x35, 0x98, 0x2f, 0x5e,
+	0x7b, 0xfa, 0x35, 0xb8, 0x7a, 0x22, 0x93, 0xd0, 0xe7, 0x61, 0xb6, 0x28, 0x55, 0x4f, 0xff, 0xbf,
+	0xd0, 0x48, 0xfd, 0x9e, 0xec, 0x9c, 0x2b, 0xdf, 0x99, 0x30, 0x53, 0x50, 0x36, 0x5a, 0xfc, 0x71,
+	0x3b, 0x0c, 0xd6, 0x0c, 0xc3, 0x11, 0x22, 0x80, 0x14, 0x8e, 0x25, 0x7e, 0x28, 0x4b, 0x86, 0xf7,
+	0x5c, 0x94, 0x4d, 0xc8, 0x8f, 0xe2, 0xfa, 0xc6, 0x4d, 0x19, 0x77, 0x83, 0x26, 0x60, 0x5c, 0xfd,
+	0xb7, 0xc4, 0x6a, 0xbe, 0xc0, 0xef, 0x1c, 0xda, 0x7e, 0x42, 0x5d, 0xe7, 0xb8, 0x59, 0x41, 0x75,
+	0xfe, 0x5a, 0x7b, 0xd4, 0x37, 0x49, 0xb3, 0x7a, 0xe3, 0xbd, 0x1e, 0x69, 0x64, 0x1c, 0x6b, 0x75,
+	0xed, 0xc1, 0xd2, 0x67, 0x1b, 0x3b, 0xcd, 0x17, 0x10, 0xc0, 0x68, 0x6b, 0xc7, 0x58, 0x5f, 0xd9,
+	0x69, 0x56, 0xd0, 0x18, 0x0c, 0x6d, 0x3d, 0x78, 0xd0, 0xac, 0xde, 0x78, 0xbd, 0x20, 0x20, 0x16,
+	0x8d, 0xc3, 0xf0, 0x27, 0xad, 0xad, 0x47, 0xcd, 0x17, 0xf8, 0xd5, 0xce, 0xda, 0x17, 0x3b, 0xcd,
+	0xca, 0x8d, 0xb7, 0x42, 0x0b, 0x39, 0xef, 0x47, 0x9a, 0x7a, 0x9a, 0x2f, 0xf0, 0x8c, 0xf8, 0xc8,
+	0x86, 0x29, 0x47, 0xa5, 0xec, 0xa1, 0xcd, 0xea, 0x32, 0x7c, 0x15, 0xfd, 0xe5, 0x7e, 0x77, 0x54,
+	0x7c, 0xec, 0xb7, 0xff, 0x7b, 0x00, 0xe3, 0x8f, 0xa8, 0xd4, 0x24, 0x7f, 0x00, 0x00,
 }
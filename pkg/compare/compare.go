@@ -24,6 +24,7 @@ import (
 	"strings"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/pmezard/go-difflib/difflib"
 	"sigs.k8s.io/yaml"
 
 	"istio.io/operator/pkg/object"
@@ -348,6 +349,78 @@ func buildResourceRegexp(s string) (*regexp.Regexp, error) {
 	return regexp.Compile(strings.Join(hash, ":"))
 }
 
+// ManifestUnifiedDiff returns a unified diff of the YAML of each object that differs between the manifests a and b,
+// including the objects that are only in one of them, in the order of the object hashes. The files of the diff are
+// named after the object hashes, prefixed with aName and bName.
+func ManifestUnifiedDiff(a, b, aName, bName string) (string, error) {
+	ao, err := object.ParseK8sObjectsFromYAMLManifest(a)
+	if err != nil {
+		return "", err
+	}
+	bo, err := object.ParseK8sObjectsFromYAMLManifest(b)
+	if err != nil {
+		return "", err
+	}
+	aom, bom := ao.ToMap(), bo.ToMap()
+	var keys []string
+	for k := range aom {
+		keys = append(keys, k)
+	}
+	for k := range bom {
+		if aom[k] == nil {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	for _, k := range keys {
+		al, err := objectLines(aom[k])
+		if err != nil {
+			return "", err
+		}
+		bl, err := objectLines(bom[k])
+		if err != nil {
+			return "", err
+		}
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        al,
+			B:        bl,
+			FromFile: aName + "/" + k,
+			ToFile:   bName + "/" + k,
+			Context:  3,
+		})
+		if err != nil {
+			return "", err
+		}
+		writeStringSafe(&sb, diff)
+	}
+	return sb.String(), nil
+}
+
+// objectLines returns the lines of the YAML of o, or no lines if o is nil. The YAML is rendered from the fields of o,
+// rather than kept as parsed, so that its formatting does not show in diffs.
+func objectLines(o *object.K8sObject) ([]string, error) {
+	if o == nil {
+		return nil, nil
+	}
+	j, err := o.JSON()
+	if err != nil {
+		return nil, err
+	}
+	y, err := yaml.JSONToYAML(j)
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.SplitAfter(string(y), "\n")
+	if last := len(lines) - 1; lines[last] == "" {
+		lines = lines[:last]
+	} else {
+		lines[last] += "\n"
+	}
+	return lines, nil
+}
+
 // manifestDiff an internal function to compare the manifests difference specified in the input.
 func manifestDiff(aom, bom map[string]*object.K8sObject, im map[string]string, verbose bool) (string, error) {
 	var sb strings.Builder
@@ -1487,3 +1487,71 @@ Object Deployment:istio-system:istio-citadel is missing in B:
 		})
 	}
 }
+
+func TestManifestUnifiedDiff(t *testing.T) {
+	deployment := `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: istio-pilot
+  namespace: istio-system
+spec:
+  replicas: 1
+`
+	service := `apiVersion: v1
+kind: Service
+metadata:
+  name: istio-pilot
+  namespace: istio-system
+`
+	tests := []struct {
+		desc string
+		a    string
+		b    string
+		want string
+	}{
+		{
+			desc: "identical",
+			a:    deployment + object.YAMLSeparator + service,
+			b:    service + object.YAMLSeparator + deployment,
+		},
+		{
+			desc: "changed",
+			a:    deployment + object.YAMLSeparator + service,
+			b:    strings.Replace(deployment, "replicas: 1", "replicas: 2", 1) + object.YAMLSeparator + service,
+			want: `--- a/Deployment:istio-system:istio-pilot
++++ b/Deployment:istio-system:istio-pilot
+@@ -4,4 +4,4 @@
+   name: istio-pilot
+   namespace: istio-system
+ spec:
+-  replicas: 1
++  replicas: 2
+`,
+		},
+		{
+			desc: "added",
+			a:    deployment,
+			b:    deployment + object.YAMLSeparator + service,
+			want: `--- a/Service:istio-system:istio-pilot
++++ b/Service:istio-system:istio-pilot
+@@ -0,0 +1,5 @@
++apiVersion: v1
++kind: Service
++metadata:
++  name: istio-pilot
++  namespace: istio-system
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := ManifestUnifiedDiff(tt.a, tt.b, "a", "b")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
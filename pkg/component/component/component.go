@@ -20,16 +20,24 @@ See ../README.md for an architecture overview.
 package component
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
 
 	"github.com/ghodss/yaml"
 
 	"istio.io/api/operator/v1alpha1"
+	valuesv1alpha1 "istio.io/operator/pkg/apis/istio/v1alpha1"
 	"istio.io/operator/pkg/helm"
 	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/object"
 	"istio.io/operator/pkg/patch"
 	"istio.io/operator/pkg/tpath"
 	"istio.io/operator/pkg/translate"
+	"istio.io/operator/pkg/util"
 	"istio.io/pkg/log"
 )
 
@@ -52,6 +60,10 @@ type Options struct {
 	Translator *translate.Translator
 	// Namespace is the namespace for this component.
 	Namespace string
+	// ReadLocalFiles allows the extra manifests of the component to be read from files. It is only set by the mesh
+	// CLI, which reads the files of its user. The controller renders CRs that anyone who can create them controls, so
+	// it must not read files from its own file system.
+	ReadLocalFiles bool
 }
 
 // IstioComponent defines the interface for a component.
@@ -66,6 +78,23 @@ type IstioComponent interface {
 	Run() error
 	// RenderManifest returns a string with the rendered manifest for the component.
 	RenderManifest() (string, error)
+	// Warnings returns the overlays, overlay paths and K8S settings that matched nothing in the manifest last rendered
+	// by RenderManifest.
+	Warnings() util.Errors
+	// RenderSteps renders the manifest for the component like RenderManifest, and returns the manifest after each
+	// step of the rendering.
+	RenderSteps() ([]*RenderStep, error)
+}
+
+// RenderStep is the manifest of a component after a step of rendering it: the chart, the K8S settings, one of the
+// overlays, or the extra manifests.
+type RenderStep struct {
+	// Name describes the step.
+	Name string
+	// Manifest is the manifest of the component after the step.
+	Manifest string
+	// Warnings are the overlays, overlay paths and K8S settings of the step that matched nothing.
+	Warnings util.Errors
 }
 
 // CommonComponentFields is a struct common to all components.
@@ -80,6 +109,29 @@ type CommonComponentFields struct {
 	index    int
 	started  bool
 	renderer helm.TemplateRenderer
+	// warnings are the overlays, overlay paths and K8S settings that matched nothing in the last rendered manifest.
+	warnings util.Errors
+}
+
+// Warnings implements the IstioComponent interface for all components.
+func (c *CommonComponentFields) Warnings() util.Errors {
+	return c.warnings
+}
+
+// RenderSteps implements the IstioComponent interface for all components. The overlays are applied one at a time,
+// each in its own step. It is an error for the component not to be enabled.
+func (c *CommonComponentFields) RenderSteps() ([]*RenderStep, error) {
+	if !c.started {
+		return nil, fmt.Errorf("component %s not started in RenderSteps", c.componentName)
+	}
+	var steps []*RenderStep
+	if _, err := renderManifest(c, &steps); err != nil {
+		return nil, err
+	}
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("component %s is not enabled", c.componentName)
+	}
+	return steps, nil
 }
 
 // NewComponent creates a new IstioComponent with the given componentName and options.
@@ -137,7 +189,7 @@ func (c *CRDComponent) RenderManifest() (string, error) {
 	if !c.started {
 		return "", fmt.Errorf("component %s not started in RenderManifest", c.ComponentName())
 	}
-	return renderManifest(c.CommonComponentFields)
+	return renderManifest(c.CommonComponentFields, nil)
 }
 
 // ComponentName implements the IstioComponent interface.
@@ -182,7 +234,7 @@ func (c *PilotComponent) RenderManifest() (string, error) {
 	if !c.started {
 		return "", fmt.Errorf("component %s not started in RenderManifest", c.ComponentName())
 	}
-	return renderManifest(c.CommonComponentFields)
+	return renderManifest(c.CommonComponentFields, nil)
 }
 
 // ComponentName implements the IstioComponent interface.
@@ -226,7 +278,7 @@ func (c *CitadelComponent) RenderManifest() (string, error) {
 	if !c.started {
 		return "", fmt.Errorf("component %s not started in RenderManifest", c.ComponentName())
 	}
-	return renderManifest(c.CommonComponentFields)
+	return renderManifest(c.CommonComponentFields, nil)
 }
 
 // ComponentName implements the IstioComponent interface.
@@ -270,7 +322,7 @@ func (c *CertManagerComponent) RenderManifest() (string, error) {
 	if !c.started {
 		return "", fmt.Errorf("component %s not started in RenderManifest", c.ComponentName())
 	}
-	return renderManifest(c.CommonComponentFields)
+	return renderManifest(c.CommonComponentFields, nil)
 }
 
 // ComponentName implements the IstioComponent interface.
@@ -314,7 +366,7 @@ func (c *NodeAgentComponent) RenderManifest() (string, error) {
 	if !c.started {
 		return "", fmt.Errorf("component %s not started in RenderManifest", c.ComponentName())
 	}
-	return renderManifest(c.CommonComponentFields)
+	return renderManifest(c.CommonComponentFields, nil)
 }
 
 // ComponentName implements the IstioComponent interface.
@@ -358,7 +410,7 @@ func (c *PolicyComponent) RenderManifest() (string, error) {
 	if !c.started {
 		return "", fmt.Errorf("component %s not started in RenderManifest", c.ComponentName())
 	}
-	return renderManifest(c.CommonComponentFields)
+	return renderManifest(c.CommonComponentFields, nil)
 }
 
 // ComponentName implements the IstioComponent interface.
@@ -402,7 +454,7 @@ func (c *TelemetryComponent) RenderManifest() (string, error) {
 	if !c.started {
 		return "", fmt.Errorf("component %s not started in RenderManifest", c.ComponentName())
 	}
-	return renderManifest(c.CommonComponentFields)
+	return renderManifest(c.CommonComponentFields, nil)
 }
 
 // ComponentName implements the IstioComponent interface.
@@ -446,7 +498,7 @@ func (c *GalleyComponent) RenderManifest() (string, error) {
 	if !c.started {
 		return "", fmt.Errorf("component %s not started in RenderManifest", c.ComponentName())
 	}
-	return renderManifest(c.CommonComponentFields)
+	return renderManifest(c.CommonComponentFields, nil)
 }
 
 // ComponentName implements the IstioComponent interface.
@@ -490,7 +542,7 @@ func (c *SidecarInjectorComponent) RenderManifest() (string, error) {
 	if !c.started {
 		return "", fmt.Errorf("component %s not started in RenderManifest", c.ComponentName())
 	}
-	return renderManifest(c.CommonComponentFields)
+	return renderManifest(c.CommonComponentFields, nil)
 }
 
 // ComponentName implements the IstioComponent interface.
@@ -534,7 +586,7 @@ func (c *CNIComponent) RenderManifest() (string, error) {
 	if !c.started {
 		return "", fmt.Errorf("component %s not started in RenderManifest", c.ComponentName())
 	}
-	return renderManifest(c.CommonComponentFields)
+	return renderManifest(c.CommonComponentFields, nil)
 }
 
 // ComponentName implements the IstioComponent interface.
@@ -580,7 +632,7 @@ func (c *IngressComponent) RenderManifest() (string, error) {
 	if !c.started {
 		return "", fmt.Errorf("component %s not started in RenderManifest", c.ComponentName())
 	}
-	return renderManifest(c.CommonComponentFields)
+	return renderManifest(c.CommonComponentFields, nil)
 }
 
 // ComponentName implements the IstioComponent interface.
@@ -628,7 +680,7 @@ func (c *EgressComponent) RenderManifest() (string, error) {
 	if !c.started {
 		return "", fmt.Errorf("component %s not started in RenderManifest", c.ComponentName())
 	}
-	return renderManifest(c.CommonComponentFields)
+	return renderManifest(c.CommonComponentFields, nil)
 }
 
 // ComponentName implements the IstioComponent interface.
@@ -673,7 +725,7 @@ func (c *AddonComponent) RenderManifest() (string, error) {
 	if !c.started {
 		return "", fmt.Errorf("component %s not started in RenderManifest", c.ComponentName())
 	}
-	return renderManifest(c.CommonComponentFields)
+	return renderManifest(c.CommonComponentFields, nil)
 }
 
 // ComponentName implements the IstioComponent interface.
@@ -705,8 +757,10 @@ func runComponent(c *CommonComponentFields) error {
 	return nil
 }
 
-// renderManifest renders the manifest for the component defined by c and returns the resulting string.
-func renderManifest(c *CommonComponentFields) (string, error) {
+// renderManifest renders the manifest for the component defined by c and returns the resulting string. If steps is
+// not nil, the manifest after each step is appended to it, and the overlays are applied one at a time.
+func renderManifest(c *CommonComponentFields, steps *[]*RenderStep) (string, error) {
+	c.warnings = nil
 	if c.componentName.IsCoreComponent() {
 		e, err := c.Translator.IsComponentEnabled(c.componentName, c.InstallSpec)
 		if err != nil {
@@ -721,6 +775,11 @@ func renderManifest(c *CommonComponentFields) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if c.componentName.IsAddon() {
+		if mergedYAML, err = c.overlayAddonValues(mergedYAML); err != nil {
+			return "", err
+		}
+	}
 
 	log.Debugf("Merged values:\n%s\n", mergedYAML)
 
@@ -733,13 +792,17 @@ func renderManifest(c *CommonComponentFields) (string, error) {
 	if devDbg {
 		log.Infof("Initial manifest with merged values:\n%s\n", my)
 	}
+	addStep(steps, "chart", my, nil)
 	// Add the k8s resources from IstioOperatorSpec.
-	my, err = c.Translator.OverlayK8sSettings(my, c.InstallSpec, c.componentName, c.index)
+	my, warnings, err := c.Translator.OverlayK8sSettings(my, c.InstallSpec, c.componentName, c.index)
 	if err != nil {
 		log.Errorf("Error in OverlayK8sSettings: %s", err)
 		return "", err
 	}
+	// The K8S settings name the component in their path.
+	c.warnings = append(c.warnings, warnings...)
 	my = "# Resources for " + string(c.componentName) + " component\n\n" + my
+	addStep(steps, "k8s settings", my, warnings)
 	if devDbg {
 		log.Infof("Manifest after k8s API settings:\n%s\n", my)
 	}
@@ -754,32 +817,322 @@ func renderManifest(c *CommonComponentFields) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	if !found {
+	if found {
+		kyo, err := yaml.Marshal(overlays)
+		if err != nil {
+			return "", err
+		}
+		log.Infof("Applying kubernetes overlay: \n%s\n", kyo)
+		if my, err = c.patchOverlays(my, overlays, false, steps); err != nil {
+			return "", err
+		}
+		log.Infof("Manifest after resources and overlay: \n%s\n", my)
+	}
+	// Add the global k8s resource overlays from the values, which apply to all components.
+	globalOverlays, err := GlobalK8sOverlays(c.InstallSpec)
+	if err != nil {
+		return "", err
+	}
+	if len(globalOverlays) != 0 {
+		if my, err = c.patchOverlays(my, globalOverlays, true, steps); err != nil {
+			return "", err
+		}
+		log.Debugf("Manifest after global overlays: \n%s\n", my)
+	}
+	// Add the extra manifests of the component from the values, which the overlays do not patch.
+	extra, err := c.extraManifests()
+	if err != nil {
+		return "", err
+	}
+	if extra == "" {
 		log.Debugf("Manifest after resources: \n%s\n", my)
 		return my, nil
 	}
-	kyo, err := yaml.Marshal(overlays)
+	my += extra
+	addStep(steps, "extra manifests", my, nil)
+	log.Debugf("Manifest after extra manifests: \n%s\n", my)
+	return my, nil
+}
+
+// patchOverlays applies overlays to the manifest my of c, and returns the patched manifest. If global is set, the
+// overlays are the global ones, which apply to the manifests of all components. If steps is not nil, the overlays are
+// applied one at a time and the manifest after each is appended to steps.
+func (c *CommonComponentFields) patchOverlays(my string, overlays []*v1alpha1.K8SObjectOverlay, global bool,
+	steps *[]*RenderStep) (string, error) {
+	if steps == nil {
+		out, warnings, err := patch.PatchManifest(my, c.Namespace, overlays, global)
+		if err != nil {
+			return "", err
+		}
+		c.addWarnings(warnings)
+		return out, nil
+	}
+	kind := "overlay"
+	if global {
+		kind = "global overlay"
+	}
+	for i, o := range overlays {
+		out, warnings, err := patch.PatchManifest(my, c.Namespace, []*v1alpha1.K8SObjectOverlay{o}, global)
+		if err != nil {
+			return "", fmt.Errorf("%s %d: %s", kind, i, err)
+		}
+		c.addWarnings(warnings)
+		my = out
+		desc := fmt.Sprintf("%s %d (kind %s", kind, i, o.Kind)
+		if o.Name != "" {
+			desc += ", name " + o.Name
+		}
+		addStep(steps, desc+")", my, warnings)
+	}
+	return my, nil
+}
+
+// addStep appends the step named desc with the given manifest and warnings to steps, unless steps is nil.
+func addStep(steps *[]*RenderStep, desc, manifest string, warnings util.Errors) {
+	if steps == nil {
+		return
+	}
+	*steps = append(*steps, &RenderStep{Name: desc, Manifest: manifest, Warnings: warnings})
+}
+
+// addWarnings adds warnings about the overlays of c to its warnings, prefixed with the component name.
+func (c *CommonComponentFields) addWarnings(warnings util.Errors) {
+	for _, w := range warnings {
+		c.warnings = util.AppendErr(c.warnings, fmt.Errorf("component %s: %s", c.componentName, w))
+	}
+}
+
+// GlobalK8sOverlays returns the overlays in values.global.k8sOverlays of iop, which apply to the resources of all
+// components.
+func GlobalK8sOverlays(iop *v1alpha1.IstioOperatorSpec) ([]*v1alpha1.K8SObjectOverlay, error) {
+	v, found, err := tpath.GetFromTreePath(iop.Values, util.PathFromString("global.k8sOverlays"))
+	if err != nil || !found || v == nil {
+		return nil, err
+	}
+	y, err := yaml.Marshal(map[string]interface{}{"overlays": v})
+	if err != nil {
+		return nil, err
+	}
+	k8s := &v1alpha1.KubernetesResourcesSpec{}
+	if err := util.UnmarshalWithJSONPB(string(y), k8s); err != nil {
+		return nil, fmt.Errorf("bad values.global.k8sOverlays: %s", err)
+	}
+	return k8s.Overlays, nil
+}
+
+// ExtraManifestYAML returns the YAML of the resources of the extra manifest e, reading it from its file if it has one.
+func ExtraManifestYAML(e *valuesv1alpha1.ExtraManifestConfig) (string, error) {
+	if e.File == "" {
+		return e.Manifest, nil
+	}
+	b, err := ioutil.ReadFile(e.File)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// ExtraManifests returns the entries in values.global.extraManifests of iop, with their component set to Base if it
+// is empty. It is an error for an entry to have fields that are not in ExtraManifestConfig, or to set both or neither
+// of manifest and file.
+func ExtraManifests(iop *v1alpha1.IstioOperatorSpec) ([]*valuesv1alpha1.ExtraManifestConfig, error) {
+	v, found, err := tpath.GetFromTreePath(iop.Values, util.PathFromString("global.extraManifests"))
+	if err != nil || !found || v == nil {
+		return nil, err
+	}
+	entries, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("bad values.global.extraManifests: got %T, want a list", v)
+	}
+	var out []*valuesv1alpha1.ExtraManifestConfig
+	for i, entry := range entries {
+		e, err := ParseExtraManifest(entry)
+		if err != nil {
+			return nil, fmt.Errorf("bad values.global.extraManifests[%d]: %s", i, err)
+		}
+		if e.Component == "" {
+			e.Component = string(name.IstioBaseComponentName)
+		}
+		out = append(out, e)
+	}
+	return out, nil
+}
+
+// ParseExtraManifest returns the ExtraManifestConfig in the untyped values tree node. It is an error for node to have
+// fields that are not in ExtraManifestConfig, or to set both or neither of manifest and file.
+func ParseExtraManifest(node interface{}) (*valuesv1alpha1.ExtraManifestConfig, error) {
+	y, err := yaml.Marshal(node)
+	if err != nil {
+		return nil, err
+	}
+	e := &valuesv1alpha1.ExtraManifestConfig{}
+	if err := util.UnmarshalValuesWithJSONPB(string(y), e, false); err != nil {
+		return nil, err
+	}
+	if (e.Manifest == "") == (e.File == "") {
+		return nil, fmt.Errorf("exactly one of manifest and file must be set")
+	}
+	return e, nil
+}
+
+// extraManifests returns the YAML of the resources in the extra manifests of c, labeled with name.ExtraManifestLabel
+// so that they can be told apart from the rendered resources when they are pruned.
+func (c *CommonComponentFields) extraManifests() (string, error) {
+	ems, err := ExtraManifests(c.InstallSpec)
 	if err != nil {
 		return "", err
 	}
-	log.Infof("Applying kubernetes overlay: \n%s\n", kyo)
-	ret, err := patch.YAMLManifestPatch(my, c.Namespace, overlays)
+	var objs object.K8sObjects
+	for i, e := range ems {
+		if !strings.EqualFold(e.Component, c.instanceName()) {
+			continue
+		}
+		if e.File != "" && !c.ReadLocalFiles {
+			return "", fmt.Errorf("values.global.extraManifests[%d]: file %s cannot be read, files are only read "+
+				"by the mesh CLI, set the resources in manifest instead", i, e.File)
+		}
+		y, err := ExtraManifestYAML(e)
+		if err != nil {
+			return "", fmt.Errorf("values.global.extraManifests[%d]: %s", i, err)
+		}
+		eo, err := object.ParseK8sObjectsFromYAMLManifest(y)
+		if err != nil {
+			return "", fmt.Errorf("values.global.extraManifests[%d]: %s", i, err)
+		}
+		objs = append(objs, eo...)
+	}
+	if len(objs) == 0 {
+		return "", nil
+	}
+	for _, o := range objs {
+		o.AddLabels(map[string]string{name.ExtraManifestLabel: "true"})
+	}
+	y, err := objs.YAMLManifest()
 	if err != nil {
 		return "", err
 	}
+	return helm.YAMLSeparator + y, nil
+}
+
+// instanceName returns the name that c is selected by in the IstioOperatorSpec: the component name for core
+// components, and the name of the gateway or addon for the others.
+func (c *CommonComponentFields) instanceName() string {
+	switch {
+	case c.componentName.IsAddon():
+		return c.addonName
+	case c.componentName == name.IngressComponentName || c.componentName == name.EgressComponentName:
+		return c.resourceName
+	}
+	return string(c.componentName)
+}
+
+// AddonSpec is the spec of an entry of addonComponents, which configures an addon that is installed from its own
+// chart.
+type AddonSpec struct {
+	// Values are the helm values of the addon, which are overlaid on the values of the addon chart.
+	Values map[string]interface{} `json:"values,omitempty"`
+	// InstallAfter is the name of the core component, in any case, that the addon is installed after. It is Base if it
+	// is empty.
+	InstallAfter string `json:"installAfter,omitempty"`
+}
+
+// GetAddonSpec returns the spec of the addon component addonName of iop, or an empty spec if it has none. It is an
+// error for the spec to have fields other than those of AddonSpec, or to be installed after a component that is not a
+// core component.
+func GetAddonSpec(iop *v1alpha1.IstioOperatorSpec, addonName string) (*AddonSpec, error) {
+	out := &AddonSpec{}
+	c := iop.AddonComponents[addonName]
+	if c == nil || c.Spec == nil {
+		return out, nil
+	}
+	j, err := json.Marshal(c.Spec)
+	if err != nil {
+		return nil, err
+	}
+	d := json.NewDecoder(bytes.NewReader(j))
+	d.DisallowUnknownFields()
+	if err := d.Decode(out); err != nil {
+		return nil, fmt.Errorf("bad addonComponents.%s.spec: %s", addonName, err)
+	}
+	if _, err := coreComponentName(out.InstallAfter); err != nil {
+		return nil, fmt.Errorf("bad addonComponents.%s.spec.installAfter: %s", addonName, err)
+	}
+	return out, nil
+}
+
+// AddonsInstallAfter returns the core components that the enabled addon components of iop are installed after, in
+// name order.
+func AddonsInstallAfter(iop *v1alpha1.IstioOperatorSpec) ([]name.ComponentName, error) {
+	seen := make(map[name.ComponentName]bool)
+	var out []name.ComponentName
+	for an, c := range iop.AddonComponents {
+		if c == nil || c.Enabled == nil || !c.Enabled.Value {
+			continue
+		}
+		spec, err := GetAddonSpec(iop, an)
+		if err != nil {
+			return nil, err
+		}
+		cn, err := coreComponentName(spec.InstallAfter)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[cn] {
+			seen[cn] = true
+			out = append(out, cn)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out, nil
+}
 
-	log.Infof("Manifest after resources and overlay: \n%s\n", ret)
-	return ret, nil
+// coreComponentName returns the core component named s in any case, or Base if s is empty.
+func coreComponentName(s string) (name.ComponentName, error) {
+	if s == "" {
+		return name.IstioBaseComponentName, nil
+	}
+	for _, cn := range name.AllCoreComponentNames {
+		if strings.EqualFold(s, string(cn)) {
+			return cn, nil
+		}
+	}
+	return "", fmt.Errorf("%s is not a core component", s)
+}
+
+// overlayAddonValues returns the helm values mergedYAML of the addon component c with the values in its spec
+// overlaid.
+func (c *CommonComponentFields) overlayAddonValues(mergedYAML string) (string, error) {
+	spec, err := GetAddonSpec(c.InstallSpec, c.addonName)
+	if err != nil {
+		return "", err
+	}
+	if len(spec.Values) == 0 {
+		return mergedYAML, nil
+	}
+	vy, err := yaml.Marshal(spec.Values)
+	if err != nil {
+		return "", err
+	}
+	return util.OverlayYAML(mergedYAML, string(vy))
 }
 
 // createHelmRenderer creates a helm renderer for the component defined by c and returns a ptr to it.
 // If a helm subdir is not found in ComponentMap translations, it is assumed to be "addon/<component name>.
+// Addons with their own chart are rendered with the renderer selected by the format of its reference, and chart URLs
+// and charts in chart repositories must have been fetched into a chart dir.
 func createHelmRenderer(c *CommonComponentFields) (helm.TemplateRenderer, error) {
 	iop := c.InstallSpec
 	cns := string(c.componentName)
 	if c.componentName.IsAddon() {
 		// For addons, distinguish the chart path using the addon name.
 		cns = c.addonName
+		if ac := iop.AddonComponents[cns]; ac != nil && ac.ChartPath != "" {
+			r, err := helm.NewChartRenderer(ac.ChartPath, cns, c.Namespace)
+			if err != nil {
+				return nil, fmt.Errorf("addon %s: %s", cns, err)
+			}
+			return r, nil
+		}
 	}
 	helmSubdir := addonsChartDirName + "/" + cns
 	if cm := c.Translator.ComponentMap(cns); cm != nil {
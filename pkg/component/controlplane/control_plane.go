@@ -16,10 +16,12 @@ package controlplane
 
 import (
 	"fmt"
+	"strings"
 
 	"istio.io/api/operator/v1alpha1"
 	"istio.io/operator/pkg/component/component"
 	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/postrender"
 	"istio.io/operator/pkg/translate"
 	"istio.io/operator/pkg/util"
 )
@@ -28,12 +30,37 @@ import (
 type IstioOperator struct {
 	// components is a slice of components that are part of the feature.
 	components []component.IstioComponent
-	started    bool
+	// names are the names of the components, the component name for core components and the name of the gateway or
+	// addon for the others.
+	names []string
+	// installSpec is the IstioOperatorSpec the components are rendered from.
+	installSpec *v1alpha1.IstioOperatorSpec
+	started     bool
+	// warnings are the overlays, overlay paths and K8S settings that matched nothing in the last rendered manifests,
+	// and the extra manifests of components that do not exist.
+	warnings util.Errors
+	// postRenderer transforms the rendered manifests, if set.
+	postRenderer postrender.PostRenderer
+	// opts are the options of the components.
+	opts []*component.Options
+}
+
+// SetPostRenderer sets the PostRenderer that the manifests rendered by RenderManifest are transformed with.
+func (i *IstioOperator) SetPostRenderer(r postrender.PostRenderer) {
+	i.postRenderer = r
+}
+
+// SetReadLocalFiles sets whether the components can read their extra manifests from files, see
+// component.Options.ReadLocalFiles. Files are not read by default.
+func (i *IstioOperator) SetReadLocalFiles(read bool) {
+	for _, o := range i.opts {
+		o.ReadLocalFiles = read
+	}
 }
 
 // NewIstioOperator creates a new IstioOperator and returns a pointer to it.
 func NewIstioOperator(installSpec *v1alpha1.IstioOperatorSpec, translator *translate.Translator) (*IstioOperator, error) {
-	out := &IstioOperator{}
+	out := &IstioOperator{installSpec: installSpec}
 	opts := &component.Options{
 		InstallSpec: installSpec,
 		Translator:  translator,
@@ -46,6 +73,8 @@ func NewIstioOperator(installSpec *v1alpha1.IstioOperatorSpec, translator *trans
 		}
 		o.Namespace = ns
 		out.components = append(out.components, component.NewComponent(c, &o))
+		out.opts = append(out.opts, &o)
+		out.names = append(out.names, string(c))
 	}
 	for idx, c := range installSpec.Components.IngressGateways {
 		if c.Enabled == nil || !c.Enabled.Value {
@@ -54,6 +83,8 @@ func NewIstioOperator(installSpec *v1alpha1.IstioOperatorSpec, translator *trans
 		o := *opts
 		o.Namespace = defaultIfEmpty(c.Namespace, installSpec.MeshConfig.RootNamespace)
 		out.components = append(out.components, component.NewIngressComponent(c.Name, idx, &o))
+		out.opts = append(out.opts, &o)
+		out.names = append(out.names, c.Name)
 	}
 	for idx, c := range installSpec.Components.EgressGateways {
 		if c.Enabled == nil || !c.Enabled.Value {
@@ -62,6 +93,8 @@ func NewIstioOperator(installSpec *v1alpha1.IstioOperatorSpec, translator *trans
 		o := *opts
 		o.Namespace = defaultIfEmpty(c.Namespace, installSpec.MeshConfig.RootNamespace)
 		out.components = append(out.components, component.NewEgressComponent(c.Name, idx, &o))
+		out.opts = append(out.opts, &o)
+		out.names = append(out.names, c.Name)
 	}
 	for cn, c := range installSpec.AddonComponents {
 		if c.Enabled == nil || !c.Enabled.Value {
@@ -76,6 +109,8 @@ func NewIstioOperator(installSpec *v1alpha1.IstioOperatorSpec, translator *trans
 		o := *opts
 		o.Namespace = defaultIfEmpty(c.Namespace, installSpec.MeshConfig.RootNamespace)
 		out.components = append(out.components, component.NewAddonComponent(cn, rn, &o))
+		out.opts = append(out.opts, &o)
+		out.names = append(out.names, cn)
 	}
 	return out, nil
 }
@@ -99,19 +134,64 @@ func (i *IstioOperator) Run() error {
 }
 
 // RenderManifest returns a manifest rendered against
+// The manifests are transformed with the PostRenderer of i, if it has one.
 func (i *IstioOperator) RenderManifest() (manifests name.ManifestMap, errsOut util.Errors) {
 	if !i.started {
 		return nil, util.NewErrs(fmt.Errorf("istioControlPlane must be Run before calling RenderManifest"))
 	}
 
 	manifests = make(name.ManifestMap)
+	i.warnings = nil
 	for _, c := range i.components {
 		ms, err := c.RenderManifest()
 		errsOut = util.AppendErr(errsOut, err)
+		i.warnings = util.AppendErrs(i.warnings, c.Warnings())
 		manifests[c.ComponentName()] = append(manifests[c.ComponentName()], ms)
 	}
 	if len(errsOut) > 0 {
 		return nil, errsOut
 	}
+	if err := i.checkExtraManifests(); err != nil {
+		return nil, util.NewErrs(err)
+	}
+	if i.postRenderer != nil {
+		pm, err := postrender.RunOnManifests(i.postRenderer, manifests)
+		if err != nil {
+			return nil, util.NewErrs(err)
+		}
+		manifests = pm
+	}
 	return
 }
+
+// Warnings returns the overlays, overlay paths and K8S settings of all the components that matched nothing in the
+// manifests last rendered by RenderManifest, and the extra manifests of components that do not exist.
+func (i *IstioOperator) Warnings() util.Errors {
+	return i.warnings
+}
+
+// Component returns the component of i with the given name: a core component name such as Pilot, in any case, or the
+// name of a gateway or addon component.
+func (i *IstioOperator) Component(n string) (component.IstioComponent, error) {
+	for idx, cn := range i.names {
+		if strings.EqualFold(cn, n) {
+			return i.components[idx], nil
+		}
+	}
+	return nil, fmt.Errorf("no component named %s, the components are: %s", n, strings.Join(i.names, ", "))
+}
+
+// checkExtraManifests adds a warning to i for each of the extra manifests in the values that is not installed because
+// it names a component that i does not have, such as a disabled gateway.
+func (i *IstioOperator) checkExtraManifests() error {
+	ems, err := component.ExtraManifests(i.installSpec)
+	if err != nil {
+		return err
+	}
+	for idx, e := range ems {
+		if _, err := i.Component(e.Component); err != nil {
+			i.warnings = util.AppendErr(i.warnings, fmt.Errorf("values.global.extraManifests[%d]: %s", idx, err))
+		}
+	}
+	return nil
+}
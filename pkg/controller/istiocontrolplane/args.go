@@ -15,7 +15,14 @@
 package istiocontrolplane
 
 import (
+	"os"
+	"path/filepath"
+
 	"github.com/spf13/cobra"
+
+	"istio.io/operator/pkg/helm"
+	"istio.io/operator/pkg/policy"
+	"istio.io/operator/pkg/postrender"
 )
 
 // Options represents the details used to configure the controller.
@@ -26,6 +33,29 @@ type Options struct {
 	// DefaultChartPath is the relative path used added to BaseChartPath when no value is specified in
 	// IstioOperator.Spec.ChartPath
 	DefaultChartPath string
+	// PackageCacheDir is the root directory of the cache that install packages fetched from URLs are stored in.
+	// It should be on a persistent volume, so that packages survive restarts of the operator.
+	PackageCacheDir string
+	// Keyring is the path to the keyring that install package signatures are verified against.
+	Keyring string
+	// InsecureSkipVerify means install packages are used without verifying their signature.
+	InsecureSkipVerify bool
+	// WebhookPort is the port the IstioOperator validating webhook is served on, or 0 if it is not served.
+	WebhookPort int
+	// WebhookCertDir is the directory the serving certificate of the webhook is written to.
+	WebhookCertDir string
+	// WebhookConfigName is the name of the ValidatingWebhookConfiguration that registers the webhook.
+	WebhookConfigName string
+	// PolicyFile is the path to the guardrail policy that installations must follow, if any.
+	PolicyFile string
+	// StrictOverlays rejects IstioOperators with overlays, overlay paths or K8S settings that match nothing in the
+	// rendered manifests.
+	StrictOverlays bool
+	// PostRenderer is the executable or kustomization directory that the rendered manifests are transformed with, if
+	// any.
+	PostRenderer string
+	// PostRendererArgs are the arguments of the PostRenderer executable.
+	PostRendererArgs []string
 }
 
 // ControllerOptions represents the options used by the controller
@@ -45,4 +75,32 @@ func AttachCobraFlags(cmd *cobra.Command) {
 			"This will be used as the base path for any IstioOperator instances specifying a relative ChartPath.")
 	cmd.PersistentFlags().StringVar(&controllerOptions.BaseChartPath, "default-chart-path", "",
 		"A path relative to base-chart-path containing charts to be used when no ChartPath is specified by an IstioOperator resource, e.g. 1.1.0/istio")
+	cmd.PersistentFlags().StringVar(&controllerOptions.PackageCacheDir, "package-cache-dir", "",
+		"Root directory of the cache for install packages fetched from URLs, ideally on a persistent volume. "+
+			"Defaults to $"+helm.PackageCacheDirEnvVar+" if set, or a directory under the system temp dir.")
+	cmd.PersistentFlags().StringVar(&controllerOptions.Keyring, "keyring", os.Getenv(helm.KeyringEnvVar),
+		"Path to a file or directory with the ed25519 (PEM) or PGP public keys that install package signatures are "+
+			"verified against. Defaults to $"+helm.KeyringEnvVar+".")
+	cmd.PersistentFlags().BoolVar(&controllerOptions.InsecureSkipVerify, "insecure-skip-verify", false,
+		"Use install packages fetched from URLs without verifying their signature.")
+	cmd.PersistentFlags().IntVar(&controllerOptions.WebhookPort, "webhook-port", 9443,
+		"Port the IstioOperator validating webhook is served on. The webhook is disabled if it is 0.")
+	cmd.PersistentFlags().StringVar(&controllerOptions.WebhookCertDir, "webhook-cert-dir",
+		filepath.Join(os.TempDir(), "istio-operator", "webhook-certs"),
+		"Directory the serving certificate of the webhook is written to. The certificate is kept in a Secret in the "+
+			"operator namespace.")
+	cmd.PersistentFlags().StringVar(&controllerOptions.WebhookConfigName, "webhook-config-name", "istio-operator",
+		"Name of the ValidatingWebhookConfiguration that registers the webhook. Its CA bundle is set at startup.")
+	cmd.PersistentFlags().StringVar(&controllerOptions.PolicyFile, "policy-file", "",
+		"Path to a guardrail policy file that the IstioOperatorSpec and the rendered manifests of every IstioOperator "+
+			"must follow. Violations are allowed with the "+policy.ExemptAnnotationKey+" annotation.")
+	cmd.PersistentFlags().BoolVar(&controllerOptions.StrictOverlays, "strict-overlays", false,
+		"Fail the reconciliation of IstioOperators with an overlay, overlay path or K8S setting that matches nothing in "+
+			"the rendered manifests, rather than log a warning.")
+	cmd.PersistentFlags().StringVar(&controllerOptions.PostRenderer, "post-renderer", "",
+		"Executable that the rendered manifests of every IstioOperator are piped through before they are applied, as "+
+			"YAML on stdin and stdout, or directory of a kustomization that lists "+postrender.ManifestFile+" in its "+
+			"resources, which the manifests are written to.")
+	cmd.PersistentFlags().StringArrayVar(&controllerOptions.PostRendererArgs, "post-renderer-arg", nil,
+		"An argument of the --post-renderer executable. May be repeated.")
 }
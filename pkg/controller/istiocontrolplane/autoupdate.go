@@ -0,0 +1,340 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package istiocontrolplane
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	iop "istio.io/operator/pkg/apis/istio/v1alpha1"
+	"istio.io/operator/pkg/helm"
+	"istio.io/pkg/log"
+)
+
+// The auto-update setting is recorded as annotations because IstioOperatorSpec is defined in istio.io/api.
+const (
+	// AutoUpdateKey is the IstioOperator annotation that, when set to "true", subscribes the resource to updates of
+	// the install package at spec.installPackagePath, which must be an HTTP(S) or file:// URL.
+	AutoUpdateKey = MetadataNamespace + "/auto-update"
+	// AutoUpdateIntervalKey is the IstioOperator annotation setting how often the install package URL is checked for
+	// updates, e.g. 30m.
+	AutoUpdateIntervalKey = MetadataNamespace + "/auto-update-interval"
+
+	// defaultAutoUpdateInterval is the interval used if AutoUpdateIntervalKey is not set.
+	defaultAutoUpdateInterval = 10 * time.Minute
+	// minAutoUpdateInterval is the shortest interval accepted in AutoUpdateIntervalKey.
+	minAutoUpdateInterval = time.Minute
+)
+
+var (
+	// packageStatusPredicate filters out updates of IstioOperator resources that only change the install package
+	// status, so that recording every background check does not trigger a reconcile.
+	packageStatusPredicate = predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldIOP, ok := e.ObjectOld.(*iop.IstioOperator)
+			if !ok {
+				return true
+			}
+			newIOP, ok := e.ObjectNew.(*iop.IstioOperator)
+			if !ok {
+				return true
+			}
+			return !reflect.DeepEqual(withoutPackageStatus(oldIOP), withoutPackageStatus(newIOP))
+		},
+	}
+)
+
+// autoUpdateSubscription is the install package URL an IstioOperator is subscribed to and the requested interval.
+type autoUpdateSubscription struct {
+	url      string
+	interval time.Duration
+}
+
+// packageSubscriptions tracks the IstioOperator resources subscribed to install package updates and runs a
+// helm.URLPoller for each subscribed URL. When a poller fetches a new package, a reconcile is queued for every
+// resource subscribed to its URL.
+type packageSubscriptions struct {
+	client client.Client
+	// events receives an event for every resource that must be reconciled after a package update.
+	events chan event.GenericEvent
+
+	// mu protects the maps below.
+	mu sync.Mutex
+	// pollers holds the poller of each subscribed URL.
+	pollers map[string]*helm.URLPoller
+	// subscribers holds the subscription of each subscribed resource.
+	subscribers map[types.NamespacedName]autoUpdateSubscription
+}
+
+// newPackageSubscriptions creates a packageSubscriptions that updates resources with cl and returns a pointer to it.
+func newPackageSubscriptions(cl client.Client) *packageSubscriptions {
+	return &packageSubscriptions{
+		client:      cl,
+		events:      make(chan event.GenericEvent),
+		pollers:     make(map[string]*helm.URLPoller),
+		subscribers: make(map[types.NamespacedName]autoUpdateSubscription),
+	}
+}
+
+// getAutoUpdateSubscription returns the subscription requested in the annotations of instance, or nil if it has
+// not opted in to automatic updates.
+func getAutoUpdateSubscription(instance *iop.IstioOperator) (*autoUpdateSubscription, error) {
+	annotations := instance.GetAnnotations()
+	enabled := false
+	if v, ok := annotations[AutoUpdateKey]; ok {
+		var err error
+		if enabled, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("bad %s annotation value %q: %s", AutoUpdateKey, v, err)
+		}
+	}
+	if !enabled {
+		return nil, nil
+	}
+	if instance.Spec == nil || !helm.IsInstallPackageURL(instance.Spec.InstallPackagePath) {
+		return nil, fmt.Errorf("%s requires spec.installPackagePath to be an HTTP(S) or file:// URL", AutoUpdateKey)
+	}
+	sub := &autoUpdateSubscription{
+		url:      instance.Spec.InstallPackagePath,
+		interval: defaultAutoUpdateInterval,
+	}
+	if v, ok := annotations[AutoUpdateIntervalKey]; ok {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("bad %s annotation value %q: %s", AutoUpdateIntervalKey, v, err)
+		}
+		if d < minAutoUpdateInterval {
+			return nil, fmt.Errorf("%s must be at least %s, got %s", AutoUpdateIntervalKey, minAutoUpdateInterval, d)
+		}
+		sub.interval = d
+	}
+	return sub, nil
+}
+
+// sync subscribes or unsubscribes instance according to its annotations and returns the poller of the URL it is
+// subscribed to, or nil if it is not subscribed.
+func (s *packageSubscriptions) sync(instance *iop.IstioOperator) (*helm.URLPoller, error) {
+	key := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	sub, err := getAutoUpdateSubscription(instance)
+	if err != nil || sub == nil {
+		s.unsubscribe(key)
+		return nil, err
+	}
+	return s.subscribe(key, *sub)
+}
+
+// subscribe subscribes the resource key to sub and returns the poller of its URL. A URL is polled at the shortest
+// interval requested by its subscribers. A new poller checks its URL before subscribe returns, so that the package
+// is available to the reconcile that triggered the subscription.
+func (s *packageSubscriptions) subscribe(key types.NamespacedName, sub autoUpdateSubscription) (*helm.URLPoller, error) {
+	p, isNew, err := s.register(key, sub)
+	if err != nil || !isNew {
+		return p, err
+	}
+	url := sub.url
+	err = p.Start(func(updated bool, _ error) {
+		s.onCheck(url, updated)
+	})
+	return p, err
+}
+
+// register records the subscription of the resource key to sub and returns the poller of its URL, creating a new one
+// if the URL was not polled yet. The interval of an existing poller is updated to the shortest interval of its
+// subscribers. isNew is true if the returned poller was created and must be started by the caller. register does not
+// start pollers because the first check fetches the package, which must not block other subscriptions.
+func (s *packageSubscriptions) register(key types.NamespacedName, sub autoUpdateSubscription) (p *helm.URLPoller,
+	isNew bool, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if old, ok := s.subscribers[key]; ok && old.url != sub.url {
+		s.unsubscribeLocked(key)
+	}
+	s.subscribers[key] = sub
+
+	interval, _ := s.intervalLocked(sub.url)
+	if p, ok := s.pollers[sub.url]; ok {
+		return p, false, s.setIntervalLocked(p, sub.url, interval)
+	}
+
+	p, err = helm.NewPoller(sub.url, controllerOptions.PackageCacheDir, interval)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := configureFetcher(p.Fetcher()); err != nil {
+		return nil, false, err
+	}
+	log.Infof("Polling install package %s every %s", sub.url, interval)
+	s.pollers[sub.url] = p
+	return p, true, nil
+}
+
+// unsubscribe removes the subscription of the resource key, if any, and stops polling its URL if it was the last
+// subscriber.
+func (s *packageSubscriptions) unsubscribe(key types.NamespacedName) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.unsubscribeLocked(key)
+}
+
+func (s *packageSubscriptions) unsubscribeLocked(key types.NamespacedName) {
+	sub, ok := s.subscribers[key]
+	if !ok {
+		return
+	}
+	delete(s.subscribers, key)
+	p, ok := s.pollers[sub.url]
+	if !ok {
+		return
+	}
+	interval, ok := s.intervalLocked(sub.url)
+	if !ok {
+		log.Infof("Stopped polling install package %s", sub.url)
+		p.Stop()
+		delete(s.pollers, sub.url)
+		return
+	}
+	// The leaving subscriber may have requested the shortest interval.
+	if err := s.setIntervalLocked(p, sub.url, interval); err != nil {
+		log.Errorf("failed to change the poll interval of install package %s: %s", sub.url, err)
+	}
+}
+
+// intervalLocked returns the shortest interval requested by the subscribers of url, and false if it has none.
+func (s *packageSubscriptions) intervalLocked(url string) (time.Duration, bool) {
+	var interval time.Duration
+	found := false
+	for _, sub := range s.subscribers {
+		if sub.url == url && (!found || sub.interval < interval) {
+			interval, found = sub.interval, true
+		}
+	}
+	return interval, found
+}
+
+// setIntervalLocked sets the interval of p, the poller of url, if it changed.
+func (s *packageSubscriptions) setIntervalLocked(p *helm.URLPoller, url string, interval time.Duration) error {
+	if p.Interval() == interval {
+		return nil
+	}
+	log.Infof("Polling install package %s every %s", url, interval)
+	return p.SetInterval(interval)
+}
+
+// subscribersOf returns the resources subscribed to url.
+func (s *packageSubscriptions) subscribersOf(url string) []types.NamespacedName {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []types.NamespacedName
+	for key, sub := range s.subscribers {
+		if sub.url == url {
+			out = append(out, key)
+		}
+	}
+	return out
+}
+
+// onCheck is called after every background check of url. It queues a reconcile of every subscriber if the package
+// was updated, and otherwise only records the check in their status.
+func (s *packageSubscriptions) onCheck(url string, updated bool) {
+	s.mu.Lock()
+	p := s.pollers[url]
+	s.mu.Unlock()
+	if p == nil {
+		return
+	}
+	for _, key := range s.subscribersOf(url) {
+		if updated {
+			log.Infof("Install package %s was updated, reconciling %s", url, key)
+			obj := &iop.IstioOperator{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}
+			s.events <- event.GenericEvent{Meta: obj, Object: obj}
+			continue
+		}
+		lastCheck, checkErr := p.LastCheck()
+		if err := setPackageStatus(s.client, key, nil, lastCheck, checkErr); err != nil {
+			log.Errorf("failed to update install package status of %s: %s", key, err)
+		}
+	}
+}
+
+// setPackageStatus records the outcome of the last install package check and, if applied is not nil, the digest and
+// verified signer of the applied package in the status of the IstioOperator key. A zero lastCheck leaves the time of
+// the last check unchanged. The status is only updated if a value changes.
+func setPackageStatus(cl client.Client, key types.NamespacedName, applied *helm.CachedPackage, lastCheck time.Time,
+	checkErr error) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		instance := &iop.IstioOperator{}
+		if err := cl.Get(context.TODO(), key, instance); err != nil {
+			return err
+		}
+		if instance.Status == nil {
+			instance.Status = &iop.IstioOperatorStatus{}
+		}
+		old := instance.Status.InstallPackage
+		status := &iop.InstallPackageStatus{}
+		if old != nil {
+			*status = *old
+		}
+		if applied != nil {
+			status.Digest = "sha256:" + applied.Digest
+			status.Signer = applied.Signer
+		}
+		if !lastCheck.IsZero() {
+			t := metav1.NewTime(lastCheck)
+			status.LastCheck = &t
+		}
+		status.Error = ""
+		if checkErr != nil {
+			status.Error = checkErr.Error()
+		}
+		if old != nil && packageStatusEqual(old, status) {
+			return nil
+		}
+		instance.Status.InstallPackage = status
+		return cl.Status().Update(context.TODO(), instance)
+	})
+}
+
+// packageStatusEqual reports whether the install package statuses a and b are equal. Check times are compared to the
+// second, the precision they are stored with.
+func packageStatusEqual(a, b *iop.InstallPackageStatus) bool {
+	if a.Digest != b.Digest || a.Signer != b.Signer || a.Error != b.Error {
+		return false
+	}
+	if a.LastCheck == nil || b.LastCheck == nil {
+		return a.LastCheck == b.LastCheck
+	}
+	ta, tb := a.LastCheck.Rfc3339Copy(), b.LastCheck.Rfc3339Copy()
+	return ta.Equal(&tb)
+}
+
+// withoutPackageStatus returns a copy of instance without the install package status and the resource version.
+func withoutPackageStatus(instance *iop.IstioOperator) *iop.IstioOperator {
+	out := instance.DeepCopy()
+	out.ResourceVersion = ""
+	if out.Status != nil {
+		out.Status.InstallPackage = nil
+	}
+	return out
+}
@@ -0,0 +1,235 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package istiocontrolplane
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"istio.io/api/operator/v1alpha1"
+	iop "istio.io/operator/pkg/apis/istio/v1alpha1"
+	"istio.io/operator/pkg/helm"
+)
+
+const testPackageURL = "https://example.com/istio-1.5.0-linux.tar.gz"
+
+func newAutoUpdateIOP(installPackagePath string, annotations map[string]string) *iop.IstioOperator {
+	return &iop.IstioOperator{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "example-istiocontrolplane",
+			Namespace:   "istio-system",
+			Annotations: annotations,
+		},
+		Spec: &v1alpha1.IstioOperatorSpec{
+			InstallPackagePath: installPackagePath,
+		},
+	}
+}
+
+func TestGetAutoUpdateSubscription(t *testing.T) {
+	tests := []struct {
+		desc        string
+		path        string
+		annotations map[string]string
+		want        *autoUpdateSubscription
+		wantErr     bool
+	}{
+		{
+			desc: "not subscribed",
+			path: testPackageURL,
+		},
+		{
+			desc:        "disabled",
+			path:        testPackageURL,
+			annotations: map[string]string{AutoUpdateKey: "false"},
+		},
+		{
+			desc:        "default interval",
+			path:        testPackageURL,
+			annotations: map[string]string{AutoUpdateKey: "true"},
+			want:        &autoUpdateSubscription{url: testPackageURL, interval: defaultAutoUpdateInterval},
+		},
+		{
+			desc:        "custom interval",
+			path:        testPackageURL,
+			annotations: map[string]string{AutoUpdateKey: "true", AutoUpdateIntervalKey: "1h"},
+			want:        &autoUpdateSubscription{url: testPackageURL, interval: time.Hour},
+		},
+		{
+			desc:        "interval too short",
+			path:        testPackageURL,
+			annotations: map[string]string{AutoUpdateKey: "true", AutoUpdateIntervalKey: "10s"},
+			wantErr:     true,
+		},
+		{
+			desc:        "bad value",
+			path:        testPackageURL,
+			annotations: map[string]string{AutoUpdateKey: "yes please"},
+			wantErr:     true,
+		},
+		{
+			desc:        "local install package path",
+			path:        "/tmp/istio/charts",
+			annotations: map[string]string{AutoUpdateKey: "true"},
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := getAutoUpdateSubscription(newAutoUpdateIOP(tt.path, tt.annotations))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error: %v, want error: %v", err, tt.wantErr)
+			}
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetPackageStatus(t *testing.T) {
+	instance := newAutoUpdateIOP(testPackageURL, map[string]string{AutoUpdateKey: "true"})
+	cl := fake.NewFakeClientWithScheme(testScheme(instance), instance)
+	key := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	lastCheck := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	lastCheckTime := metav1.NewTime(lastCheck)
+
+	tests := []struct {
+		desc      string
+		applied   *helm.CachedPackage
+		lastCheck time.Time
+		checkErr  error
+		want      *iop.InstallPackageStatus
+	}{
+		{
+			desc:     "create with error",
+			checkErr: fmt.Errorf("connection refused"),
+			want:     &iop.InstallPackageStatus{Error: "connection refused"},
+		},
+		{
+			desc:      "applied package clears error",
+			applied:   &helm.CachedPackage{Digest: "abcd", Signer: "Istio Release (0123ABCD)"},
+			lastCheck: lastCheck,
+			want: &iop.InstallPackageStatus{
+				Digest:    "sha256:abcd",
+				Signer:    "Istio Release (0123ABCD)",
+				LastCheck: &lastCheckTime,
+			},
+		},
+		{
+			desc:     "failed check keeps digest and last check",
+			checkErr: fmt.Errorf("timeout"),
+			want: &iop.InstallPackageStatus{
+				Digest:    "sha256:abcd",
+				Signer:    "Istio Release (0123ABCD)",
+				LastCheck: &lastCheckTime,
+				Error:     "timeout",
+			},
+		},
+		{
+			desc:    "unverified package clears signer",
+			applied: &helm.CachedPackage{Digest: "ef01"},
+			want: &iop.InstallPackageStatus{
+				Digest:    "sha256:ef01",
+				LastCheck: &lastCheckTime,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			if err := setPackageStatus(cl, key, tt.applied, tt.lastCheck, tt.checkErr); err != nil {
+				t.Fatal(err)
+			}
+			got := &iop.IstioOperator{}
+			if err := cl.Get(context.TODO(), key, got); err != nil {
+				t.Fatal(err)
+			}
+			if got.Status == nil || !packageStatusEqual(got.Status.InstallPackage, tt.want) {
+				t.Errorf("got install package status %+v, want %+v", got.Status, tt.want)
+			}
+			if !reflect.DeepEqual(got.Annotations, instance.Annotations) {
+				t.Errorf("got annotations %v, want them unchanged: %v", got.Annotations, instance.Annotations)
+			}
+		})
+	}
+}
+
+func TestPackageStatusPredicate(t *testing.T) {
+	old := newAutoUpdateIOP(testPackageURL, nil)
+	old.Status = &iop.IstioOperatorStatus{}
+	statusOnly := old.DeepCopy()
+	statusOnly.ResourceVersion = "2"
+	statusOnly.Status.InstallPackage = &iop.InstallPackageStatus{Digest: "sha256:abcd"}
+	if packageStatusPredicate.Update(event.UpdateEvent{ObjectOld: old, MetaOld: old, ObjectNew: statusOnly, MetaNew: statusOnly}) {
+		t.Error("got a reconcile for an install package status update, want none")
+	}
+	specChange := statusOnly.DeepCopy()
+	specChange.Spec.InstallPackagePath = "https://example.com/istio-1.5.1-linux.tar.gz"
+	if !packageStatusPredicate.Update(event.UpdateEvent{ObjectOld: old, MetaOld: old, ObjectNew: specChange, MetaNew: specChange}) {
+		t.Error("got no reconcile for a spec update, want one")
+	}
+}
+
+func TestRegisterSubscription(t *testing.T) {
+	s := newPackageSubscriptions(nil)
+	first := types.NamespacedName{Name: "first", Namespace: "istio-system"}
+	second := types.NamespacedName{Name: "second", Namespace: "istio-system"}
+
+	p1, isNew, err := s.register(first, autoUpdateSubscription{url: testPackageURL, interval: time.Hour})
+	if err != nil || !isNew {
+		t.Fatalf("first subscription: got new=%v, err=%v, want a new poller", isNew, err)
+	}
+	p2, isNew, err := s.register(second, autoUpdateSubscription{url: testPackageURL, interval: time.Hour})
+	if err != nil || isNew || p2 != p1 {
+		t.Fatalf("same interval: got new=%v, err=%v, want the existing poller", isNew, err)
+	}
+	p3, isNew, err := s.register(second, autoUpdateSubscription{url: testPackageURL, interval: time.Minute})
+	if err != nil || isNew || p3 != p1 {
+		t.Fatalf("shorter interval: got new=%v, err=%v, want the existing poller", isNew, err)
+	}
+	if got := p1.Interval(); got != time.Minute {
+		t.Errorf("shorter interval: got interval %s, want %s", got, time.Minute)
+	}
+
+	// The interval goes back up once the subscriber with the shortest interval leaves.
+	s.unsubscribe(second)
+	if got := p1.Interval(); got != time.Hour {
+		t.Errorf("after unsubscribing: got interval %s, want %s", got, time.Hour)
+	}
+
+	s.unsubscribe(first)
+	if len(s.pollers) != 0 || len(s.subscribers) != 0 {
+		t.Errorf("got %d pollers and %d subscribers after unsubscribing all, want none", len(s.pollers), len(s.subscribers))
+	}
+}
+
+// testScheme returns a scheme that knows the IstioOperator type of instance.
+func testScheme(instance *iop.IstioOperator) *runtime.Scheme {
+	instance.Kind = "IstioOperator"
+	instance.ApiVersion = "install.istio.io/v1alpha1"
+	s := scheme.Scheme
+	s.AddKnownTypes(iop.SchemeGroupVersion, instance)
+	return s
+}
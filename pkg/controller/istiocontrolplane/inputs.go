@@ -16,8 +16,10 @@ package istiocontrolplane
 
 import (
 	"istio.io/operator/pkg/apis/istio/v1alpha1"
+	"istio.io/operator/pkg/component/component"
 	"istio.io/operator/pkg/helmreconciler"
 	"istio.io/operator/pkg/name"
+	"istio.io/pkg/log"
 )
 
 var (
@@ -78,24 +80,52 @@ func (i *IstioRenderingInput) GetTargetNamespace() string {
 	return i.instance.Spec.MeshConfig.RootNamespace
 }
 
-// GetProcessingOrder returns the order in which the rendered charts should be processed.
+// GetProcessingOrder returns the order in which the rendered charts should be processed. All components are processed
+// after Base, except addon components, which are processed after the components they are installed after.
 func (i *IstioRenderingInput) GetProcessingOrder(m helmreconciler.ChartManifestsMap) (helmreconciler.ComponentNameToListMap, helmreconciler.DependencyWaitCh) {
 	componentNameList := make([]name.ComponentName, 0)
 	dependencyWaitCh := make(helmreconciler.DependencyWaitCh)
+	componentDependencies := make(helmreconciler.ComponentNameToListMap)
 	for c := range m {
 		cn := name.ComponentName(c)
-		if cn == name.IstioBaseComponentName {
+		switch cn {
+		case name.IstioBaseComponentName:
+			continue
+		case name.AddonComponentName:
+			parents := i.addonParents(m)
+			for _, p := range parents {
+				componentDependencies[p] = append(componentDependencies[p], cn)
+			}
+			dependencyWaitCh[cn] = make(chan struct{}, len(parents))
 			continue
 		}
 		componentNameList = append(componentNameList, cn)
 		dependencyWaitCh[cn] = make(chan struct{}, 1)
 	}
-	componentDependencies := helmreconciler.ComponentNameToListMap{
-		name.IstioBaseComponentName: componentNameList,
-	}
+	componentDependencies[name.IstioBaseComponentName] = append(componentDependencies[name.IstioBaseComponentName],
+		componentNameList...)
 	return componentDependencies, dependencyWaitCh
 }
 
+// addonParents returns the components in m that the addon components of i are installed after, or Base if there are
+// none.
+func (i *IstioRenderingInput) addonParents(m helmreconciler.ChartManifestsMap) []name.ComponentName {
+	addonsAfter, err := component.AddonsInstallAfter(i.instance.Spec)
+	if err != nil {
+		log.Errorf("Installing addons after %s: %s", name.IstioBaseComponentName, err)
+	}
+	var out []name.ComponentName
+	for _, p := range addonsAfter {
+		if _, ok := m[string(p)]; ok {
+			out = append(out, p)
+		}
+	}
+	if len(out) == 0 {
+		out = append(out, name.IstioBaseComponentName)
+	}
+	return out
+}
+
 func buildInstallTree() {
 	// Starting with root, recursively insert each first level child into each node.
 	helmreconciler.InsertChildrenRecursive(name.IstioBaseComponentName, installTree, componentDependencies)
@@ -0,0 +1,115 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package istiocontrolplane
+
+import (
+	"testing"
+
+	"istio.io/api/operator/v1alpha1"
+	iop "istio.io/operator/pkg/apis/istio/v1alpha1"
+	"istio.io/operator/pkg/helmreconciler"
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/util"
+)
+
+func TestGetProcessingOrder(t *testing.T) {
+	m := helmreconciler.ChartManifestsMap{
+		string(name.IstioBaseComponentName): nil,
+		string(name.PilotComponentName):     nil,
+		string(name.GalleyComponentName):    nil,
+		string(name.AddonComponentName):     nil,
+	}
+	tests := []struct {
+		desc        string
+		spec        string
+		wantParents []name.ComponentName
+	}{
+		{
+			desc:        "default",
+			spec:        "addonComponents:\n  greeter:\n    enabled: true\n",
+			wantParents: []name.ComponentName{name.IstioBaseComponentName},
+		},
+		{
+			desc: "install after",
+			spec: `addonComponents:
+  greeter:
+    enabled: true
+    spec:
+      installAfter: pilot
+  other-greeter:
+    enabled: true
+    spec:
+      installAfter: Galley
+  disabled-greeter:
+    enabled: false
+    spec:
+      installAfter: Policy
+`,
+			wantParents: []name.ComponentName{name.GalleyComponentName, name.PilotComponentName},
+		},
+		{
+			desc: "not rendered",
+			spec: `addonComponents:
+  greeter:
+    enabled: true
+    spec:
+      installAfter: Policy
+`,
+			wantParents: []name.ComponentName{name.IstioBaseComponentName},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			spec := &v1alpha1.IstioOperatorSpec{}
+			if err := util.UnmarshalWithJSONPB(tt.spec, spec); err != nil {
+				t.Fatal(err)
+			}
+			deps, dch := NewIstioRenderingInput(&iop.IstioOperator{Spec: spec}).GetProcessingOrder(m)
+			var gotParents []name.ComponentName
+			for p, children := range deps {
+				for _, c := range children {
+					if c == name.AddonComponentName {
+						gotParents = append(gotParents, p)
+					}
+				}
+			}
+			if len(gotParents) != len(tt.wantParents) {
+				t.Fatalf("got addon parents %v, want %v", gotParents, tt.wantParents)
+			}
+			for _, p := range tt.wantParents {
+				if !containsComponent(gotParents, p) {
+					t.Errorf("got addon parents %v, want %v", gotParents, tt.wantParents)
+				}
+			}
+			if got := cap(dch[name.AddonComponentName]); got != len(tt.wantParents) {
+				t.Errorf("got addon channel capacity %d, want %d", got, len(tt.wantParents))
+			}
+			for _, cn := range []name.ComponentName{name.PilotComponentName, name.GalleyComponentName} {
+				if !containsComponent(deps[name.IstioBaseComponentName], cn) {
+					t.Errorf("got %s not installed after %s", cn, name.IstioBaseComponentName)
+				}
+			}
+		})
+	}
+}
+
+func containsComponent(cns []name.ComponentName, cn name.ComponentName) bool {
+	for _, c := range cns {
+		if c == cn {
+			return true
+		}
+	}
+	return false
+}
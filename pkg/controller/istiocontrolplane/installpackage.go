@@ -0,0 +1,159 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package istiocontrolplane
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	"istio.io/api/operator/v1alpha1"
+	iop "istio.io/operator/pkg/apis/istio/v1alpha1"
+	"istio.io/operator/pkg/helm"
+	"istio.io/pkg/log"
+)
+
+// resolveInstallPackage returns the install package that instance must be rendered with, or nil if its
+// installPackagePath is not a URL. Resources subscribed to auto-update use the package of the poller of their URL,
+// which is also returned. Other resources fetch their package into the package cache, which is a no-op once it is
+// cached.
+func (r *ReconcileIstioOperator) resolveInstallPackage(instance *iop.IstioOperator) (*helm.CachedPackage, *helm.URLPoller, error) {
+	poller, err := r.syncPackageSubscription(instance)
+	if err != nil {
+		return nil, nil, err
+	}
+	if poller != nil {
+		return poller.Package(), poller, nil
+	}
+	if instance.Spec == nil || !helm.IsInstallPackageURL(instance.Spec.InstallPackagePath) {
+		return nil, nil, nil
+	}
+	uf, err := helm.NewURLFetcher(instance.Spec.InstallPackagePath, controllerOptions.PackageCacheDir)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := configureFetcher(uf); err != nil {
+		return nil, nil, err
+	}
+	if err := uf.FetchBundles().ToError(); err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch install package %s: %s", instance.Spec.InstallPackagePath, err)
+	}
+	return uf.Package(), nil, nil
+}
+
+// resolveAddonCharts fetches the charts of the addon components of iops that are URLs of chart archives or charts in
+// chart repositories into the package cache, and sets their chart paths to the fetched chart dirs. Local chart
+// archives are rejected, since chart paths come from the CR and the files of the operator must not be read. If
+// offline is set, only charts that are already in the package cache are used, and it is an error for an addon to use a
+// chart repository.
+func resolveAddonCharts(iops *v1alpha1.IstioOperatorSpec, offline bool) error {
+	newFetcher := func(url string) (*helm.URLFetcher, error) {
+		uf, err := helm.NewURLFetcher(url, controllerOptions.PackageCacheDir)
+		if err != nil {
+			return nil, err
+		}
+		uf.SetOffline(offline)
+		return uf, configureFetcher(uf)
+	}
+	for an, c := range iops.AddonComponents {
+		if c == nil || c.Enabled == nil || !c.Enabled.Value {
+			continue
+		}
+		if helm.IsChartArchive(c.ChartPath) {
+			return fmt.Errorf("addon %s: local chart archive %s is not read by the controller, use a chart URL or a "+
+				"chart repository reference", an, c.ChartPath)
+		}
+		if offline && helm.IsChartRepoRef(c.ChartPath) {
+			return fmt.Errorf("addon %s: chart repository %s is not read offline", an, c.ChartPath)
+		}
+		if !helm.IsInstallPackageURL(c.ChartPath) {
+			continue
+		}
+		dir, err := helm.FetchChart(c.ChartPath, newFetcher)
+		if err != nil {
+			return fmt.Errorf("addon %s: %s", an, err)
+		}
+		c.ChartPath = dir
+	}
+	return nil
+}
+
+// syncPackageSubscription subscribes or unsubscribes instance to install package updates according to its
+// annotations and returns the poller of the package it is subscribed to, or nil if it is not subscribed. A returned
+// poller always has a package.
+func (r *ReconcileIstioOperator) syncPackageSubscription(instance *iop.IstioOperator) (*helm.URLPoller, error) {
+	if r.packages == nil {
+		if sub, err := getAutoUpdateSubscription(instance); err != nil || sub != nil {
+			return nil, fmt.Errorf("install package auto-update is not available in this controller")
+		}
+		return nil, nil
+	}
+	poller, err := r.packages.sync(instance)
+	if poller == nil || (err == nil && poller.Package() != nil) {
+		return poller, err
+	}
+	if err == nil {
+		_, err = poller.LastCheck()
+	}
+	return nil, fmt.Errorf("no install package fetched from %s yet: %v", instance.Spec.InstallPackagePath, err)
+}
+
+// unsubscribe removes any install package subscription of the resource key.
+func (r *ReconcileIstioOperator) unsubscribe(key types.NamespacedName) {
+	if r.packages != nil {
+		r.packages.unsubscribe(key)
+	}
+}
+
+// setInstallPackageError reports that the install package of the resource key could not be fetched, by setting its
+// status to ERROR and recording fetchErr in its install package status.
+func (r *ReconcileIstioOperator) setInstallPackageError(key types.NamespacedName, fetchErr error) {
+	if err := setPackageStatus(r.client, key, nil, time.Time{}, fetchErr); err != nil {
+		log.Errorf("failed to record install package error of %s: %s", key, err)
+	}
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		instance := &iop.IstioOperator{}
+		if err := r.client.Get(context.TODO(), key, instance); err != nil {
+			return err
+		}
+		if instance.Status == nil {
+			instance.Status = &iop.IstioOperatorStatus{}
+		}
+		if instance.Status.Status == v1alpha1.InstallStatus_ERROR {
+			return nil
+		}
+		instance.Status.Status = v1alpha1.InstallStatus_ERROR
+		return r.client.Status().Update(context.TODO(), instance)
+	})
+	if err != nil {
+		log.Errorf("failed to update status of %s: %s", key, err)
+	}
+}
+
+// configureFetcher applies the package verification options of the controller to uf.
+func configureFetcher(uf *helm.URLFetcher) error {
+	if controllerOptions.Keyring != "" {
+		keyring, err := helm.LoadKeyring(controllerOptions.Keyring)
+		if err != nil {
+			return err
+		}
+		uf.SetKeyring(keyring)
+	}
+	uf.SetInsecureSkipVerify(controllerOptions.InsecureSkipVerify)
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package istiocontrolplane
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	protobuf "github.com/gogo/protobuf/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"istio.io/api/operator/v1alpha1"
+	iop "istio.io/operator/pkg/apis/istio/v1alpha1"
+	"istio.io/operator/pkg/helmreconciler"
+)
+
+func TestReconcileInstallPackageFetchError(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "istio-install-packages")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	oldCacheDir := controllerOptions.PackageCacheDir
+	controllerOptions.PackageCacheDir = tmp
+	defer func() { controllerOptions.PackageCacheDir = oldCacheDir }()
+
+	instance := newAutoUpdateIOP("file://"+filepath.Join(tmp, "missing", "istio-1.5.0-linux.tar.gz"), nil)
+	s := testScheme(instance)
+	cl := fake.NewFakeClientWithScheme(s, []runtime.Object{instance}...)
+	factory := &helmreconciler.Factory{CustomizerFactory: &IstioRenderingCustomizerFactory{}}
+	r := &ReconcileIstioOperator{client: cl, scheme: s, factory: factory}
+
+	key := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	if _, err := r.Reconcile(reconcile.Request{NamespacedName: key}); err == nil {
+		t.Fatalf("reconcile with missing install package: got no error")
+	}
+
+	got := &iop.IstioOperator{}
+	if err := cl.Get(context.TODO(), key, got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Status == nil || got.Status.Status != v1alpha1.InstallStatus_ERROR {
+		t.Errorf("got status %v, want %s", got.Status, v1alpha1.InstallStatus_ERROR)
+	}
+	if got.Status == nil || got.Status.InstallPackage == nil || got.Status.InstallPackage.Error == "" {
+		t.Errorf("got status %v, want an install package error", got.Status)
+	}
+}
+
+func TestResolveAddonChartsLocalArchive(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "istio-addon-charts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	archive := filepath.Join(tmp, "my-addon-1.0.0.tgz")
+	if err := ioutil.WriteFile(archive, []byte("not read"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, offline := range []bool{false, true} {
+		iops := &v1alpha1.IstioOperatorSpec{
+			AddonComponents: map[string]*v1alpha1.ExternalComponentSpec{
+				"myAddon": {
+					Enabled:   &v1alpha1.BoolValueForPB{BoolValue: protobuf.BoolValue{Value: true}},
+					ChartPath: archive,
+				},
+			},
+		}
+		err := resolveAddonCharts(iops, offline)
+		if err == nil || !strings.Contains(err.Error(), "is not read by the controller") {
+			t.Errorf("offline %v: got error %v, want local chart archive error", offline, err)
+		}
+	}
+}
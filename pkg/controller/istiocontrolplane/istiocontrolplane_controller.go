@@ -17,6 +17,7 @@ package istiocontrolplane
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -37,6 +38,9 @@ import (
 
 	iop "istio.io/operator/pkg/apis/istio/v1alpha1"
 	"istio.io/operator/pkg/helmreconciler"
+	"istio.io/operator/pkg/policy"
+	"istio.io/operator/pkg/postrender"
+	"istio.io/operator/pkg/translate"
 	"istio.io/pkg/log"
 )
 
@@ -54,17 +58,42 @@ const (
 // Add creates a new IstioOperator Controller and adds it to the Manager. The Manager will set fields on the Controller
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager) error {
-	return add(mgr, newReconciler(mgr))
+	r, err := newReconciler(mgr)
+	if err != nil {
+		return err
+	}
+	return add(mgr, r, r.packages.events)
 }
 
 // newReconciler returns a new reconcile.Reconciler
-func newReconciler(mgr manager.Manager) reconcile.Reconciler {
-	factory := &helmreconciler.Factory{CustomizerFactory: &IstioRenderingCustomizerFactory{}}
-	return &ReconcileIstioOperator{client: mgr.GetClient(), scheme: mgr.GetScheme(), factory: factory}
+func newReconciler(mgr manager.Manager) (*ReconcileIstioOperator, error) {
+	factory := &helmreconciler.Factory{CustomizerFactory: &IstioRenderingCustomizerFactory{}, RESTMapper: mgr.GetRESTMapper(),
+		StrictOverlays: controllerOptions.StrictOverlays}
+	if controllerOptions.PolicyFile != "" {
+		p, err := policy.Load(controllerOptions.PolicyFile)
+		if err != nil {
+			return nil, err
+		}
+		factory.Policy = p
+	}
+	if controllerOptions.PostRenderer != "" {
+		pr, err := postrender.New(controllerOptions.PostRenderer, controllerOptions.PostRendererArgs)
+		if err != nil {
+			return nil, err
+		}
+		factory.PostRenderer = pr
+	}
+	return &ReconcileIstioOperator{
+		client:   mgr.GetClient(),
+		scheme:   mgr.GetScheme(),
+		factory:  factory,
+		packages: newPackageSubscriptions(mgr.GetClient()),
+	}, nil
 }
 
-// add adds a new Controller to mgr with r as the reconcile.Reconciler
-func add(mgr manager.Manager, r reconcile.Reconciler) error {
+// add adds a new Controller to mgr with r as the reconcile.Reconciler. packageEvents receives the IstioOperator
+// resources to reconcile after an update of their install package.
+func add(mgr manager.Manager, r reconcile.Reconciler, packageEvents <-chan event.GenericEvent) error {
 	log.Info("Adding controller for IstioOperator")
 	// Create a new controller
 	c, err := controller.New("istiocontrolplane-controller", mgr, controller.Options{Reconciler: r})
@@ -73,12 +102,17 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 	}
 
 	// Watch for changes to primary resource IstioOperator
-	err = c.Watch(&source.Kind{Type: &iop.IstioOperator{}}, &handler.EnqueueRequestForObject{})
+	err = c.Watch(&source.Kind{Type: &iop.IstioOperator{}}, &handler.EnqueueRequestForObject{}, packageStatusPredicate)
+	if err != nil {
+		return err
+	}
+	// Watch for updates of install packages that IstioOperator resources are subscribed to
+	err = c.Watch(&source.Channel{Source: packageEvents}, &handler.EnqueueRequestForObject{})
 	if err != nil {
 		return err
 	}
 	//watch for changes to Istio resources
-	err = watchIstioResources(c)
+	err = watchIstioResources(c, mgr.GetRESTMapper())
 	if err != nil {
 		return err
 	}
@@ -95,6 +129,8 @@ type ReconcileIstioOperator struct {
 	client  client.Client
 	scheme  *runtime.Scheme
 	factory *helmreconciler.Factory
+	// packages tracks the resources subscribed to install package updates. Auto-update is unavailable if it is nil.
+	packages *packageSubscriptions
 }
 
 // Reconcile reads that state of the cluster for a IstioOperator object and makes changes based on the state read
@@ -122,6 +158,7 @@ func (r *ReconcileIstioOperator) Reconcile(request reconcile.Request) (reconcile
 			// Request object not found, could have been deleted after reconcile request.
 			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
 			// Return and don't requeue
+			r.unsubscribe(reqNamespacedName)
 			return reconcile.Result{}, nil
 		}
 		// Error reading the object - requeue the request.
@@ -137,6 +174,7 @@ func (r *ReconcileIstioOperator) Reconcile(request reconcile.Request) (reconcile
 			return reconcile.Result{}, nil
 		}
 		log.Info("Deleting IstioOperator")
+		r.unsubscribe(reqNamespacedName)
 
 		reconciler, err := r.factory.New(iop, r.client)
 		if err == nil {
@@ -175,12 +213,28 @@ func (r *ReconcileIstioOperator) Reconcile(request reconcile.Request) (reconcile
 	}
 
 	log.Info("Updating IstioOperator")
-	var err error
+	pkg, poller, err := r.resolveInstallPackage(iop)
+	if err != nil {
+		log.Errorf("failed to get install package: %s", err)
+		r.setInstallPackageError(reqNamespacedName, err)
+		return reconcile.Result{}, err
+	}
+	if err := helmreconciler.CheckDeprecatedFields(iop); err != nil {
+		log.Errorf("IstioOperator %s: %s", reqNamespacedName, err)
+		return reconcile.Result{}, err
+	}
 	iopMerged := *iop
 	iopMerged.Spec, err = helmreconciler.MergeIOPSWithProfile(iop.Spec)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
+	if pkg != nil {
+		iopMerged.Spec.InstallPackagePath = pkg.ChartsPath()
+	}
+	if err := resolveAddonCharts(iopMerged.Spec, false); err != nil {
+		log.Errorf("failed to get addon charts: %s", err)
+		return reconcile.Result{}, err
+	}
 	reconciler, err := r.getOrCreateReconciler(&iopMerged)
 	if err == nil {
 		err = reconciler.Reconcile()
@@ -190,6 +244,16 @@ func (r *ReconcileIstioOperator) Reconcile(request reconcile.Request) (reconcile
 	} else {
 		log.Errorf("failed to create reconciler: %s", err)
 	}
+	if err == nil && pkg != nil {
+		var lastCheck time.Time
+		var checkErr error
+		if poller != nil {
+			lastCheck, checkErr = poller.LastCheck()
+		}
+		if serr := setPackageStatus(r.client, reqNamespacedName, pkg, lastCheck, checkErr); serr != nil {
+			log.Errorf("failed to update install package status: %s", serr)
+		}
+	}
 
 	return reconcile.Result{}, err
 }
@@ -252,9 +316,22 @@ func (r *ReconcileIstioOperator) getOrCreateReconciler(iop *iop.IstioOperator) (
 	return reconciler, err
 }
 
-// Watch changes for Istio resources managed by the operator
-func watchIstioResources(c controller.Controller) error {
+// Watch changes for Istio resources managed by the operator. Resources in API versions that the cluster does not serve
+// according to mapper are not watched.
+func watchIstioResources(c controller.Controller, mapper meta.RESTMapper) error {
+	deprecations, err := translate.NewDeprecations()
+	if err != nil {
+		return err
+	}
 	for _, t := range watchedResources {
+		if _, err := mapper.RESTMapping(schema.GroupKind{Group: t.Group, Kind: t.Kind}, t.Version); meta.IsNoMatchError(err) {
+			msg := fmt.Sprintf("Not watching %s.%s.%s, which the cluster does not serve", t.Kind, t.Group, t.Version)
+			if a := deprecations.FindAPI(t.GroupVersion().String(), t.Kind); a != nil {
+				msg += fmt.Sprintf(", it is replaced by %s", a.Replacement)
+			}
+			log.Info(msg)
+			continue
+		}
 		u := &unstructured.Unstructured{}
 		u.SetGroupVersionKind(schema.GroupVersionKind{
 			Kind:    t.Kind,
@@ -81,16 +81,20 @@ func NewIstioStatusUpdater(instance *iop.IstioOperator) helmreconciler.Rendering
 
 // EndReconcile updates the status field on the IstioOperator instance based on the resulting err parameter.
 func (u *IstioStatusUpdater) EndReconcile(_ runtime.Object, status *v1alpha1.InstallStatus) error {
-	iop := &iop.IstioOperator{}
+	instance := &iop.IstioOperator{}
 	namespacedName := types.NamespacedName{
 		Name:      u.instance.Name,
 		Namespace: u.instance.Namespace,
 	}
-	if err := u.reconciler.GetClient().Get(context.TODO(), namespacedName, iop); err != nil {
+	if err := u.reconciler.GetClient().Get(context.TODO(), namespacedName, instance); err != nil {
 		return fmt.Errorf("failed to get IstioOperator before updating status due to %v", err)
 	}
-	iop.Status = status
-	return u.reconciler.GetClient().Status().Update(context.TODO(), iop)
+	// Keep the install package status, which is recorded separately by setPackageStatus.
+	if instance.Status == nil {
+		instance.Status = &iop.IstioOperatorStatus{}
+	}
+	instance.Status.InstallStatus = *status
+	return u.reconciler.GetClient().Status().Update(context.TODO(), instance)
 }
 
 // RegisterReconciler registers the HelmReconciler with this object
@@ -0,0 +1,205 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package istiocontrolplane
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"istio.io/api/operator/v1alpha1"
+	"istio.io/operator/pkg/helm"
+	"istio.io/operator/pkg/helmreconciler"
+	"istio.io/operator/pkg/util"
+	"istio.io/operator/pkg/validate"
+	"istio.io/pkg/log"
+)
+
+const (
+	// webhookPath is the path the IstioOperator validating webhook is served at.
+	webhookPath = "/validate-istiooperator"
+	// webhookServiceName is the name of the Service in the operator namespace that the API server calls the webhook
+	// through.
+	webhookServiceName = "istio-operator"
+)
+
+// AddWebhook registers the IstioOperator validating webhook with the webhook server of mgr, after making sure that
+// the server has a certificate and that the ValidatingWebhookConfiguration trusts it. namespace is the namespace the
+// operator runs in. The webhook is not served if the webhook port option is 0.
+func AddWebhook(mgr manager.Manager, namespace string) error {
+	if controllerOptions.WebhookPort == 0 {
+		log.Info("IstioOperator validating webhook is disabled")
+		return nil
+	}
+	ctx := context.TODO()
+	// The cache of the manager is not started yet, so objects are read directly from the API server.
+	cert, err := ensureWebhookCert(ctx, mgr.GetAPIReader(), mgr.GetClient(), namespace)
+	if err != nil {
+		return err
+	}
+	if err := cert.writeTo(controllerOptions.WebhookCertDir); err != nil {
+		return err
+	}
+	svc := types.NamespacedName{Namespace: namespace, Name: webhookServiceName}
+	if err := patchWebhookCABundle(ctx, mgr.GetAPIReader(), mgr.GetClient(), controllerOptions.WebhookConfigName, svc,
+		cert.caCert); err != nil {
+		return err
+	}
+
+	srv := mgr.GetWebhookServer()
+	srv.Port = controllerOptions.WebhookPort
+	srv.CertDir = controllerOptions.WebhookCertDir
+	srv.Register(webhookPath, newWebhook(mgr.GetAPIReader()))
+	return nil
+}
+
+// newWebhook returns the IstioOperator validating webhook, which looks up namespaces with reader.
+func newWebhook(reader client.Reader) http.Handler {
+	return &admission.Webhook{Handler: &iopValidator{reader: reader}}
+}
+
+// iopValidator is an admission.Handler that rejects IstioOperator resources that fail the validation of
+// mesh validate.
+type iopValidator struct {
+	reader client.Reader
+}
+
+var _ admission.Handler = &iopValidator{}
+
+// Handle implements admission.Handler.
+func (v *iopValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1beta1.Create && req.Operation != admissionv1beta1.Update {
+		return admission.Allowed("")
+	}
+	ds := v.validate(ctx, string(req.Object.Raw))
+	var errs, warnings []string
+	for _, d := range ds {
+		// The request holds the resource as JSON, so positions in it would not help the user.
+		d.File, d.Line, d.Column = "", 0, 0
+		if d.Severity == validate.SeverityError {
+			errs = append(errs, d.String())
+		} else {
+			warnings = append(warnings, d.String())
+		}
+	}
+	for _, w := range warnings {
+		log.Warnf("IstioOperator %s/%s: %s", req.Namespace, req.Name, w)
+	}
+	if len(errs) != 0 {
+		// The API server shows the message of the result to the user, which can span lines unlike its reason.
+		resp := admission.Denied("")
+		resp.Result.Code = http.StatusUnprocessableEntity
+		resp.Result.Reason = metav1.StatusReasonInvalid
+		resp.Result.Message = fmt.Sprintf("IstioOperator %s failed validation:\n%s", req.Name, strings.Join(errs, "\n"))
+		return resp
+	}
+	return admission.Allowed("")
+}
+
+// validate returns the problems found in the IstioOperator crJSON. Like mesh validate, the CR is checked on its own
+// first. If it has no errors, it is merged with its profile to check the values it results in with the semantic rules
+// that are not disabled in the CR, and to render its manifests.
+func (v *iopValidator) validate(ctx context.Context, crJSON string) validate.Diagnostics {
+	iops, ds := validate.CheckIstioOperatorCR(crJSON, "")
+	if ds.HasErrors() {
+		return ds
+	}
+	disabled, err := validate.DisabledRulesFromCR(crJSON)
+	if err != nil {
+		return append(ds, &validate.Diagnostic{RuleID: validate.RuleInvalidSpec, Severity: validate.SeverityError,
+			Message: err.Error()})
+	}
+	merged, err := helmreconciler.MergeIOPSWithProfile(iops)
+	if err != nil {
+		return append(ds, &validate.Diagnostic{RuleID: validate.RuleInvalidSpec, Severity: validate.SeverityError,
+			Message: fmt.Sprintf("could not merge with the profile: %s", err)})
+	}
+	ds = append(ds, validate.CheckValuesConfig(merged)...)
+	ds = append(ds, validate.CheckRules(merged, disabled, func(name string) (bool, error) {
+		return namespaceExists(ctx, v.reader, name)
+	})...)
+	if ds.HasErrors() {
+		return ds
+	}
+	if !useCachedInstallPackage(merged) {
+		return ds
+	}
+	if err := resolveAddonCharts(merged, true); err != nil {
+		log.Infof("Skipping the test render of the addon charts: %s", err)
+		return ds
+	}
+	// The manifests are not post-rendered, since the overlays apply before the post-renderer.
+	_, unmatched, err := helmreconciler.RenderManifests(merged, nil)
+	if err != nil {
+		ds = append(ds, &validate.Diagnostic{RuleID: validate.RuleRender, Severity: validate.SeverityError,
+			Message: err.Error()})
+	}
+	if len(unmatched) == 0 {
+		return ds
+	}
+	specYAML, err := util.MarshalWithJSONPB(iops)
+	if err == nil {
+		var uds validate.Diagnostics
+		uds, err = validate.UnmatchedOverlayDiagnostics(unmatched, specYAML, controllerOptions.StrictOverlays)
+		ds = append(ds, uds...)
+	}
+	if err != nil {
+		ds = append(ds, &validate.Diagnostic{RuleID: validate.RuleUnmatchedOverlay, Severity: validate.SeverityError,
+			Message: err.Error()})
+	}
+	return ds
+}
+
+// useCachedInstallPackage points the installPackagePath of iops to the package cache if it is a URL, and reports
+// whether iops can be rendered. Packages are not fetched while an admission request waits, so a URL that is not in
+// the package cache yet is only rendered when the resource is reconciled.
+func useCachedInstallPackage(iops *v1alpha1.IstioOperatorSpec) bool {
+	if !helm.IsInstallPackageURL(iops.InstallPackagePath) {
+		return true
+	}
+	uf, err := helm.NewURLFetcher(iops.InstallPackagePath, controllerOptions.PackageCacheDir)
+	if err == nil {
+		err = configureFetcher(uf)
+	}
+	if err == nil {
+		uf.SetOffline(true)
+		err = uf.FetchBundles().ToError()
+	}
+	if err != nil {
+		log.Infof("Skipping the test render of install package %s: %s", iops.InstallPackagePath, err)
+		return false
+	}
+	iops.InstallPackagePath = uf.Package().ChartsPath()
+	return true
+}
+
+// namespaceExists reports whether the namespace with the given name exists in the cluster.
+func namespaceExists(ctx context.Context, reader client.Reader, name string) (bool, error) {
+	err := reader.Get(ctx, client.ObjectKey{Name: name}, &corev1.Namespace{})
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
@@ -0,0 +1,232 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package istiocontrolplane
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestWebhookHandle(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webhook-extra-manifests")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	extraFile := filepath.Join(dir, "extra.yaml")
+	if err := ioutil.WriteFile(extraFile, []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: extra\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		desc      string
+		operation admissionv1beta1.Operation
+		crJSON    string
+		// wantDenied holds the parts of the denial message, or is nil if the request must be allowed.
+		wantDenied []string
+	}{
+		{
+			desc:   "valid",
+			crJSON: `{"apiVersion":"install.istio.io/v1alpha1","kind":"IstioOperator","spec":{"profile":"minimal"}}`,
+		},
+		{
+			desc: "unknown field and bad value",
+			crJSON: `{"apiVersion":"install.istio.io/v1alpha1","kind":"IstioOperator",` +
+				`"spec":{"hub":"docker.io/ex ample","components":{"pilot":{"enabld":true}}}}`,
+			wantDenied: []string{
+				"IstioOperator example-istiocontrolplane failed validation:",
+				"error: invalid value Hub: docker.io/ex ample [invalid-value]",
+				"error: components.pilot.enabld: unknown field, did you mean enabled? [unknown-field]",
+			},
+		},
+		{
+			desc: "rule",
+			crJSON: `{"apiVersion":"install.istio.io/v1alpha1","kind":"IstioOperator",` +
+				`"spec":{"values":{"global":{"controlPlaneSecurityEnabled":false,"mtls":{"auto":true}}}}}`,
+			wantDenied: []string{"[auto-mtls-control-plane-security]"},
+		},
+		{
+			desc: "disabled rule",
+			crJSON: `{"apiVersion":"install.istio.io/v1alpha1","kind":"IstioOperator",` +
+				`"metadata":{"annotations":{"install.istio.io/disabled-rules":"auto-mtls-control-plane-security"}},` +
+				`"spec":{"values":{"global":{"controlPlaneSecurityEnabled":false,"mtls":{"auto":true}}}}}`,
+		},
+		{
+			// The file exists, but files named in CRs must not be read from the file system of the controller.
+			desc: "extra manifest file",
+			crJSON: `{"apiVersion":"install.istio.io/v1alpha1","kind":"IstioOperator",` +
+				`"spec":{"profile":"minimal","values":{"global":{"extraManifests":[{"file":"` + extraFile + `"}]}}}}`,
+			wantDenied: []string{"values.global.extraManifests[0]: file " + extraFile + " cannot be read"},
+		},
+		{
+			desc:      "delete",
+			operation: admissionv1beta1.Delete,
+			crJSON:    `{"spec":{"hub":"docker.io/ex ample"}}`,
+		},
+	}
+	v := &iopValidator{reader: fake.NewFakeClientWithScheme(scheme.Scheme)}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			op := tt.operation
+			if op == "" {
+				op = admissionv1beta1.Create
+			}
+			resp := v.Handle(context.TODO(), admission.Request{AdmissionRequest: admissionv1beta1.AdmissionRequest{
+				Name:      "example-istiocontrolplane",
+				Namespace: "istio-system",
+				Operation: op,
+				Object:    runtime.RawExtension{Raw: []byte(tt.crJSON)},
+			}})
+			if gotDenied := !resp.Allowed; gotDenied != (tt.wantDenied != nil) {
+				t.Fatalf("got allowed %v with result %v, want allowed %v", resp.Allowed, resp.Result, tt.wantDenied == nil)
+			}
+			for _, want := range tt.wantDenied {
+				if !strings.Contains(resp.Result.Message, want) {
+					t.Errorf("got message:\n%s\nwant it to contain %q", resp.Result.Message, want)
+				}
+			}
+		})
+	}
+}
+
+func TestWebhookCert(t *testing.T) {
+	now := time.Now()
+	c, err := newWebhookCert(webhookDNSNames("istio-operator"), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.verify("istio-operator.istio-operator.svc", now); err != nil {
+		t.Errorf("got error %v for the service name, want none", err)
+	}
+	if err := c.verify("istio-operator.other.svc", now); err == nil {
+		t.Error("got no error for another name, want one")
+	}
+	if err := c.verify("istio-operator.istio-operator.svc", now.Add(webhookCertValidity-webhookCertRenewBefore/2)); err == nil {
+		t.Error("got no error for a certificate about to expire, want one")
+	}
+	other, err := newWebhookCert(webhookDNSNames("istio-operator"), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.caCert = other.caCert
+	if err := c.verify("istio-operator.istio-operator.svc", now); err == nil {
+		t.Error("got no error for a certificate of another CA, want one")
+	}
+
+	dir, err := ioutil.TempDir("", "webhook-cert")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := other.writeTo(filepath.Join(dir, "certs")); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := ioutil.ReadFile(filepath.Join(dir, "certs", "tls.key")); err != nil || !bytes.Equal(got, other.key) {
+		t.Errorf("got key %s and error %v, want the key of the certificate", got, err)
+	}
+}
+
+func TestEnsureWebhookCert(t *testing.T) {
+	cl := fake.NewFakeClientWithScheme(scheme.Scheme)
+	first, err := ensureWebhookCert(context.TODO(), cl, cl, "istio-operator")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := ensureWebhookCert(context.TODO(), cl, cl, "istio-operator")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(first.cert, second.cert) || !bytes.Equal(first.caCert, second.caCert) {
+		t.Error("got a new certificate, want the stored one to be reused")
+	}
+
+	// A certificate that is not valid anymore is replaced.
+	key := types.NamespacedName{Namespace: "istio-operator", Name: webhookCertSecretName}
+	secret := &corev1.Secret{}
+	if err := cl.Get(context.TODO(), key, secret); err != nil {
+		t.Fatal(err)
+	}
+	secret.Data[corev1.TLSCertKey] = []byte("garbage")
+	if err := cl.Update(context.TODO(), secret); err != nil {
+		t.Fatal(err)
+	}
+	third, err := ensureWebhookCert(context.TODO(), cl, cl, "istio-operator")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(third.caCert, first.caCert) {
+		t.Error("got the invalid certificate, want a new one")
+	}
+	if err := cl.Get(context.TODO(), key, secret); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(secret.Data[corev1.TLSCertKey], third.cert) {
+		t.Error("the new certificate was not stored")
+	}
+}
+
+func TestPatchWebhookCABundle(t *testing.T) {
+	svc := types.NamespacedName{Namespace: "istio-operator", Name: webhookServiceName}
+	config := &admissionregistrationv1beta1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "istio-operator"},
+		Webhooks: []admissionregistrationv1beta1.ValidatingWebhook{
+			{
+				Name: "validation.install.istio.io",
+				ClientConfig: admissionregistrationv1beta1.WebhookClientConfig{
+					Service: &admissionregistrationv1beta1.ServiceReference{Namespace: svc.Namespace, Name: svc.Name},
+				},
+			},
+			{
+				Name: "other.example.com",
+				ClientConfig: admissionregistrationv1beta1.WebhookClientConfig{
+					Service: &admissionregistrationv1beta1.ServiceReference{Namespace: "other", Name: svc.Name},
+				},
+			},
+		},
+	}
+	cl := fake.NewFakeClientWithScheme(scheme.Scheme, config)
+	if err := patchWebhookCABundle(context.TODO(), cl, cl, "istio-operator", svc, []byte("ca")); err != nil {
+		t.Fatal(err)
+	}
+	got := &admissionregistrationv1beta1.ValidatingWebhookConfiguration{}
+	if err := cl.Get(context.TODO(), client.ObjectKey{Name: "istio-operator"}, got); err != nil {
+		t.Fatal(err)
+	}
+	if string(got.Webhooks[0].ClientConfig.CABundle) != "ca" || got.Webhooks[1].ClientConfig.CABundle != nil {
+		t.Errorf("got CA bundles %q and %q, want only the operator webhook to be patched", got.Webhooks[0].ClientConfig.CABundle,
+			got.Webhooks[1].ClientConfig.CABundle)
+	}
+
+	if err := patchWebhookCABundle(context.TODO(), cl, cl, "missing", svc, []byte("ca")); err != nil {
+		t.Errorf("got error %v for a missing configuration, want none", err)
+	}
+}
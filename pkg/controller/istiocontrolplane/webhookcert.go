@@ -0,0 +1,228 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package istiocontrolplane
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"istio.io/pkg/log"
+)
+
+const (
+	// webhookCertSecretName is the name of the Secret in the operator namespace that holds the serving certificate of
+	// the webhook, so that all the replicas and restarts of the operator use the same one.
+	webhookCertSecretName = "istio-operator-webhook-cert"
+	// webhookCertValidity is how long generated certificates are valid for.
+	webhookCertValidity = 10 * 365 * 24 * time.Hour
+	// webhookCertRenewBefore is how long before it expires a certificate is replaced at startup.
+	webhookCertRenewBefore = 30 * 24 * time.Hour
+	// caCertKey is the Secret key of the CA certificate.
+	caCertKey = "ca.crt"
+)
+
+// webhookCert is a serving certificate of the webhook, its key and the certificate of the CA that signed it, all PEM
+// encoded.
+type webhookCert struct {
+	caCert []byte
+	cert   []byte
+	key    []byte
+}
+
+// webhookDNSNames returns the names the webhook Service in namespace is called by.
+func webhookDNSNames(namespace string) []string {
+	svc := webhookServiceName + "." + namespace
+	return []string{webhookServiceName, svc, svc + ".svc", svc + ".svc.cluster.local"}
+}
+
+// newWebhookCert generates a self-signed CA and a serving certificate signed by it for dnsNames, valid from now.
+func newWebhookCert(dnsNames []string, now time.Time) (*webhookCert, error) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	caTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "istio-operator-webhook-ca"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(webhookCertValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTmpl, caTmpl, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: dnsNames[len(dnsNames)-1]},
+		DNSNames:     dnsNames,
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(webhookCertValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, caTmpl, &key.PublicKey, caKey)
+	if err != nil {
+		return nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return &webhookCert{
+		caCert: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}),
+		cert:   pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		key:    pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}, nil
+}
+
+// verify checks that c is a valid serving certificate for dnsName, signed by its CA, that does not expire within
+// webhookCertRenewBefore of now.
+func (c *webhookCert) verify(dnsName string, now time.Time) error {
+	if _, err := tls.X509KeyPair(c.cert, c.key); err != nil {
+		return err
+	}
+	block, _ := pem.Decode(c.cert)
+	if block == nil {
+		return fmt.Errorf("no PEM certificate found")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return err
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(c.caCert) {
+		return fmt.Errorf("no PEM CA certificate found")
+	}
+	if _, err := cert.Verify(x509.VerifyOptions{DNSName: dnsName, Roots: roots, CurrentTime: now}); err != nil {
+		return err
+	}
+	if now.Add(webhookCertRenewBefore).After(cert.NotAfter) {
+		return fmt.Errorf("certificate expires at %s", cert.NotAfter)
+	}
+	return nil
+}
+
+// writeTo writes the certificate and key of c to dir, with the file names the webhook server reads them from.
+func (c *webhookCert) writeTo(dir string) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, corev1.TLSCertKey), c.cert, 0600); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, corev1.TLSPrivateKeyKey), c.key, 0600)
+}
+
+// ensureWebhookCert returns the webhook serving certificate kept in the webhookCertSecretName Secret in namespace.
+// A new certificate is generated and stored if the Secret does not exist or its certificate is not valid anymore.
+func ensureWebhookCert(ctx context.Context, reader client.Reader, cl client.Client, namespace string) (*webhookCert, error) {
+	key := types.NamespacedName{Namespace: namespace, Name: webhookCertSecretName}
+	dnsNames := webhookDNSNames(namespace)
+	secret := &corev1.Secret{}
+	err := reader.Get(ctx, key, secret)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get webhook certificate Secret %s: %s", key, err)
+	}
+	found := err == nil
+	if found {
+		c := &webhookCert{caCert: secret.Data[caCertKey], cert: secret.Data[corev1.TLSCertKey], key: secret.Data[corev1.TLSPrivateKeyKey]}
+		err := c.verify(dnsNames[len(dnsNames)-2], time.Now())
+		if err == nil {
+			return c, nil
+		}
+		log.Infof("Replacing the webhook certificate in Secret %s: %s", key, err)
+	}
+
+	c, err := newWebhookCert(dnsNames, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate webhook certificate: %s", err)
+	}
+	secret.Type = corev1.SecretTypeTLS
+	secret.Data = map[string][]byte{caCertKey: c.caCert, corev1.TLSCertKey: c.cert, corev1.TLSPrivateKeyKey: c.key}
+	if found {
+		err = cl.Update(ctx, secret)
+	} else {
+		secret.ObjectMeta = metav1.ObjectMeta{Namespace: namespace, Name: webhookCertSecretName}
+		err = cl.Create(ctx, secret)
+	}
+	if apierrors.IsAlreadyExists(err) || apierrors.IsConflict(err) {
+		// Another replica stored its certificate first, which is used instead.
+		return ensureWebhookCert(ctx, reader, cl, namespace)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to store webhook certificate in Secret %s: %s", key, err)
+	}
+	log.Infof("Stored a new webhook certificate in Secret %s", key)
+	return c, nil
+}
+
+// patchWebhookCABundle sets caBundle as the CA bundle of the webhooks of the ValidatingWebhookConfiguration configName
+// that call the Service svc. It does nothing if the configuration does not exist, which means that the webhook is not
+// registered.
+func patchWebhookCABundle(ctx context.Context, reader client.Reader, cl client.Client, configName string, svc types.NamespacedName,
+	caBundle []byte) error {
+	config := &admissionregistrationv1beta1.ValidatingWebhookConfiguration{}
+	if err := reader.Get(ctx, client.ObjectKey{Name: configName}, config); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Warnf("ValidatingWebhookConfiguration %s not found, IstioOperator resources are not validated on admission", configName)
+			return nil
+		}
+		return fmt.Errorf("failed to get ValidatingWebhookConfiguration %s: %s", configName, err)
+	}
+	changed := false
+	for i := range config.Webhooks {
+		s := config.Webhooks[i].ClientConfig.Service
+		if s == nil || s.Namespace != svc.Namespace || s.Name != svc.Name || bytes.Equal(config.Webhooks[i].ClientConfig.CABundle, caBundle) {
+			continue
+		}
+		config.Webhooks[i].ClientConfig.CABundle = caBundle
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	if err := cl.Update(ctx, config); err != nil {
+		return fmt.Errorf("failed to update the CA bundle of ValidatingWebhookConfiguration %s: %s", configName, err)
+	}
+	return nil
+}
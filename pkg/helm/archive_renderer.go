@@ -0,0 +1,104 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"sync"
+
+	"k8s.io/helm/pkg/chartutil"
+	"k8s.io/helm/pkg/proto/hapi/chart"
+
+	"istio.io/pkg/log"
+)
+
+var (
+	// chartCache holds the charts loaded from archives, keyed by the SHA256 digest of the archive.
+	chartCache   = make(map[string]*chart.Chart)
+	chartCacheMu sync.Mutex
+)
+
+// ArchiveRenderer is a helm template renderer for a chart archive, a .tgz file on the local filesystem. The chart is
+// loaded straight from the archive, without unpacking it. Archives at URLs are fetched with FetchChart instead.
+type ArchiveRenderer struct {
+	namespace     string
+	componentName string
+	archive       string
+	chart         *chart.Chart
+	started       bool
+}
+
+// NewArchiveRenderer creates an ArchiveRenderer with the given chart archive path, component name and namespace.
+func NewArchiveRenderer(archive, componentName, namespace string) *ArchiveRenderer {
+	log.Infof("NewArchiveRenderer with chart=%s, componentName=%s", archive, componentName)
+	return &ArchiveRenderer{
+		namespace:     namespace,
+		componentName: componentName,
+		archive:       archive,
+	}
+}
+
+// Run implements the TemplateRenderer interface.
+func (h *ArchiveRenderer) Run() error {
+	log.Infof("Run ArchiveRenderer with chart=%s, componentName=%s", h.archive, h.componentName)
+	data, err := ioutil.ReadFile(h.archive)
+	if err != nil {
+		return fmt.Errorf("failed to read chart %s: %s", h.archive, err)
+	}
+	if h.chart, err = loadChartArchive(data); err != nil {
+		return fmt.Errorf("failed to load chart %s: %s", h.archive, err)
+	}
+	h.started = true
+	return nil
+}
+
+// RenderManifest renders the chart with the given values and returns the resulting YAML manifest string.
+func (h *ArchiveRenderer) RenderManifest(values string) (string, error) {
+	if !h.started {
+		return "", fmt.Errorf("archiveRenderer for %s not started in renderChart", h.componentName)
+	}
+	return renderChart(h.namespace, values, h.chart)
+}
+
+// loadChartArchive returns the chart in the archive data. A chart is only loaded once for each archive digest, and is
+// shared by all the renderers of archives with that digest.
+func loadChartArchive(data []byte) (*chart.Chart, error) {
+	sum := sha256.Sum256(data)
+	digest := hex.EncodeToString(sum[:])
+	if c := cachedChart(digest); c != nil {
+		log.Debugf("Using cached chart %s with digest %s", c.Metadata.GetName(), digest)
+		return c, nil
+	}
+	c, err := chartutil.LoadArchive(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	chartCacheMu.Lock()
+	defer chartCacheMu.Unlock()
+	chartCache[digest] = c
+	return c, nil
+}
+
+// cachedChart returns the chart loaded from the archive with the given SHA256 digest, or nil if no such archive was
+// loaded.
+func cachedChart(digest string) *chart.Chart {
+	chartCacheMu.Lock()
+	defer chartCacheMu.Unlock()
+	return chartCache[digest]
+}
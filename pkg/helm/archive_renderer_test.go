@@ -0,0 +1,130 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// chartArchive returns a chart archive of the chart name with the given version, which renders a ConfigMap holding
+// greeting, and its SHA256 digest.
+func chartArchive(t *testing.T, name, version, greeting string) ([]byte, string) {
+	files := map[string]string{
+		"Chart.yaml":  fmt.Sprintf("apiVersion: v1\nname: %s\nversion: %s\n", name, version),
+		"values.yaml": fmt.Sprintf("greeting: %s\n", greeting),
+		"templates/configmap.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Chart.Name }}
+  namespace: {{ .Release.Namespace }}
+data:
+  greeting: {{ .Values.greeting }}
+`,
+	}
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	for _, f := range []string{"Chart.yaml", "values.yaml", "templates/configmap.yaml"} {
+		hdr := &tar.Header{Name: name + "/" + f, Mode: 0644, Size: int64(len(files[f])), ModTime: time.Unix(0, 0)}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(files[f])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256(buf.Bytes())
+	return buf.Bytes(), hex.EncodeToString(sum[:])
+}
+
+func TestArchiveRenderer(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "chart-archives")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	data, _ := chartArchive(t, "greeter", "1.0.0", "archive-hello")
+	archive := filepath.Join(tmp, "greeter-1.0.0.tgz")
+	if err := ioutil.WriteFile(archive, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := NewChartRenderer(archive, "greeter", "istio-system")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := r.(*ArchiveRenderer); !ok {
+		t.Fatalf("got renderer %T, want *ArchiveRenderer", r)
+	}
+	if err := r.Run(); err != nil {
+		t.Fatal(err)
+	}
+	got, err := r.RenderManifest("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"name: greeter", "namespace: istio-system", "greeting: archive-hello"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("got manifest:\n%s\nwant it to contain %q", got, want)
+		}
+	}
+
+	if err := NewArchiveRenderer(filepath.Join(tmp, "missing-1.0.0.tgz"), "greeter", "istio-system").Run(); err == nil {
+		t.Error("got no error for a missing archive, want one")
+	}
+}
+
+func TestNewChartRenderer(t *testing.T) {
+	for ref, want := range map[string]string{
+		"charts/greeter":                          "*helm.FileTemplateRenderer",
+		"charts/greeter-1.0.0.tgz":                "*helm.ArchiveRenderer",
+		"https://example.com/greeter-1.0.0.tgz":   "",
+		"https://example.com/charts#greeter@1.0":  "",
+		"file:///charts/index.yaml#greeter":       "",
+		"https://example.com/charts/greeter.json": "",
+	} {
+		r, err := NewChartRenderer(ref, "greeter", "istio-system")
+		if want == "" {
+			if err == nil {
+				t.Errorf("%s: got renderer %T, want an error", ref, r)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %s", ref, err)
+			continue
+		}
+		if got := fmt.Sprintf("%T", r); got != want {
+			t.Errorf("%s: got renderer %s, want %s", ref, got, want)
+		}
+	}
+}
@@ -0,0 +1,476 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mholt/archiver"
+
+	"istio.io/pkg/log"
+)
+
+const (
+	// PackageCacheDirEnvVar is the environment variable that overrides the default install package cache directory.
+	PackageCacheDirEnvVar = "ISTIO_INSTALL_PACKAGE_CACHE"
+
+	// cacheLayoutVersion is the version of the on-disk layout of the package cache. It must be bumped whenever the
+	// layout changes in an incompatible way, so that old and new layouts can coexist under the same root.
+	cacheLayoutVersion = "v2"
+	// packagesDirName is the directory under the versioned cache root holding one directory per package digest.
+	packagesDirName = "packages"
+	// downloadsDirName is the staging directory for packages being downloaded, before they are verified.
+	downloadsDirName = "downloads"
+	// stagingDirName is the directory under the versioned cache root where entries are assembled before they are
+	// renamed into packagesDirName, so that an interrupted Add never leaves a partial entry behind.
+	stagingDirName = "staging"
+	// contentsDirName is the directory inside a package entry holding the unpacked package.
+	contentsDirName = "contents"
+	// metadataFileName is the file inside a package entry holding its CachedPackage metadata.
+	metadataFileName = "package.json"
+)
+
+// CachedPackage describes an install package stored in a PackageCache.
+type CachedPackage struct {
+	// Name is the file name of the package tarball, e.g. istio-1.5.0-linux.tar.gz.
+	Name string `json:"name"`
+	// URL is the URL the package was downloaded from. It is empty for imported packages.
+	URL string `json:"url,omitempty"`
+	// Digest is the hex encoded SHA256 of the package tarball.
+	Digest string `json:"digest"`
+	// Added is the time the package was added to the cache.
+	Added time.Time `json:"added"`
+	// Signer is the identity of the key that signed the package, if its signature was verified.
+	Signer string `json:"signer,omitempty"`
+	// ContentsDigest is the hex encoded SHA256 of the unpacked package tree, see treeSHA256.
+	ContentsDigest string `json:"contentsDigest"`
+
+	// Path is the path of the package tarball in the cache.
+	Path string `json:"-"`
+	// Dir is the path of the root directory of the unpacked package in the cache.
+	Dir string `json:"-"`
+}
+
+// ChartsPath returns the path of the charts directory of the unpacked package.
+func (cp *CachedPackage) ChartsPath() string {
+	return filepath.Join(cp.Dir, ChartsFilePath)
+}
+
+// PackageCache is a content-addressed store of install packages. Each package is stored under the SHA256 digest of
+// its tarball. The tarball and the unpacked package are verified again every time the package is looked up.
+type PackageCache struct {
+	// root is the root directory of the cache.
+	root string
+}
+
+// DefaultPackageCacheDir returns the install package cache directory set through PackageCacheDirEnvVar, or
+// a directory under the system temp dir if it is not set.
+func DefaultPackageCacheDir() string {
+	if dir := os.Getenv(PackageCacheDirEnvVar); dir != "" {
+		return dir
+	}
+	return filepath.Join(os.TempDir(), InstallationDirectory)
+}
+
+// NewPackageCache creates a PackageCache rooted at root, or at DefaultPackageCacheDir if root is empty, and returns
+// a pointer to it.
+func NewPackageCache(root string) (*PackageCache, error) {
+	if root == "" {
+		root = DefaultPackageCacheDir()
+	}
+	c := &PackageCache{root: root}
+	for _, d := range []string{c.packagesDir(), c.DownloadsDir(), c.stagingDir()} {
+		if err := os.MkdirAll(d, os.ModeDir|os.ModePerm); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// Root returns the root directory of the cache.
+func (c *PackageCache) Root() string {
+	return c.root
+}
+
+// DownloadsDir returns the staging directory for packages that have not been verified yet.
+func (c *PackageCache) DownloadsDir() string {
+	return filepath.Join(c.root, cacheLayoutVersion, downloadsDirName)
+}
+
+func (c *PackageCache) packagesDir() string {
+	return filepath.Join(c.root, cacheLayoutVersion, packagesDirName)
+}
+
+func (c *PackageCache) stagingDir() string {
+	return filepath.Join(c.root, cacheLayoutVersion, stagingDirName)
+}
+
+func (c *PackageCache) entryDir(digest string) string {
+	return filepath.Join(c.packagesDir(), digest)
+}
+
+// Add moves the package tarball at path into the cache, unpacks it and returns the resulting cache entry.
+// url records where the package came from and may be empty. The entry is assembled in a staging directory and renamed
+// into place, so it either appears complete or not at all.
+func (c *PackageCache) Add(path, url string) (*CachedPackage, error) {
+	digest, err := fileSHA256(path)
+	if err != nil {
+		return nil, err
+	}
+	staged, err := ioutil.TempDir(c.stagingDir(), digest+"-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(staged)
+	name := filepath.Base(path)
+	if err := moveFile(path, filepath.Join(staged, name)); err != nil {
+		return nil, err
+	}
+	cp := &CachedPackage{
+		Name:   name,
+		URL:    url,
+		Digest: digest,
+		Added:  time.Now().UTC(),
+	}
+	if err := unpackTo(filepath.Join(staged, name), filepath.Join(staged, contentsDirName)); err != nil {
+		return nil, err
+	}
+	if cp.ContentsDigest, err = treeSHA256(filepath.Join(staged, contentsDirName)); err != nil {
+		return nil, err
+	}
+	if err := writeMetadata(staged, cp); err != nil {
+		return nil, err
+	}
+	ed := c.entryDir(digest)
+	if err := os.RemoveAll(ed); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(staged, ed); err != nil {
+		return nil, err
+	}
+	cp.Path = filepath.Join(ed, name)
+	cp.Dir = packageRoot(filepath.Join(ed, contentsDirName))
+	return cp, nil
+}
+
+// SignaturePath returns the path of the detached signature of a cached package. The file only exists for packages
+// with a verified signature.
+func (c *PackageCache) SignaturePath(cp *CachedPackage) string {
+	return filepath.Join(c.entryDir(cp.Digest), cp.Name+SignatureFileSuffix)
+}
+
+// SetSignature copies the verified detached signature at sigPath into the entry of a cached package and records
+// signer as the package signer.
+func (c *PackageCache) SetSignature(cp *CachedPackage, sigPath, signer string) error {
+	if err := copyFile(sigPath, c.SignaturePath(cp)); err != nil {
+		return err
+	}
+	cp.Signer = signer
+	return writeMetadata(c.entryDir(cp.Digest), cp)
+}
+
+// Import copies the package tarball at path into the cache and returns the resulting cache entry. If shaF is set,
+// the tarball is verified against that SHA file first.
+func (c *PackageCache) Import(path, shaF string) (*CachedPackage, error) {
+	if shaF != "" {
+		if err := verifySHAFile(path, shaF); err != nil {
+			return nil, err
+		}
+	}
+	staged := filepath.Join(c.DownloadsDir(), filepath.Base(path))
+	if err := copyFile(path, staged); err != nil {
+		return nil, err
+	}
+	return c.Add(staged, "")
+}
+
+// Lookup returns the newest cache entry for the package tarball with the given name, or nil if there is none.
+// The tarball digest is verified on every lookup; entries that fail verification are removed from the cache and an
+// error is returned.
+func (c *PackageCache) Lookup(name string) (*CachedPackage, error) {
+	pkgs, err := c.List()
+	if err != nil {
+		return nil, err
+	}
+	var found *CachedPackage
+	for _, cp := range pkgs {
+		if cp.Name == name && (found == nil || cp.Added.After(found.Added)) {
+			found = cp
+		}
+	}
+	if found == nil {
+		return nil, nil
+	}
+	if err := c.Verify(found); err != nil {
+		if rerr := c.Remove(found); rerr != nil {
+			log.Warnf("failed to remove corrupted package %s from cache: %s", found.Path, rerr)
+		}
+		return nil, err
+	}
+	return found, nil
+}
+
+// Verify checks that the tarball of a cached package and its unpacked contents still match their digests.
+func (c *PackageCache) Verify(cp *CachedPackage) error {
+	digest, err := fileSHA256(cp.Path)
+	if err != nil {
+		return fmt.Errorf("failed to verify cached package %s: %s", cp.Name, err)
+	}
+	if !strings.EqualFold(digest, cp.Digest) {
+		return fmt.Errorf("checksum of cached package %s is %s, expected %s", cp.Path, digest, cp.Digest)
+	}
+	contents := filepath.Join(c.entryDir(cp.Digest), contentsDirName)
+	digest, err = treeSHA256(contents)
+	if err != nil {
+		return fmt.Errorf("failed to verify unpacked contents of cached package %s: %s", cp.Name, err)
+	}
+	if !strings.EqualFold(digest, cp.ContentsDigest) {
+		return fmt.Errorf("checksum of the unpacked contents of cached package %s is %s, expected %s", contents,
+			digest, cp.ContentsDigest)
+	}
+	return nil
+}
+
+// List returns all cache entries, sorted by name and then by the time they were added.
+func (c *PackageCache) List() ([]*CachedPackage, error) {
+	out, bad, err := c.entries()
+	for _, b := range bad {
+		log.Warnf("skipping bad package cache entry %s", b)
+	}
+	return out, err
+}
+
+// entries returns all cache entries, sorted by name and then by the time they were added, and the paths of entry
+// directories without readable metadata.
+func (c *PackageCache) entries() ([]*CachedPackage, []string, error) {
+	fis, err := ioutil.ReadDir(c.packagesDir())
+	if err != nil {
+		return nil, nil, err
+	}
+	var out []*CachedPackage
+	var bad []string
+	for _, fi := range fis {
+		cp, err := c.readMetadata(fi.Name())
+		if err != nil {
+			bad = append(bad, filepath.Join(c.packagesDir(), fi.Name()))
+			continue
+		}
+		out = append(out, cp)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Name != out[j].Name {
+			return out[i].Name < out[j].Name
+		}
+		return out[i].Added.Before(out[j].Added)
+	})
+	return out, bad, nil
+}
+
+// Remove deletes a package from the cache.
+func (c *PackageCache) Remove(cp *CachedPackage) error {
+	return os.RemoveAll(c.entryDir(cp.Digest))
+}
+
+// PruneResult lists what Prune removed, or would remove in a dry run.
+type PruneResult struct {
+	// Packages are the removed cache entries.
+	Packages []*CachedPackage
+	// Leftovers are the paths of removed entries without readable metadata, e.g. from an interrupted Add by an older
+	// version, and of leftover downloads and staging directories.
+	Leftovers []string
+}
+
+// Prune removes all entries that fail verification or are superseded by a newer entry with the same name, along
+// with any incomplete entries and leftover downloads. If all is set, every entry is removed. If dryRun is set,
+// nothing is removed. It returns what was, or would be, removed.
+func (c *PackageCache) Prune(all, dryRun bool) (*PruneResult, error) {
+	pkgs, bad, err := c.entries()
+	if err != nil {
+		return nil, err
+	}
+	newest := make(map[string]*CachedPackage)
+	for _, cp := range pkgs {
+		if c.Verify(cp) == nil {
+			// entries is sorted by time added, so the last valid entry for each name wins.
+			newest[cp.Name] = cp
+		}
+	}
+	out := &PruneResult{Leftovers: bad}
+	for _, cp := range pkgs {
+		if all || newest[cp.Name] != cp {
+			out.Packages = append(out.Packages, cp)
+		}
+	}
+	for _, d := range []string{c.DownloadsDir(), c.stagingDir()} {
+		fis, err := ioutil.ReadDir(d)
+		if err != nil {
+			return nil, err
+		}
+		for _, fi := range fis {
+			out.Leftovers = append(out.Leftovers, filepath.Join(d, fi.Name()))
+		}
+	}
+	if dryRun {
+		return out, nil
+	}
+	for _, cp := range out.Packages {
+		if err := c.Remove(cp); err != nil {
+			return out, err
+		}
+	}
+	for _, p := range out.Leftovers {
+		if err := os.RemoveAll(p); err != nil {
+			return out, err
+		}
+	}
+	return out, nil
+}
+
+// unpackTo extracts the package tarball at path into dir.
+func unpackTo(path, dir string) error {
+	targz := archiver.TarGz{Tar: &archiver.Tar{OverwriteExisting: true}}
+	if err := targz.Unarchive(path, dir); err != nil {
+		return fmt.Errorf("failed to unpack %s: %s", path, err)
+	}
+	return nil
+}
+
+// treeSHA256 returns the hex encoded SHA256 digest of the tree rooted at dir, computed over the relative path, type
+// and content digest of every entry in lexical order.
+func treeSHA256(dir string) (string, error) {
+	h := sha256.New()
+	err := filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		var sum string
+		switch {
+		case fi.IsDir():
+			sum = "dir"
+		case fi.Mode()&os.ModeSymlink != 0:
+			target, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			sum = "symlink " + target
+		default:
+			if sum, err = fileSHA256(path); err != nil {
+				return err
+			}
+		}
+		_, err = fmt.Fprintf(h, "%s\x00%s\n", filepath.ToSlash(rel), sum)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// writeMetadata writes the metadata of cp into the entry directory dir, replacing any existing metadata atomically.
+func writeMetadata(dir string, cp *CachedPackage) error {
+	b, err := json.MarshalIndent(cp, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(dir, metadataFileName+".tmp")
+	if err := ioutil.WriteFile(tmp, b, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(dir, metadataFileName))
+}
+
+func (c *PackageCache) readMetadata(digest string) (*CachedPackage, error) {
+	ed := c.entryDir(digest)
+	b, err := ioutil.ReadFile(filepath.Join(ed, metadataFileName))
+	if err != nil {
+		return nil, err
+	}
+	cp := &CachedPackage{}
+	if err := json.Unmarshal(b, cp); err != nil {
+		return nil, err
+	}
+	if cp.Digest != digest {
+		return nil, fmt.Errorf("metadata digest %s does not match entry %s", cp.Digest, digest)
+	}
+	cp.Path = filepath.Join(ed, cp.Name)
+	cp.Dir = packageRoot(filepath.Join(ed, contentsDirName))
+	return cp, nil
+}
+
+// packageRoot returns the single top level directory under dir if there is one, otherwise dir.
+func packageRoot(dir string) string {
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil || len(fis) != 1 || !fis[0].IsDir() {
+		return dir
+	}
+	return filepath.Join(dir, fis[0].Name())
+}
+
+// fileSHA256 returns the hex encoded SHA256 digest of the file at path.
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// moveFile moves src to dst, falling back to a copy if they are on different file systems.
+func moveFile(src, dst string) error {
+	if err := os.Rename(src, dst); err == nil {
+		return nil
+	}
+	if err := copyFile(src, dst); err != nil {
+		return err
+	}
+	return os.Remove(src)
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,261 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const (
+	testPackageName = "istio-installer-1.3.0.tar.gz"
+)
+
+func TestPackageCache(t *testing.T) {
+	tmp, err := ioutil.TempDir("", InstallationDirectory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	c, err := NewPackageCache(tmp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := c.Lookup(testPackageName); err != nil || got != nil {
+		t.Fatalf("Lookup on empty cache: got %v, %v, want nil, nil", got, err)
+	}
+
+	tarball := filepath.Join("testdata", testPackageName)
+	cp, err := c.Import(tarball, tarball+SHAFileSuffix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := filepath.Base(cp.Dir), "istio-installer"; got != want {
+		t.Errorf("got package dir %s, want %s", got, want)
+	}
+	if _, err := os.Stat(filepath.Join(cp.Dir, "Chart.yaml")); err != nil {
+		t.Errorf("unpacked package is missing Chart.yaml: %s", err)
+	}
+
+	got, err := c.Lookup(testPackageName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Digest != cp.Digest || got.Path != cp.Path || got.Dir != cp.Dir {
+		t.Errorf("Lookup: got %+v, want %+v", got, cp)
+	}
+
+	// A tampered tarball must be detected and evicted on the next lookup.
+	if err := ioutil.WriteFile(cp.Path, []byte("tampered"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Lookup(testPackageName); err == nil {
+		t.Errorf("Lookup of tampered package: got no error")
+	}
+	if pkgs, err := c.List(); err != nil || len(pkgs) != 0 {
+		t.Errorf("List after eviction: got %v, %v, want empty", pkgs, err)
+	}
+}
+
+func TestPackageCacheImportBadSHA(t *testing.T) {
+	tmp, err := ioutil.TempDir("", InstallationDirectory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	c, err := NewPackageCache(tmp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shaF := filepath.Join(tmp, "bad"+SHAFileSuffix)
+	if err := ioutil.WriteFile(shaF, []byte("0123 "+testPackageName), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Import(filepath.Join("testdata", testPackageName), shaF); err == nil {
+		t.Errorf("Import with bad SHA file: got no error")
+	}
+}
+
+func TestPackageCachePrune(t *testing.T) {
+	tmp, err := ioutil.TempDir("", InstallationDirectory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	c, err := NewPackageCache(tmp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Import(filepath.Join("testdata", testPackageName), ""); err != nil {
+		t.Fatal(err)
+	}
+	// An entry without metadata, as left behind by an interrupted write, must be pruned.
+	partial := filepath.Join(c.packagesDir(), "partial")
+	if err := os.MkdirAll(filepath.Join(partial, contentsDirName), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		desc          string
+		all           bool
+		dryRun        bool
+		wantPackages  int
+		wantLeftovers int
+		wantRemaining int
+	}{
+		{
+			desc:          "dry run",
+			dryRun:        true,
+			wantLeftovers: 1,
+			wantRemaining: 2,
+		},
+		{
+			desc:          "stale",
+			wantLeftovers: 1,
+			wantRemaining: 1,
+		},
+		{
+			desc:          "all dry run",
+			all:           true,
+			dryRun:        true,
+			wantPackages:  1,
+			wantRemaining: 1,
+		},
+		{
+			desc:         "all",
+			all:          true,
+			wantPackages: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := c.Prune(tt.all, tt.dryRun)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got.Packages) != tt.wantPackages || len(got.Leftovers) != tt.wantLeftovers {
+				t.Errorf("Prune: got %d packages and leftovers %v, want %d packages and %d leftovers",
+					len(got.Packages), got.Leftovers, tt.wantPackages, tt.wantLeftovers)
+			}
+			fis, err := ioutil.ReadDir(c.packagesDir())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(fis) != tt.wantRemaining {
+				t.Errorf("Prune: got %d remaining entries, want %d", len(fis), tt.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestPackageCacheVerifyContents(t *testing.T) {
+	tmp, err := ioutil.TempDir("", InstallationDirectory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	c, err := NewPackageCache(tmp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cp, err := c.Import(filepath.Join("testdata", testPackageName), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Verify(cp); err != nil {
+		t.Fatalf("Verify: %s", err)
+	}
+	// A tampered unpacked file must be detected even though the tarball is intact.
+	if err := ioutil.WriteFile(filepath.Join(cp.Dir, "Chart.yaml"), []byte("tampered"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Verify(cp); err == nil {
+		t.Errorf("Verify of tampered contents: got no error")
+	}
+	if _, err := c.Lookup(testPackageName); err == nil {
+		t.Errorf("Lookup of tampered contents: got no error")
+	}
+}
+
+func TestURLFetcherOffline(t *testing.T) {
+	tmp, err := ioutil.TempDir("", InstallationDirectory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	// The URL is never accessed in offline mode.
+	uf, err := NewURLFetcher("http://unreachable.invalid/"+testPackageName, tmp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uf.SetOffline(true)
+	uf.SetInsecureSkipVerify(true)
+	if errs := uf.FetchBundles(); len(errs) == 0 {
+		t.Fatalf("FetchBundles in offline mode with empty cache: got no error")
+	}
+
+	c, err := NewPackageCache(tmp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cp, err := c.Import(filepath.Join("testdata", testPackageName), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if errs := uf.FetchBundles(); len(errs) != 0 {
+		t.Fatalf("FetchBundles in offline mode with seeded cache: %s", errs)
+	}
+	if got := uf.Package(); got == nil || got.Digest != cp.Digest {
+		t.Errorf("got package %v, want digest %s", got, cp.Digest)
+	}
+}
+
+func TestURLFetcherFileURL(t *testing.T) {
+	tmp, err := ioutil.TempDir("", InstallationDirectory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	tarball, err := filepath.Abs(filepath.Join("testdata", testPackageName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	uf, err := NewURLFetcher("file://"+tarball, tmp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uf.SetInsecureSkipVerify(true)
+	if err := uf.FetchBundles().ToError(); err != nil {
+		t.Fatal(err)
+	}
+	digest, err := fileSHA256(tarball)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := uf.Package(); got == nil || got.Digest != digest {
+		t.Errorf("got package %v, want digest %s", got, digest)
+	}
+	if _, err := os.Stat(tarball); err != nil {
+		t.Errorf("source package was moved: %s", err)
+	}
+}
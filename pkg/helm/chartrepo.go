@@ -0,0 +1,133 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"k8s.io/helm/pkg/repo"
+
+	"istio.io/operator/pkg/httprequest"
+	"istio.io/operator/pkg/util"
+)
+
+const (
+	// repoIndexFileName is the name of the index file of a chart repository.
+	repoIndexFileName = "index.yaml"
+)
+
+// IsChartRepoRef reports whether ref is a reference to a chart in a chart repository, an HTTP(S) or file:// URL with
+// a fragment naming the chart.
+func IsChartRepoRef(ref string) bool {
+	return IsInstallPackageURL(ref) && strings.Contains(ref, "#")
+}
+
+// ParseChartRepoRef parses a reference to a chart in a chart repository of the form <repo URL>#<chart>[@<version>],
+// e.g. https://charts.example.com#my-chart@1.2.0, and returns the URL of the index.yaml of the repository, the chart
+// name and the version. The repository URL may be the URL of its index.yaml. An empty version selects the latest one.
+func ParseChartRepoRef(ref string) (indexURL, chartName, version string, err error) {
+	if !IsChartRepoRef(ref) {
+		return "", "", "", fmt.Errorf("%s is not a chart repository reference of the form <repo URL>#<chart>[@<version>]", ref)
+	}
+	i := strings.LastIndex(ref, "#")
+	repoURL, frag := ref[:i], ref[i+1:]
+	chartName, version = frag, ""
+	if j := strings.Index(frag, "@"); j >= 0 {
+		chartName, version = frag[:j], frag[j+1:]
+	}
+	if chartName == "" {
+		return "", "", "", fmt.Errorf("chart repository reference %s has no chart name", ref)
+	}
+	indexURL = repoURL
+	if !strings.HasSuffix(indexURL, "/"+repoIndexFileName) {
+		indexURL = strings.TrimSuffix(indexURL, "/") + "/" + repoIndexFileName
+	}
+	return indexURL, chartName, version, nil
+}
+
+// resolveChartRepoRef looks up the chart of the chart repository reference ref in the index of its repository, and
+// returns the URL of its archive and the SHA256 digest that the archive must have.
+func resolveChartRepoRef(ref string) (archiveURL, digest string, err error) {
+	indexURL, chartName, version, err := ParseChartRepoRef(ref)
+	if err != nil {
+		return "", "", err
+	}
+	cv, err := lookupRepoChart(indexURL, chartName, version)
+	if err != nil {
+		return "", "", err
+	}
+	if len(cv.URLs) == 0 {
+		return "", "", fmt.Errorf("chart %s-%s in %s has no URLs", cv.Name, cv.Version, indexURL)
+	}
+	if cv.Digest == "" {
+		return "", "", fmt.Errorf("chart %s-%s in %s has no digest", cv.Name, cv.Version, indexURL)
+	}
+	if archiveURL, err = resolveRepoURL(indexURL, cv.URLs[0]); err != nil {
+		return "", "", err
+	}
+	return archiveURL, cv.Digest, nil
+}
+
+// lookupRepoChart returns the entry of the chart chartName with the given version, or the latest version if it is
+// empty, in the chart repository index at indexURL.
+func lookupRepoChart(indexURL, chartName, version string) (*repo.ChartVersion, error) {
+	data, err := readRepoIndex(indexURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chart repository index %s: %s", indexURL, err)
+	}
+	index := &repo.IndexFile{}
+	if err := yaml.Unmarshal(data, index); err != nil {
+		return nil, fmt.Errorf("bad chart repository index %s: %s", indexURL, err)
+	}
+	if index.APIVersion == "" {
+		return nil, fmt.Errorf("bad chart repository index %s: no apiVersion", indexURL)
+	}
+	index.SortEntries()
+	cv, err := index.Get(chartName, version)
+	if err != nil {
+		return nil, fmt.Errorf("chart %s version %q not found in %s: %s", chartName, version, indexURL, err)
+	}
+	return cv, nil
+}
+
+// resolveRepoURL returns the URL of the chart archive at ref, which is relative to the repository of the index at
+// indexURL unless it is absolute.
+func resolveRepoURL(indexURL, ref string) (string, error) {
+	base, err := url.Parse(indexURL)
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(ref)
+	if err != nil {
+		return "", fmt.Errorf("bad chart URL %s in %s: %s", ref, indexURL, err)
+	}
+	return base.ResolveReference(u).String(), nil
+}
+
+// readRepoIndex returns the contents of the chart repository index at the HTTP(S) or file:// URL indexURL.
+func readRepoIndex(indexURL string) ([]byte, error) {
+	if util.IsHTTPURL(indexURL) {
+		return httprequest.Get(indexURL)
+	}
+	u, err := url.Parse(indexURL)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadFile(u.Path)
+}
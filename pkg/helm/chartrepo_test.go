@@ -0,0 +1,263 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// repoServer is a chart repository stand-in that counts the requests for each path.
+type repoServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	files    map[string][]byte
+	requests map[string]int
+}
+
+func newRepoServer(files map[string][]byte) *repoServer {
+	s := &repoServer{files: files, requests: make(map[string]int)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests[r.URL.Path]++
+		s.mu.Unlock()
+		b, ok := s.files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(b)
+	}))
+	return s
+}
+
+func (s *repoServer) numRequests(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[path]
+}
+
+func TestFetchRepoChart(t *testing.T) {
+	tmp, err := ioutil.TempDir("", InstallationDirectory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	old, oldDigest := chartArchive(t, "greeter", "1.0.0", "repo-hello")
+	latest, latestDigest := chartArchive(t, "greeter", "1.1.0", "repo-hi")
+	bad, _ := chartArchive(t, "tampered", "1.0.0", "repo-tampered")
+	priv, keyring := writeEd25519Keyring(t, tmp, "Istio Release")
+	oldSum := sha256.Sum256(old)
+	server := newRepoServer(map[string][]byte{
+		"/charts/greeter-1.0.0.tgz":     old,
+		"/charts/greeter-1.0.0.tgz.sig": ed25519.Sign(priv, oldSum[:]),
+		"/archive/greeter-1.1.0.tgz":    latest,
+		"/charts/tampered-1.0.0.tgz":    bad,
+	})
+	defer server.Close()
+	server.files["/charts/index.yaml"] = []byte(fmt.Sprintf(`apiVersion: v1
+entries:
+  greeter:
+  - name: greeter
+    version: 1.0.0
+    digest: %s
+    urls:
+    - greeter-1.0.0.tgz
+  - name: greeter
+    version: 1.1.0
+    digest: %s
+    urls:
+    - %s/archive/greeter-1.1.0.tgz
+  tampered:
+  - name: tampered
+    version: 1.0.0
+    digest: %s
+    urls:
+    - tampered-1.0.0.tgz
+`, oldDigest, latestDigest, server.URL, strings.Repeat("0", 64)))
+	repoURL := server.URL + "/charts"
+
+	tests := []struct {
+		desc         string
+		ref          string
+		keyring      string
+		wantGreeting string
+		wantErr      string
+	}{
+		{
+			desc:         "latest",
+			ref:          repoURL + "#greeter",
+			wantGreeting: "repo-hi",
+		},
+		{
+			desc:         "version",
+			ref:          repoURL + "/index.yaml#greeter@1.0.0",
+			wantGreeting: "repo-hello",
+		},
+		{
+			desc:         "version range",
+			ref:          repoURL + "/#greeter@~1.0",
+			wantGreeting: "repo-hello",
+		},
+		{
+			desc:    "missing version",
+			ref:     repoURL + "#greeter@2.0.0",
+			wantErr: "not found",
+		},
+		{
+			desc:    "missing chart",
+			ref:     repoURL + "#missing",
+			wantErr: "not found",
+		},
+		{
+			desc:    "digest mismatch",
+			ref:     repoURL + "#tampered",
+			wantErr: "checksum of",
+		},
+		{
+			desc:    "missing repository",
+			ref:     server.URL + "/missing#greeter",
+			wantErr: "failed to read chart repository index",
+		},
+		{
+			desc:         "signed",
+			ref:          repoURL + "#greeter@1.0.0",
+			keyring:      keyring,
+			wantGreeting: "repo-hello",
+		},
+		{
+			desc:    "unsigned with keyring",
+			ref:     repoURL + "#greeter@1.1.0",
+			keyring: keyring,
+			wantErr: "failed to get signature file",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			newFetcher := func(url string) (*URLFetcher, error) {
+				// Packages cached without a verified signature are refused when a keyring is set, so the verified
+				// packages are kept in their own cache.
+				cacheDir := filepath.Join(tmp, "cache")
+				if tt.keyring != "" {
+					cacheDir = filepath.Join(tmp, "verified-cache")
+				}
+				uf, err := NewURLFetcher(url, cacheDir)
+				if err != nil {
+					return nil, err
+				}
+				if tt.keyring == "" {
+					uf.SetInsecureSkipVerify(true)
+					return uf, nil
+				}
+				k, err := LoadKeyring(tt.keyring)
+				if err != nil {
+					return nil, err
+				}
+				uf.SetKeyring(k)
+				return uf, nil
+			}
+			dir, err := FetchChart(tt.ref, newFetcher)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want one containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			r, err := NewChartRenderer(dir, "greeter", "istio-system")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := r.Run(); err != nil {
+				t.Fatal(err)
+			}
+			got, err := r.RenderManifest("")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := "greeting: " + tt.wantGreeting; !strings.Contains(got, want) {
+				t.Errorf("got manifest:\n%s\nwant it to contain %q", got, want)
+			}
+		})
+	}
+
+	// Each archive is downloaded once into each cache and then used from the cache.
+	for path, want := range map[string]int{
+		"/charts/greeter-1.0.0.tgz":  2,
+		"/archive/greeter-1.1.0.tgz": 2,
+	} {
+		if got := server.numRequests(path); got != want {
+			t.Errorf("got %d requests for %s, want %d", got, path, want)
+		}
+	}
+}
+
+func TestParseChartRepoRef(t *testing.T) {
+	tests := []struct {
+		ref           string
+		wantIndexURL  string
+		wantChartName string
+		wantVersion   string
+		wantErr       bool
+	}{
+		{
+			ref:           "https://charts.example.com#greeter@1.0.0",
+			wantIndexURL:  "https://charts.example.com/index.yaml",
+			wantChartName: "greeter",
+			wantVersion:   "1.0.0",
+		},
+		{
+			ref:           "https://charts.example.com/stable/#greeter",
+			wantIndexURL:  "https://charts.example.com/stable/index.yaml",
+			wantChartName: "greeter",
+		},
+		{
+			ref:           "file:///charts/index.yaml#greeter@^1.0",
+			wantIndexURL:  "file:///charts/index.yaml",
+			wantChartName: "greeter",
+			wantVersion:   "^1.0",
+		},
+		{
+			ref:     "https://charts.example.com#@1.0.0",
+			wantErr: true,
+		},
+		{
+			ref:     "charts/greeter#greeter",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		indexURL, chartName, version, err := ParseChartRepoRef(tt.ref)
+		if gotErr := err != nil; gotErr != tt.wantErr {
+			t.Errorf("%s: got error %v, want error %v", tt.ref, err, tt.wantErr)
+			continue
+		}
+		if indexURL != tt.wantIndexURL || chartName != tt.wantChartName || version != tt.wantVersion {
+			t.Errorf("%s: got %s, %s, %s, want %s, %s, %s", tt.ref, indexURL, chartName, version, tt.wantIndexURL,
+				tt.wantChartName, tt.wantVersion)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IsChartArchive reports whether path is the path of a local chart archive, a .tgz or .tar.gz file, rather than a
+// chart directory or URL.
+func IsChartArchive(path string) bool {
+	return !IsInstallPackageURL(path) && hasChartArchiveSuffix(path)
+}
+
+// IsChartURL reports whether ref is the URL of a chart archive rather than a chart repository reference. Both must be
+// fetched into the package cache with FetchChart before they are rendered.
+func IsChartURL(ref string) bool {
+	return IsInstallPackageURL(ref) && !IsChartRepoRef(ref)
+}
+
+// hasChartArchiveSuffix reports whether ref names a chart archive, a .tgz or .tar.gz file.
+func hasChartArchiveSuffix(ref string) bool {
+	return strings.HasSuffix(ref, ".tgz") || strings.HasSuffix(ref, ".tar.gz")
+}
+
+// FetchChart returns the local chart reference of the chart at source. Chart URLs and charts in chart repositories
+// are fetched into the package cache with the fetcher returned by newFetcher, so they are verified like install
+// packages, and the dir of the unpacked chart is returned. The archive of a chart in a repository is verified against
+// the digest in the index of the repository instead of a SHA file. Chart dirs and archives are returned as they are,
+// since they are read by the renderer.
+func FetchChart(source string, newFetcher func(url string) (*URLFetcher, error)) (string, error) {
+	if !IsInstallPackageURL(source) {
+		return source, nil
+	}
+	archiveURL, digest := source, ""
+	if IsChartRepoRef(source) {
+		var err error
+		if archiveURL, digest, err = resolveChartRepoRef(source); err != nil {
+			return "", err
+		}
+	}
+	uf, err := newFetcher(archiveURL)
+	if err != nil {
+		return "", err
+	}
+	uf.SetDigest(digest)
+	if err := uf.FetchBundles().ToError(); err != nil {
+		return "", fmt.Errorf("failed to fetch chart %s: %s", source, err)
+	}
+	return uf.Package().Dir, nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFetchChart(t *testing.T) {
+	tmp, err := ioutil.TempDir("", InstallationDirectory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	serverRoot := filepath.Join(tmp, "server")
+	if err := os.Mkdir(serverRoot, 0755); err != nil {
+		t.Fatal(err)
+	}
+	server := NewServer(serverRoot)
+	defer server.srv.Close()
+	if _, err := server.moveFiles("testdata/*.tar.gz*"); err != nil {
+		t.Fatal(err)
+	}
+	chartDir := filepath.Join(tmp, "my-addon")
+	if err := os.Mkdir(chartDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	cache, err := NewPackageCache(filepath.Join(tmp, "cache"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	newFetcher := func(url string) (*URLFetcher, error) {
+		uf, err := NewURLFetcher(url, cache.Root())
+		if err != nil {
+			return nil, err
+		}
+		uf.SetInsecureSkipVerify(true)
+		return uf, nil
+	}
+
+	tests := []struct {
+		desc    string
+		source  string
+		want    string
+		wantErr string
+	}{
+		{
+			desc:   "directory",
+			source: chartDir,
+			want:   chartDir,
+		},
+		{
+			desc:   "URL",
+			source: server.URL() + "/" + testPackageName,
+		},
+		{
+			desc:   "archive",
+			source: filepath.Join("testdata", testPackageName),
+			want:   filepath.Join("testdata", testPackageName),
+		},
+		{
+			desc:    "chart repository without index",
+			source:  server.URL() + "#istio-installer@1.3.0",
+			wantErr: "failed to read chart repository index",
+		},
+		{
+			desc:    "missing URL",
+			source:  server.URL() + "/missing-1.0.0.tgz",
+			wantErr: "failed to fetch chart",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := FetchChart(tt.source, newFetcher)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want one containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.want != "" {
+				if got != tt.want {
+					t.Errorf("got %s, want %s", got, tt.want)
+				}
+				return
+			}
+			if !strings.HasPrefix(got, cache.Root()) {
+				t.Errorf("got chart dir %s outside of the package cache %s", got, cache.Root())
+			}
+			if _, err := os.Stat(filepath.Join(got, "Chart.yaml")); err != nil {
+				t.Errorf("fetched chart is missing Chart.yaml: %s", err)
+			}
+		})
+	}
+}
+
+func TestIsChartArchive(t *testing.T) {
+	for path, want := range map[string]bool{
+		"charts/my-addon":                         false,
+		"charts/my-addon-1.0.0.tgz":               true,
+		"/tmp/my-addon-1.0.0.tar.gz":              true,
+		"https://example.com/my-addon-1.0.0.tgz":  false,
+		"file:///tmp/my-addon-1.0.0.tgz":          false,
+		"charts/my-addon-1.0.0.tgz/templates/foo": false,
+	} {
+		if got := IsChartArchive(path); got != want {
+			t.Errorf("IsChartArchive(%s): got %v, want %v", path, got, want)
+		}
+	}
+}
+
+func TestIsChartURL(t *testing.T) {
+	for ref, want := range map[string]bool{
+		"charts/my-addon-1.0.0.tgz":                      false,
+		"https://example.com/my-addon-1.0.0.tgz":         true,
+		"file:///tmp/my-addon-1.0.0.tgz":                 true,
+		"https://example.com/charts#my-addon@1.0.0":      false,
+		"https://example.com/charts/index.yaml#my-addon": false,
+	} {
+		if got := IsChartURL(ref); got != want {
+			t.Errorf("IsChartURL(%s): got %v, want %v", ref, got, want)
+		}
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"html/template"
 	"io/ioutil"
+	"os"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -61,6 +62,8 @@ func NewHelmRenderer(chartsRootDir, helmBaseDir, componentName, namespace string
 	switch {
 	case chartsRootDir == "":
 		return NewVFSRenderer(helmBaseDir, componentName, namespace), nil
+	case IsInstallPackageURL(chartsRootDir):
+		return nil, fmt.Errorf("install package %s must be fetched before rendering", chartsRootDir)
 	case util.IsFilePath(dir):
 		return NewFileTemplateRenderer(dir, componentName, namespace), nil
 	default:
@@ -68,6 +71,23 @@ func NewHelmRenderer(chartsRootDir, helmBaseDir, componentName, namespace string
 	}
 }
 
+// NewChartRenderer creates a helm renderer for the single chart at chartRef and returns an interface to it. The format
+// of chartRef selects the renderer: a chart archive, a .tgz or .tar.gz path, is rendered from the archive, and a
+// directory from the chart files in it. Chart URLs and chart repository references must be fetched with FetchChart
+// first.
+func NewChartRenderer(chartRef, componentName, namespace string) (TemplateRenderer, error) {
+	switch {
+	case IsInstallPackageURL(chartRef):
+		return nil, fmt.Errorf("chart %s must be fetched before rendering", chartRef)
+	case hasChartArchiveSuffix(chartRef):
+		return NewArchiveRenderer(chartRef, componentName, namespace), nil
+	case util.IsFilePath(chartRef):
+		return NewFileTemplateRenderer(chartRef, componentName, namespace), nil
+	default:
+		return nil, fmt.Errorf("unknown helm renderer with chart=%s", chartRef)
+	}
+}
+
 // ReadProfileYAML reads the YAML values associated with the given profile. It uses an appropriate reader for the
 // profile format (compiled-in, file, HTTP, etc.).
 func ReadProfileYAML(profile string) (string, error) {
@@ -85,13 +105,29 @@ func ReadProfileYAML(profile string) (string, error) {
 		if globalValues, err = LoadValuesVFS(profile); err != nil {
 			return "", err
 		}
+	case util.IsHTTPURL(profile) || util.IsFileURL(profile):
+		log.Infof("Loading values from URL %s", profile)
+		if globalValues, err = readProfileURL(profile); err != nil {
+			return "", err
+		}
 	case util.IsFilePath(profile):
 		log.Infof("Loading values from local filesystem at path %s", profile)
 		if globalValues, err = readFile(profile); err != nil {
 			return "", err
 		}
 	default:
-		return "", fmt.Errorf("unsupported Profile type: %s", profile)
+		path, err := FindUserProfile(profile)
+		if err != nil {
+			return "", err
+		}
+		if path == "" {
+			return "", fmt.Errorf("unsupported Profile type: %s, not a built-in profile, URL, file path or "+
+				"profile in the profile directories %v", profile, ProfileDirs())
+		}
+		log.Infof("Loading values for profile %s from %s", profile, path)
+		if globalValues, err = readFile(path); err != nil {
+			return "", err
+		}
 	}
 
 	return globalValues, nil
@@ -172,16 +208,31 @@ func renderTemplate(tmpl string, ts interface{}) (string, error) {
 	return buf.String(), nil
 }
 
-// DefaultFilenameForProfile returns the profile name of the default profile for the given profile.
+// DefaultFilenameForProfile returns the profile name of the default profile for the given profile. Profiles from
+// URLs are relative to the built-in default profile. Profiles from the profile directories are relative to the
+// default.yaml in the same directory if there is one, and to the built-in default profile otherwise.
 func DefaultFilenameForProfile(profile string) (string, error) {
 	switch {
+	case util.IsHTTPURL(profile) || util.IsFileURL(profile):
+		return DefaultProfileString, nil
 	case util.IsFilePath(profile):
 		return filepath.Join(filepath.Dir(profile), DefaultProfileFilename), nil
 	default:
 		if _, ok := ProfileNames[profile]; ok || profile == "" {
 			return DefaultProfileString, nil
 		}
-		return "", fmt.Errorf("bad profile string %s", profile)
+		path, err := FindUserProfile(profile)
+		if err != nil {
+			return "", err
+		}
+		if path == "" {
+			return "", fmt.Errorf("bad profile string %s", profile)
+		}
+		dfn := filepath.Join(filepath.Dir(path), DefaultProfileFilename)
+		if _, err := os.Stat(dfn); err == nil {
+			return dfn, nil
+		}
+		return DefaultProfileString, nil
 	}
 }
 
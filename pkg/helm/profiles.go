@@ -0,0 +1,337 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ghodss/yaml"
+
+	"istio.io/operator/pkg/httprequest"
+	"istio.io/operator/pkg/util"
+)
+
+const (
+	// ProfilesDirEnvVar is the environment variable holding the default search path of user profile directories,
+	// separated by the OS path list separator.
+	ProfilesDirEnvVar = "ISTIO_PROFILES_DIR"
+
+	// BaseProfileAnnotationKey is the annotation of a profile CR that names the profile it is overlaid on. The value
+	// takes the same forms as a profile name. Relative file paths are relative to the location of the profile that
+	// declares them. Profiles without the annotation are overlaid on their default profile.
+	BaseProfileAnnotationKey = "install.istio.io/base-profile"
+
+	// profileFileSuffix is the suffix of profile files in user profile directories.
+	profileFileSuffix = ".yaml"
+)
+
+var (
+	// profileDirsMu protects profileDirs.
+	profileDirsMu sync.RWMutex
+	// profileDirs is the search path of user profile directories. nil means it was not set and ProfilesDirEnvVar
+	// is used.
+	profileDirs []string
+)
+
+// Profile describes a profile that can be selected by name.
+type Profile struct {
+	// Name is the name the profile is selected with.
+	Name string
+	// Path is the file path of a user profile. It is empty for built-in profiles.
+	Path string
+	// Shadowed holds the paths of user profiles with the same name that are hidden by this profile, in search order.
+	Shadowed []string
+}
+
+// Builtin reports whether the profile is compiled in.
+func (p *Profile) Builtin() bool {
+	return p.Path == ""
+}
+
+// SetProfileDirs sets the search path of user profile directories, overriding ProfilesDirEnvVar. Setting nil
+// restores the search path from ProfilesDirEnvVar.
+func SetProfileDirs(dirs []string) {
+	profileDirsMu.Lock()
+	defer profileDirsMu.Unlock()
+	if dirs == nil {
+		profileDirs = nil
+		return
+	}
+	profileDirs = append([]string{}, dirs...)
+}
+
+// ProfileDirs returns the search path of user profile directories.
+func ProfileDirs() []string {
+	profileDirsMu.RLock()
+	defer profileDirsMu.RUnlock()
+	if profileDirs != nil {
+		return profileDirs
+	}
+	var out []string
+	for _, d := range filepath.SplitList(os.Getenv(ProfilesDirEnvVar)) {
+		if d != "" {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+// ListProfiles returns the built-in profiles and the user profiles found in the profile directories, sorted by name.
+// Built-in profiles take precedence over user profiles with the same name, and user profiles in earlier directories
+// take precedence over those in later ones. Hidden profiles are listed in the Shadowed field of the visible one.
+func ListProfiles() ([]*Profile, error) {
+	byName := make(map[string]*Profile)
+	for _, name := range ListBuiltinProfiles() {
+		byName[name] = &Profile{Name: name}
+	}
+	for _, dir := range ProfileDirs() {
+		names, err := userProfileNames(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			path := filepath.Join(dir, name+profileFileSuffix)
+			if p, ok := byName[name]; ok {
+				p.Shadowed = append(p.Shadowed, path)
+				continue
+			}
+			byName[name] = &Profile{Name: name, Path: path}
+		}
+	}
+	out := make([]*Profile, 0, len(byName))
+	for _, p := range byName {
+		out = append(out, p)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+// FindUserProfile returns the path of the user profile with the given name in the profile directories, or the empty
+// string if there is none. Built-in profile names are never looked up.
+func FindUserProfile(name string) (string, error) {
+	if isBuiltinProfileName(name) || strings.ContainsAny(name, `/\`) {
+		return "", nil
+	}
+	for _, dir := range ProfileDirs() {
+		path := filepath.Join(dir, name+profileFileSuffix)
+		fi, err := os.Stat(path)
+		switch {
+		case err == nil && !fi.IsDir():
+			return path, nil
+		case err != nil && !os.IsNotExist(err):
+			return "", err
+		}
+	}
+	return "", nil
+}
+
+// userProfileNames returns the names of the profiles in the user profile directory dir. A missing directory has no
+// profiles.
+func userProfileNames(dir string) ([]string, error) {
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read profile directory: %s", err)
+	}
+	var out []string
+	for _, fi := range fis {
+		if fi.IsDir() || !strings.HasSuffix(fi.Name(), profileFileSuffix) {
+			continue
+		}
+		out = append(out, strings.TrimSuffix(fi.Name(), profileFileSuffix))
+	}
+	return out, nil
+}
+
+// readProfileURL reads a profile from an HTTP(S) or file:// URL.
+func readProfileURL(profile string) (string, error) {
+	if util.IsFileURL(profile) {
+		u, err := url.Parse(profile)
+		if err != nil {
+			return "", err
+		}
+		return readFile(u.Path)
+	}
+	b, err := httprequest.Get(profile)
+	return string(b), err
+}
+
+// ProfileLayer is a profile in an inheritance chain.
+type ProfileLayer struct {
+	// Profile is the reference the profile was read from, e.g. a built-in profile name, file path or URL.
+	Profile string
+	// YAML is the profile CR YAML, not overlaid on its base profile.
+	YAML string
+}
+
+// ReadProfileLayers returns the inheritance chain of the given profile, starting with the root profile and ending
+// with profile itself. Each profile is overlaid on the base profile named by its BaseProfileAnnotationKey annotation,
+// or on its default profile if it has none.
+func ReadProfileLayers(profile string) ([]*ProfileLayer, error) {
+	var chain []*ProfileLayer
+	var refs []string
+	seen := make(map[string]bool)
+	for ref := profile; ; {
+		key, err := profileKey(ref)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, profileDisplayName(ref))
+		if seen[key] {
+			return nil, fmt.Errorf("profile inheritance cycle: %s", strings.Join(refs, " -> "))
+		}
+		seen[key] = true
+
+		y, err := ReadProfileYAML(ref)
+		if err != nil {
+			return nil, fmt.Errorf("could not read the profile values for %s: %s", profileDisplayName(ref), err)
+		}
+		chain = append(chain, &ProfileLayer{Profile: ref, YAML: y})
+
+		base, err := baseProfile(ref, y)
+		if err != nil {
+			return nil, err
+		}
+		if base == "" {
+			break
+		}
+		ref = base
+	}
+	for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+		chain[i], chain[j] = chain[j], chain[i]
+	}
+	return chain, nil
+}
+
+// ReadMergedProfileYAML returns the YAML of the given profile overlaid on its chain of base profiles.
+func ReadMergedProfileYAML(profile string) (string, error) {
+	layers, err := ReadProfileLayers(profile)
+	if err != nil {
+		return "", err
+	}
+	return OverlayProfileLayers(layers)
+}
+
+// OverlayProfileLayers overlays the profile layers, which are ordered from the root profile down.
+func OverlayProfileLayers(layers []*ProfileLayer) (string, error) {
+	if len(layers) == 0 {
+		return "", nil
+	}
+	out := layers[0].YAML
+	for _, l := range layers[1:] {
+		var err error
+		out, err = util.OverlayYAML(out, l.YAML)
+		if err != nil {
+			return "", fmt.Errorf("could not overlay the profile %s over its base: %s", profileDisplayName(l.Profile), err)
+		}
+	}
+	return out, nil
+}
+
+// baseProfile returns the reference of the profile that the profile ref with YAML y is overlaid on, or the empty
+// string if it is a root profile.
+func baseProfile(ref, y string) (string, error) {
+	cr := struct {
+		Metadata struct {
+			Annotations map[string]string `json:"annotations"`
+		} `json:"metadata"`
+	}{}
+	if err := yaml.Unmarshal([]byte(y), &cr); err != nil {
+		return "", fmt.Errorf("could not read the metadata of profile %s: %s", profileDisplayName(ref), err)
+	}
+	base, ok := cr.Metadata.Annotations[BaseProfileAnnotationKey]
+	if !ok {
+		if IsDefaultProfile(ref) {
+			return "", nil
+		}
+		return DefaultFilenameForProfile(ref)
+	}
+	if base == "" {
+		return "", fmt.Errorf("profile %s has an empty %s annotation", profileDisplayName(ref), BaseProfileAnnotationKey)
+	}
+	return resolveBaseProfile(ref, base)
+}
+
+// resolveBaseProfile resolves the base profile reference base, declared by the profile ref. Relative file paths are
+// resolved against the location of ref, other references are returned unchanged.
+func resolveBaseProfile(ref, base string) (string, error) {
+	if !util.IsFilePath(base) || filepath.IsAbs(base) || util.IsHTTPURL(base) || util.IsFileURL(base) {
+		return base, nil
+	}
+	switch {
+	case util.IsHTTPURL(ref) || util.IsFileURL(ref):
+		u, err := url.Parse(ref)
+		if err != nil {
+			return "", err
+		}
+		bu, err := url.Parse(filepath.ToSlash(base))
+		if err != nil {
+			return "", fmt.Errorf("bad base profile %s of profile %s: %s", base, ref, err)
+		}
+		return u.ResolveReference(bu).String(), nil
+	case util.IsFilePath(ref):
+		return filepath.Join(filepath.Dir(ref), base), nil
+	}
+	path, err := FindUserProfile(ref)
+	if err != nil {
+		return "", err
+	}
+	if path == "" {
+		// Built-in profiles have no location, relative paths are relative to the working directory.
+		return base, nil
+	}
+	return filepath.Join(filepath.Dir(path), base), nil
+}
+
+// profileKey returns a key identifying the profile that ref refers to, so that different references to the same
+// profile are detected.
+func profileKey(ref string) (string, error) {
+	switch {
+	case ref == "":
+		return DefaultProfileString, nil
+	case isBuiltinProfileName(ref), util.IsHTTPURL(ref):
+		return ref, nil
+	case util.IsFileURL(ref):
+		u, err := url.Parse(ref)
+		if err != nil {
+			return "", err
+		}
+		return filepath.Abs(u.Path)
+	case util.IsFilePath(ref):
+		return filepath.Abs(ref)
+	}
+	path, err := FindUserProfile(ref)
+	if err != nil || path == "" {
+		return ref, err
+	}
+	return filepath.Abs(path)
+}
+
+// profileDisplayName returns the name of the profile ref for messages.
+func profileDisplayName(ref string) string {
+	if ref == "" {
+		return DefaultProfileString
+	}
+	return ref
+}
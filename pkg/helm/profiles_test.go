@@ -0,0 +1,267 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testProfileYAML = `apiVersion: install.istio.io/v1alpha1
+kind: IstioOperator
+spec:
+  hub: my.registry/istio
+`
+
+// writeProfileDirs creates two user profile directories under tmp, both holding a corp profile and the second one
+// also holding a demo profile, and returns their paths.
+func writeProfileDirs(t *testing.T, tmp string) (string, string) {
+	first, second := filepath.Join(tmp, "first"), filepath.Join(tmp, "second")
+	files := []string{
+		filepath.Join(first, "corp.yaml"),
+		filepath.Join(second, "corp.yaml"),
+		filepath.Join(second, "demo.yaml"),
+	}
+	for _, f := range files {
+		if err := os.MkdirAll(filepath.Dir(f), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(f, []byte(testProfileYAML), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return first, second
+}
+
+func TestListProfiles(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "profiles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	first, second := writeProfileDirs(t, tmp)
+	SetProfileDirs([]string{first, second, filepath.Join(tmp, "missing")})
+	defer SetProfileDirs(nil)
+
+	profiles, err := ListProfiles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make(map[string]*Profile)
+	for _, p := range profiles {
+		got[p.Name] = p
+	}
+	corp := got["corp"]
+	if corp == nil || corp.Path != filepath.Join(first, "corp.yaml") {
+		t.Fatalf("got corp profile %+v, want it from %s", corp, first)
+	}
+	if want := []string{filepath.Join(second, "corp.yaml")}; !reflect.DeepEqual(corp.Shadowed, want) {
+		t.Errorf("got corp shadowed %v, want %v", corp.Shadowed, want)
+	}
+	demo := got["demo"]
+	if demo == nil || !demo.Builtin() {
+		t.Fatalf("got demo profile %+v, want built-in", demo)
+	}
+	if want := []string{filepath.Join(second, "demo.yaml")}; !reflect.DeepEqual(demo.Shadowed, want) {
+		t.Errorf("got demo shadowed %v, want %v", demo.Shadowed, want)
+	}
+}
+
+func TestReadProfileYAMLSources(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "profiles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	first, _ := writeProfileDirs(t, tmp)
+	SetProfileDirs([]string{first})
+	defer SetProfileDirs(nil)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testProfileYAML))
+	}))
+	defer srv.Close()
+
+	tests := []struct {
+		desc        string
+		profile     string
+		wantDefault string
+		wantErr     bool
+	}{
+		{
+			desc:        "profile directory",
+			profile:     "corp",
+			wantDefault: DefaultProfileString,
+		},
+		{
+			desc:        "file URL",
+			profile:     "file://" + filepath.Join(first, "corp.yaml"),
+			wantDefault: DefaultProfileString,
+		},
+		{
+			desc:        "HTTP URL",
+			profile:     srv.URL + "/corp.yaml",
+			wantDefault: DefaultProfileString,
+		},
+		{
+			desc:    "unknown name",
+			profile: "unknown",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := ReadProfileYAML(tt.profile)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error: %v, want error: %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got != testProfileYAML {
+				t.Errorf("got profile:\n%s\nwant:\n%s", got, testProfileYAML)
+			}
+			dfn, err := DefaultFilenameForProfile(tt.profile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if dfn != tt.wantDefault {
+				t.Errorf("got default profile %s, want %s", dfn, tt.wantDefault)
+			}
+		})
+	}
+
+	// A default.yaml next to user profiles is their base instead of the built-in default profile.
+	dfn := filepath.Join(first, DefaultProfileFilename)
+	if err := ioutil.WriteFile(dfn, []byte(testProfileYAML), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := DefaultFilenameForProfile("corp"); err != nil || got != dfn {
+		t.Errorf("got default profile %s, %v, want %s", got, err, dfn)
+	}
+}
+
+// baseProfileYAML returns a profile CR with the given base profile annotation, or none if base is empty, and tag.
+func baseProfileYAML(base, tag string) string {
+	out := "apiVersion: install.istio.io/v1alpha1\nkind: IstioOperator\n"
+	if base != "" {
+		out += "metadata:\n  annotations:\n    " + BaseProfileAnnotationKey + ": " + base + "\n"
+	}
+	return out + "spec:\n  tag: " + tag + "\n"
+}
+
+func TestReadProfileLayers(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "profiles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	dir := filepath.Join(tmp, "profiles")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"prod.yaml":    baseProfileYAML("demo", "prod"),
+		"prod-eu.yaml": baseProfileYAML("prod.yaml", "prod-eu"),
+		"plain.yaml":   baseProfileYAML("", "plain"),
+		"a.yaml":       baseProfileYAML("b", "a"),
+		"b.yaml":       baseProfileYAML("./a.yaml", "b"),
+	}
+	for name, y := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(y), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	SetProfileDirs([]string{dir})
+	defer SetProfileDirs(nil)
+
+	tests := []struct {
+		desc    string
+		profile string
+		want    []string
+		wantTag string
+		wantErr string
+	}{
+		{
+			desc:    "built-in default",
+			profile: "",
+			want:    []string{""},
+			wantTag: "latest",
+		},
+		{
+			desc:    "built-in",
+			profile: "demo",
+			want:    []string{DefaultProfileString, "demo"},
+			wantTag: "latest",
+		},
+		{
+			desc:    "no annotation",
+			profile: "plain",
+			want:    []string{DefaultProfileString, "plain"},
+			wantTag: "plain",
+		},
+		{
+			desc:    "chain",
+			profile: "prod-eu",
+			want:    []string{DefaultProfileString, "demo", filepath.Join(dir, "prod.yaml"), "prod-eu"},
+			wantTag: "prod-eu",
+		},
+		{
+			desc:    "file path",
+			profile: filepath.Join(dir, "prod.yaml"),
+			want:    []string{DefaultProfileString, "demo", filepath.Join(dir, "prod.yaml")},
+			wantTag: "prod",
+		},
+		{
+			desc:    "cycle",
+			profile: "a",
+			wantErr: "profile inheritance cycle: a -> b -> " + filepath.Join(dir, "a.yaml"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			layers, err := ReadProfileLayers(tt.profile)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("got error %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, l := range layers {
+				got = append(got, l.Profile)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got layers %v, want %v", got, tt.want)
+			}
+			merged, err := OverlayProfileLayers(layers)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := "tag: " + tt.wantTag + "\n"; !strings.Contains(merged, want) {
+				t.Errorf("got merged profile without %q:\n%s", want, merged)
+			}
+		})
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+)
+
+const (
+	// SignatureFileSuffix is the suffix of detached signature files for install packages.
+	SignatureFileSuffix = ".sig"
+	// KeyringEnvVar is the environment variable pointing to the default keyring to verify install packages with.
+	KeyringEnvVar = "ISTIO_INSTALL_PACKAGE_KEYRING"
+
+	// pemPublicKeyType is the PEM block type of PKIX encoded public keys.
+	pemPublicKeyType = "PUBLIC KEY"
+	// pemIdentityHeader is the optional PEM header naming the owner of a public key.
+	pemIdentityHeader = "Identity"
+	// pgpArmorPrefix is the prefix of ASCII armored PGP blocks.
+	pgpArmorPrefix = "-----BEGIN PGP"
+)
+
+// ed25519Key is an ed25519 public key along with the identity of its owner.
+type ed25519Key struct {
+	identity string
+	key      ed25519.PublicKey
+}
+
+// Keyring holds the trusted public keys that install package signatures are verified against. It supports PEM
+// encoded ed25519 keys, whose signatures are over the SHA256 digest of the package, and PGP keys.
+type Keyring struct {
+	ed25519Keys []ed25519Key
+	pgpKeys     openpgp.EntityList
+}
+
+// LoadKeyring reads a keyring from the file at path, or from all the files in path if it is a directory. Each file
+// may contain one or more PEM encoded ed25519 public keys, or an armored or binary PGP public keyring.
+func LoadKeyring(path string) (*Keyring, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	files := []string{path}
+	if fi.IsDir() {
+		fis, err := ioutil.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		files = nil
+		for _, f := range fis {
+			if !f.IsDir() {
+				files = append(files, filepath.Join(path, f.Name()))
+			}
+		}
+	}
+	k := &Keyring{}
+	for _, f := range files {
+		b, err := ioutil.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.add(b); err != nil {
+			return nil, fmt.Errorf("failed to load keys from %s: %s", f, err)
+		}
+	}
+	if k.Len() == 0 {
+		return nil, fmt.Errorf("no public keys found in keyring %s", path)
+	}
+	return k, nil
+}
+
+// Len returns the number of keys in the keyring.
+func (k *Keyring) Len() int {
+	return len(k.ed25519Keys) + len(k.pgpKeys)
+}
+
+func (k *Keyring) add(b []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(b), []byte(pgpArmorPrefix)) {
+		el, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(b))
+		if err != nil {
+			return err
+		}
+		k.pgpKeys = append(k.pgpKeys, el...)
+		return nil
+	}
+	block, rest := pem.Decode(b)
+	if block == nil {
+		el, err := openpgp.ReadKeyRing(bytes.NewReader(b))
+		if err != nil {
+			return fmt.Errorf("not a PEM or PGP keyring")
+		}
+		k.pgpKeys = append(k.pgpKeys, el...)
+		return nil
+	}
+	for ; block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != pemPublicKeyType {
+			return fmt.Errorf("unsupported PEM block type %s", block.Type)
+		}
+		pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return err
+		}
+		edPub, ok := pub.(ed25519.PublicKey)
+		if !ok {
+			return fmt.Errorf("unsupported public key type %T, only ed25519 keys are supported", pub)
+		}
+		identity := block.Headers[pemIdentityHeader]
+		if identity == "" {
+			identity = ed25519Fingerprint(edPub)
+		}
+		k.ed25519Keys = append(k.ed25519Keys, ed25519Key{identity: identity, key: edPub})
+	}
+	return nil
+}
+
+// Verify checks the detached signature sig of the data read from signed against the keys in the keyring and returns
+// the identity of the signer. The data is streamed rather than read into memory, so ed25519 signatures are over its
+// SHA256 digest. signed is rewound if more than one kind of key must be tried.
+func (k *Keyring) Verify(signed io.ReadSeeker, sig []byte) (string, error) {
+	if bytes.HasPrefix(bytes.TrimSpace(sig), []byte(pgpArmorPrefix)) {
+		block, err := armor.Decode(bytes.NewReader(sig))
+		if err != nil {
+			return "", fmt.Errorf("bad PGP signature: %s", err)
+		}
+		return k.verifyPGP(signed, block.Body)
+	}
+	if edSig, ok := decodeEd25519Signature(sig); ok {
+		h := sha256.New()
+		if _, err := io.Copy(h, signed); err != nil {
+			return "", err
+		}
+		digest := h.Sum(nil)
+		for _, ek := range k.ed25519Keys {
+			if ed25519.Verify(ek.key, digest, edSig) {
+				return ek.identity, nil
+			}
+		}
+		if len(k.pgpKeys) == 0 {
+			return "", fmt.Errorf("signature does not match any key in the keyring")
+		}
+		if _, err := signed.Seek(0, io.SeekStart); err != nil {
+			return "", err
+		}
+	}
+	return k.verifyPGP(signed, bytes.NewReader(sig))
+}
+
+// VerifyFile checks the detached signature in the file at sigPath of the file at path and returns the identity of
+// the signer.
+func (k *Keyring) VerifyFile(path, sigPath string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	sig, err := ioutil.ReadFile(sigPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read signature file: %s", err)
+	}
+	signer, err := k.Verify(f, sig)
+	if err != nil {
+		return "", fmt.Errorf("signature verification of %s failed: %s", filepath.Base(path), err)
+	}
+	return signer, nil
+}
+
+func (k *Keyring) verifyPGP(signed io.Reader, sig io.Reader) (string, error) {
+	if len(k.pgpKeys) == 0 {
+		return "", fmt.Errorf("signature does not match any key in the keyring")
+	}
+	signer, err := openpgp.CheckDetachedSignature(k.pgpKeys, signed, sig)
+	if err != nil {
+		return "", err
+	}
+	if name := pgpIdentity(signer); name != "" {
+		return fmt.Sprintf("%s (%X)", name, signer.PrimaryKey.KeyId), nil
+	}
+	return fmt.Sprintf("%X", signer.PrimaryKey.KeyId), nil
+}
+
+// pgpIdentity returns the primary identity of e, or the first of its identity names in lexical order if none is
+// marked primary, so that the reported signer is stable.
+func pgpIdentity(e *openpgp.Entity) string {
+	var names []string
+	for name, id := range e.Identities {
+		if id.SelfSignature != nil && id.SelfSignature.IsPrimaryId != nil && *id.SelfSignature.IsPrimaryId {
+			return name
+		}
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return ""
+	}
+	sort.Strings(names)
+	return names[0]
+}
+
+// decodeEd25519Signature returns sig as an ed25519 signature, if it is either raw or base64 encoded.
+func decodeEd25519Signature(sig []byte) ([]byte, bool) {
+	if len(sig) == ed25519.SignatureSize {
+		return sig, true
+	}
+	b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(sig)))
+	if err != nil || len(b) != ed25519.SignatureSize {
+		return nil, false
+	}
+	return b, true
+}
+
+// ed25519Fingerprint returns an identity for a key without one, derived from the SHA256 of the key.
+func ed25519Fingerprint(key ed25519.PublicKey) string {
+	sum := sha256.Sum256(key)
+	return "ed25519:" + hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,247 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+	"golang.org/x/crypto/openpgp/packet"
+)
+
+// writeEd25519Keyring generates an ed25519 key, writes its public key with the given identity to a PEM keyring file
+// in dir and returns the private key and the keyring path.
+func writeEd25519Keyring(t *testing.T, dir, identity string) (ed25519.PrivateKey, string) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := &pem.Block{Type: pemPublicKeyType, Bytes: der}
+	if identity != "" {
+		block.Headers = map[string]string{pemIdentityHeader: identity}
+	}
+	path := filepath.Join(dir, "keyring.pem")
+	if err := ioutil.WriteFile(path, pem.EncodeToMemory(block), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return priv, path
+}
+
+func TestFetchSigned(t *testing.T) {
+	tmp, err := ioutil.TempDir("", InstallationDirectory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	serveDir := filepath.Join(tmp, "serve")
+	keyDir := filepath.Join(tmp, "keys")
+	for _, d := range []string{serveDir, keyDir} {
+		if err := os.Mkdir(d, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	server := NewServer(serveDir)
+	defer server.srv.Close()
+	if _, err := server.moveFiles("testdata/*.tar.gz*"); err != nil {
+		t.Fatal(err)
+	}
+	tarball, err := ioutil.ReadFile(filepath.Join(serveDir, testPackageName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// ed25519 signatures are over the SHA256 digest of the package.
+	digest := sha256.Sum256(tarball)
+	priv, keyringPath := writeEd25519Keyring(t, keyDir, "release@example.com")
+	keyring, err := LoadKeyring(keyringPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sigPath := filepath.Join(serveDir, testPackageName+SignatureFileSuffix)
+	url := server.URL() + "/" + testPackageName
+
+	tests := []struct {
+		desc       string
+		sig        []byte
+		keyring    *Keyring
+		skip       bool
+		wantSigner string
+		wantErr    string
+	}{
+		{
+			desc:       "valid signature",
+			sig:        []byte(base64.StdEncoding.EncodeToString(ed25519.Sign(priv, digest[:]))),
+			keyring:    keyring,
+			wantSigner: "release@example.com",
+		},
+		{
+			desc:    "signature from another key",
+			sig:     ed25519.Sign(ed25519.NewKeyFromSeed(bytes.Repeat([]byte{1}, ed25519.SeedSize)), digest[:]),
+			keyring: keyring,
+			wantErr: "signature does not match any key in the keyring",
+		},
+		{
+			desc:    "no keyring",
+			sig:     ed25519.Sign(priv, digest[:]),
+			wantErr: "no keyring is configured",
+		},
+		{
+			desc: "no keyring with insecure skip verify",
+			skip: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			if err := ioutil.WriteFile(sigPath, tt.sig, 0644); err != nil {
+				t.Fatal(err)
+			}
+			cacheDir, err := ioutil.TempDir(tmp, "cache")
+			if err != nil {
+				t.Fatal(err)
+			}
+			uf, err := NewURLFetcher(url, cacheDir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			uf.SetKeyring(tt.keyring)
+			uf.SetInsecureSkipVerify(tt.skip)
+			err = uf.FetchBundles().ToError()
+			if gotErr := errToString(err); !strings.Contains(gotErr, tt.wantErr) || (tt.wantErr == "") != (err == nil) {
+				t.Fatalf("got error: %v, want error containing: %s", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got := uf.Package().Signer; got != tt.wantSigner {
+				t.Errorf("got signer %q, want %q", got, tt.wantSigner)
+			}
+
+			// The signature is verified again when the package is served from the cache.
+			cached, err := NewURLFetcher(url, cacheDir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cached.SetOffline(true)
+			cached.SetKeyring(tt.keyring)
+			cached.SetInsecureSkipVerify(tt.skip)
+			if err := cached.FetchBundles().ToError(); err != nil {
+				t.Fatalf("offline fetch from cache: %s", err)
+			}
+			if got := cached.Package().Signer; got != tt.wantSigner {
+				t.Errorf("got cached signer %q, want %q", got, tt.wantSigner)
+			}
+		})
+	}
+}
+
+func TestKeyringPGP(t *testing.T) {
+	entity, err := openpgp.NewEntity("Istio Release", "", "release@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubBuf := &bytes.Buffer{}
+	w, err := armor.Encode(pubBuf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := entity.Serialize(w); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	keyring := &Keyring{}
+	if err := keyring.add(pubBuf.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+
+	data := []byte("install package")
+	sig := &bytes.Buffer{}
+	if err := openpgp.ArmoredDetachSign(sig, entity, bytes.NewReader(data), nil); err != nil {
+		t.Fatal(err)
+	}
+	signer, err := keyring.Verify(bytes.NewReader(data), sig.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(signer, "Istio Release <release@example.com>") {
+		t.Errorf("got signer %s, want Istio Release <release@example.com>", signer)
+	}
+	if _, err := keyring.Verify(strings.NewReader("tampered package"), sig.Bytes()); err == nil {
+		t.Errorf("Verify of tampered data: got no error")
+	}
+}
+
+func TestPGPIdentity(t *testing.T) {
+	primary := true
+	tests := []struct {
+		desc       string
+		identities map[string]*openpgp.Identity
+		want       string
+	}{
+		{
+			desc: "none",
+		},
+		{
+			desc: "lexically first",
+			identities: map[string]*openpgp.Identity{
+				"b <b@example.com>": {},
+				"a <a@example.com>": {},
+				"c <c@example.com>": {},
+			},
+			want: "a <a@example.com>",
+		},
+		{
+			desc: "primary",
+			identities: map[string]*openpgp.Identity{
+				"a <a@example.com>": {},
+				"b <b@example.com>": {SelfSignature: &packet.Signature{IsPrimaryId: &primary}},
+			},
+			want: "b <b@example.com>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			if got := pgpIdentity(&openpgp.Entity{Identities: tt.identities}); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// errToString returns the string representation of err and the empty string if
+// err is nil.
+func errToString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -15,10 +15,7 @@
 package helm
 
 import (
-	"crypto/sha256"
-	"encoding/hex"
 	"fmt"
-	"io"
 	"io/ioutil"
 	"net/url"
 	"os"
@@ -26,8 +23,6 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/mholt/archiver"
-
 	"istio.io/operator/pkg/httprequest"
 	"istio.io/operator/pkg/util"
 	"istio.io/pkg/log"
@@ -54,62 +49,121 @@ type URLFetcher struct {
 	verifyURL string
 	// verify indicates whether the downloaded tar should be verified
 	verify bool
-	// destDir is path of charts downloaded to, empty as default to temp dir
+	// digest is the SHA256 digest the package must have, e.g. the one listed for a chart in a chart repository index.
+	// If it is set, the package is verified against it instead of its SHA file.
+	digest string
+	// offline indicates that packages must be served from the cache, without accessing the network.
+	offline bool
+	// keyring holds the keys that package signatures are verified against.
+	keyring *Keyring
+	// insecureSkipVerify indicates that packages are accepted without a verified signature.
+	insecureSkipVerify bool
+	// destDir is the root of the package cache the charts are downloaded to, empty as default to temp dir
 	destDir string
+	// cache is the package cache rooted at destDir.
+	cache *PackageCache
+	// pkg is the cache entry of the fetched package, set once it is fetched.
+	pkg *CachedPackage
 }
 
 // NewURLFetcher creates an URLFetcher pointing to installation package URL and destination,
 // and returns a pointer to it.
 func NewURLFetcher(insPackageURL string, destDir string) (*URLFetcher, error) {
-	if destDir == "" {
-		destDir = filepath.Join(os.TempDir(), InstallationDirectory)
-	}
-	if _, err := os.Stat(destDir); os.IsNotExist(err) {
-		err := os.Mkdir(destDir, os.ModeDir|os.ModePerm)
-		if err != nil {
-			return nil, err
-		}
+	cache, err := NewPackageCache(destDir)
+	if err != nil {
+		return nil, err
 	}
 	uf := &URLFetcher{
 		url:       insPackageURL,
 		verifyURL: insPackageURL + SHAFileSuffix,
 		verify:    true,
-		destDir:   destDir,
+		destDir:   cache.Root(),
+		cache:     cache,
 	}
 	return uf, nil
 }
 
+// SetOffline sets whether the fetcher must only use packages that are already in the cache.
+func (f *URLFetcher) SetOffline(offline bool) {
+	f.offline = offline
+}
+
+// SetDigest sets the SHA256 digest the package must have, which it is verified against instead of its SHA file.
+// Cached packages with another digest are not used.
+func (f *URLFetcher) SetDigest(digest string) {
+	f.digest = digest
+}
+
+// SetKeyring sets the keyring that package signatures are verified against.
+func (f *URLFetcher) SetKeyring(keyring *Keyring) {
+	f.keyring = keyring
+}
+
+// SetInsecureSkipVerify sets whether packages are accepted without a verified signature.
+func (f *URLFetcher) SetInsecureSkipVerify(skip bool) {
+	f.insecureSkipVerify = skip
+}
+
 // DestDir returns path of destination dir.
 func (f *URLFetcher) DestDir() string {
 	return f.destDir
 }
 
+// Package returns the cache entry of the fetched package, or nil if it has not been fetched yet.
+func (f *URLFetcher) Package() *CachedPackage {
+	return f.pkg
+}
+
 // FetchBundles fetches the charts, sha and version file
 func (f *URLFetcher) FetchBundles() util.Errors {
 	errs := util.Errors{}
-	// check whether install package already cached locally at destDir, skip downloading if yes.
+	// check whether install package already cached locally, skip downloading if yes.
 	fn := path.Base(f.url)
-	_, err := os.Stat(filepath.Join(f.destDir, fn))
-	if err == nil {
+	pkg, err := f.cache.Lookup(fn)
+	if err != nil {
+		log.Warnf("Discarding cached install package: %s", err)
+	}
+	if pkg != nil && f.digest != "" && !strings.EqualFold(pkg.Digest, f.digest) {
+		log.Infof("Not using cached install package %s with digest %s, want digest %s", pkg.Name, pkg.Digest, f.digest)
+		pkg = nil
+	}
+	if pkg != nil {
+		log.Infof("Using cached install package %s with digest %s", pkg.Name, pkg.Digest)
+		if err := f.verifyCachedSignature(pkg); err != nil {
+			return util.AppendErr(errs, err)
+		}
+		f.pkg = pkg
 		return errs
 	}
+	if f.offline {
+		return util.AppendErr(errs, fmt.Errorf("install package %s is not in the package cache at %s and offline mode is set",
+			fn, f.destDir))
+	}
+	if f.digest != "" {
+		return util.AppendErr(errs, f.fetchChart(""))
+	}
 	shaF, err := f.fetchSha()
 	errs = util.AppendErr(errs, err)
 	return util.AppendErr(errs, f.fetchChart(shaF))
 }
 
-// fetchChart fetches the charts and verifies charts against SHA file if required
+// fetchChart fetches the charts, verifies charts against the digest set with SetDigest or the SHA file if required
+// and adds them to the cache.
 func (f *URLFetcher) fetchChart(shaF string) error {
-	saved, err := DownloadTo(f.url, f.destDir)
+	saved, err := DownloadTo(f.url, f.cache.DownloadsDir())
 	if err != nil {
 		return err
 	}
-	file, err := os.Open(saved)
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-	if f.verify {
+	switch {
+	case f.digest != "":
+		digest, err := fileSHA256(saved)
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(digest, f.digest) {
+			return fmt.Errorf("checksum of %s is %s, expected %s", f.url, digest, f.digest)
+		}
+	case f.verify:
 		// verify with sha file
 		_, err := os.Stat(shaF)
 		if os.IsNotExist(err) {
@@ -118,24 +172,82 @@ func (f *URLFetcher) fetchChart(shaF string) error {
 				return fmt.Errorf("failed to get sha file: %s", err)
 			}
 		}
-		hashAll, err := ioutil.ReadFile(shaF)
-		if err != nil {
-			return fmt.Errorf("failed to read sha file: %s", err)
-		}
-		// SHA file has structure of "sha_value filename"
-		hash := strings.Split(string(hashAll), " ")[0]
-		h := sha256.New()
-		if _, err := io.Copy(h, file); err != nil {
-			log.Error(err.Error())
-		}
-		sum := h.Sum(nil)
-		actualHash := hex.EncodeToString(sum)
-		if !strings.EqualFold(actualHash, hash) {
-			return fmt.Errorf("checksum of charts file located at: %s does not match expected SHA file: %s", saved, shaF)
+		if err := verifySHAFile(saved, shaF); err != nil {
+			return err
 		}
 	}
-	targz := archiver.TarGz{Tar: &archiver.Tar{OverwriteExisting: true}}
-	return targz.Unarchive(saved, f.destDir)
+	sigF, signer, err := f.fetchAndVerifySignature(saved)
+	if err != nil {
+		return err
+	}
+	f.pkg, err = f.cache.Add(saved, f.url)
+	if err != nil || sigF == "" {
+		return err
+	}
+	return f.cache.SetSignature(f.pkg, sigF, signer)
+}
+
+// fetchAndVerifySignature downloads the detached signature of the package tarball at path and verifies it against
+// the keyring. It returns the path of the signature file and the signer identity, which are both empty if
+// verification is skipped.
+func (f *URLFetcher) fetchAndVerifySignature(path string) (string, string, error) {
+	if f.insecureSkipVerify {
+		log.Warnf("Skipping signature verification of install package %s", f.url)
+		return "", "", nil
+	}
+	if f.keyring == nil {
+		return "", "", errNoKeyring(f.url)
+	}
+	sigF, err := DownloadTo(f.url+SignatureFileSuffix, f.cache.DownloadsDir())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get signature file: %s", err)
+	}
+	signer, err := f.keyring.VerifyFile(path, sigF)
+	if err != nil {
+		return "", "", err
+	}
+	log.Infof("Install package %s is signed by %s", f.url, signer)
+	return sigF, signer, nil
+}
+
+// verifyCachedSignature verifies the stored signature of a cached package against the keyring, unless verification
+// is skipped.
+func (f *URLFetcher) verifyCachedSignature(pkg *CachedPackage) error {
+	if f.insecureSkipVerify {
+		return nil
+	}
+	if f.keyring == nil {
+		return errNoKeyring(pkg.Name)
+	}
+	if pkg.Signer == "" {
+		return fmt.Errorf("cached install package %s has no verified signature, pull or import it again with a keyring",
+			pkg.Name)
+	}
+	_, err := f.keyring.VerifyFile(pkg.Path, f.cache.SignaturePath(pkg))
+	return err
+}
+
+func errNoKeyring(pkg string) error {
+	return fmt.Errorf("refusing to use install package %s: no keyring is configured to verify its signature, "+
+		"set a keyring or skip verification with --insecure-skip-verify", pkg)
+}
+
+// verifySHAFile checks the SHA256 digest of the file at path against the SHA file at shaF.
+func verifySHAFile(path, shaF string) error {
+	hashAll, err := ioutil.ReadFile(shaF)
+	if err != nil {
+		return fmt.Errorf("failed to read sha file: %s", err)
+	}
+	// SHA file has structure of "sha_value filename"
+	hash := strings.Split(string(hashAll), " ")[0]
+	actualHash, err := fileSHA256(path)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(actualHash, hash) {
+		return fmt.Errorf("checksum of charts file located at: %s does not match expected SHA file: %s", path, shaF)
+	}
+	return nil
 }
 
 // fetchsha downloads the SHA file from url
@@ -143,33 +255,42 @@ func (f *URLFetcher) fetchSha() (string, error) {
 	if f.verifyURL == "" {
 		return "", fmt.Errorf("SHA file url is empty")
 	}
-	shaF, err := DownloadTo(f.verifyURL, f.destDir)
+	shaF, err := DownloadTo(f.verifyURL, f.cache.DownloadsDir())
 	if err != nil {
 		return "", err
 	}
 	return shaF, nil
 }
 
-// DownloadTo downloads from remote url to dest local file path
+// DownloadTo downloads from remote url to dest local file path. file:// URLs are copied from the local filesystem.
 func DownloadTo(ref, dest string) (string, error) {
 	u, err := url.Parse(ref)
 	if err != nil {
 		return "", fmt.Errorf("invalid chart URL: %s", ref)
 	}
-	data, err := httprequest.Get(u.String())
-	if err != nil {
-		return "", err
-	}
 
 	name := filepath.Base(u.Path)
 	destFile := filepath.Join(dest, name)
-	if err := ioutil.WriteFile(destFile, data, 0666); err != nil {
+	if u.Scheme == "file" {
+		return destFile, copyFile(u.Path, destFile)
+	}
+	c, err := httprequest.DefaultClient()
+	if err != nil {
+		return "", err
+	}
+	if err := c.GetToFile(u.String(), destFile); err != nil {
 		return destFile, err
 	}
 
 	return destFile, nil
 }
 
+// IsInstallPackageURL reports whether path is an install package URL that must be fetched before it can be used,
+// rather than a local charts directory.
+func IsInstallPackageURL(path string) bool {
+	return util.IsHTTPURL(path) || util.IsFileURL(path)
+}
+
 // InstallURLFromVersion generates default installation url from version number.
 func InstallURLFromVersion(version string) string {
 	return fmt.Sprintf(installationPathTemplate, version, version)
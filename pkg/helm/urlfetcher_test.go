@@ -113,6 +113,7 @@ func TestFetch(t *testing.T) {
 			t.Error(err)
 			return
 		}
+		fq.SetInsecureSkipVerify(true)
 		if test.verify {
 			fq.verify = test.verify
 			savedShaF, err := fq.fetchSha()
@@ -143,7 +144,10 @@ func TestFetch(t *testing.T) {
 				return
 			}
 		}
-		ef := filepath.Join(fq.destDir, test.installationPackageName)
+		ef := fq.Package().Path
+		if filepath.Base(ef) != test.installationPackageName {
+			t.Errorf("got cached package %s, want %s", ef, test.installationPackageName)
+		}
 		if _, err := os.Stat(ef); err != nil {
 			t.Error(err)
 			return
@@ -17,8 +17,9 @@ package helm
 import (
 	"fmt"
 	"io/ioutil"
-	"os"
+	"path"
 	"strings"
+	"sync"
 	"time"
 
 	"istio.io/pkg/log"
@@ -28,12 +29,25 @@ import (
 type URLPoller struct {
 	// url is remote target url to poll from
 	url string
-	// existingHash records last sha value of polled files
-	existingHash string
-	// ticker helps to tick at interval
-	ticker *time.Ticker
 	// urlFetcher fetches resources from url
 	urlFetcher *URLFetcher
+	// stop ends the polling goroutine when closed
+	stop chan struct{}
+	// intervalChanged tells the polling goroutine that interval was changed by SetInterval
+	intervalChanged chan struct{}
+
+	// mu protects the fields below, which are written by the polling goroutine or SetInterval
+	mu sync.Mutex
+	// interval is the time between two checks
+	interval time.Duration
+	// existingHash records last sha value of polled files
+	existingHash string
+	// pkg is the cache entry of the most recently fetched package
+	pkg *CachedPackage
+	// lastCheck is the time of the last check, successful or not
+	lastCheck time.Time
+	// lastErr is the error of the last check
+	lastErr error
 }
 
 // checkUpdate checks a SHA URL to determine if the installation package has been updated
@@ -49,58 +63,172 @@ func (p *URLPoller) checkUpdate() (bool, error) {
 		return false, fmt.Errorf("failed to read sha file: %s", err)
 	}
 	// Original sha file name is formatted with "HashValue filename"
-	newHash := strings.Fields(string(hashAll))[0]
+	fields := strings.Fields(string(hashAll))
+	if len(fields) == 0 {
+		return false, fmt.Errorf("sha file %s is empty", p.urlFetcher.verifyURL)
+	}
+	newHash := fields[0]
 
-	if !strings.EqualFold(newHash, p.existingHash) {
-		p.existingHash = newHash
-		return true, uf.fetchChart(shaF)
+	p.mu.Lock()
+	existingHash := p.existingHash
+	p.mu.Unlock()
+	if strings.EqualFold(newHash, existingHash) {
+		return false, nil
 	}
-	return false, nil
-}
 
-func (p *URLPoller) poll(notify chan<- struct{}) {
-	for t := range p.ticker.C {
-		// When the ticker fires
-		log.Debugf("Tick at: %s", t)
-		updated, err := p.checkUpdate()
-		if err != nil {
-			log.Errorf("Error polling charts: %v", err)
+	// The package may already be in the cache, e.g. after a restart of the operator.
+	cp, err := uf.cache.Lookup(path.Base(p.url))
+	if err == nil && cp != nil && strings.EqualFold(cp.Digest, newHash) {
+		if err := uf.verifyCachedSignature(cp); err != nil {
+			return false, err
 		}
-		if updated {
-			notify <- struct{}{}
+	} else {
+		if err := uf.fetchChart(shaF); err != nil {
+			return false, err
 		}
+		cp = uf.Package()
 	}
+	p.mu.Lock()
+	p.existingHash = newHash
+	p.pkg = cp
+	p.mu.Unlock()
+	return true, nil
 }
 
-// NewPoller returns a poller pointing to given url with specified interval
-func NewPoller(installationURL string, destDir string, interval time.Duration) (*URLPoller, error) {
-	uf, err := NewURLFetcher(installationURL, destDir)
+// check runs checkUpdate and records its outcome.
+func (p *URLPoller) check() (bool, error) {
+	updated, err := p.checkUpdate()
+	p.mu.Lock()
+	p.lastCheck = time.Now().UTC()
+	p.lastErr = err
+	p.mu.Unlock()
+	if err != nil {
+		log.Errorf("Error polling install package %s: %v", p.url, err)
+	} else if updated {
+		log.Infof("Fetched updated install package %s with digest %s", p.url, p.Package().Digest)
+	}
+	return updated, err
+}
+
+func (p *URLPoller) poll(onCheck func(updated bool, err error)) {
+	ticker := time.NewTicker(p.Interval())
+	defer func() { ticker.Stop() }()
+	for {
+		select {
+		case <-p.intervalChanged:
+			ticker.Stop()
+			ticker = time.NewTicker(p.Interval())
+		case t := <-ticker.C:
+			// When the ticker fires
+			log.Debugf("Tick at: %s", t)
+			updated, err := p.check()
+			if onCheck != nil {
+				onCheck(updated, err)
+			}
+		case <-p.stop:
+			return
+		}
+	}
+}
+
+// NewPoller returns a poller pointing to given url with specified interval, which stores the packages it fetches in
+// the package cache at cacheDir.
+func NewPoller(installationURL string, cacheDir string, interval time.Duration) (*URLPoller, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("poll interval must be positive, got %s", interval)
+	}
+	uf, err := NewURLFetcher(installationURL, cacheDir)
 	if err != nil {
 		return nil, err
 	}
 	return &URLPoller{
-		url:        installationURL,
-		ticker:     time.NewTicker(time.Minute * interval),
-		urlFetcher: uf,
+		url:             installationURL,
+		interval:        interval,
+		urlFetcher:      uf,
+		stop:            make(chan struct{}),
+		intervalChanged: make(chan struct{}, 1),
 	}, nil
 }
 
-//PollURL continuously polls the given url, which points to a directory containing an
-//installation package at the given interval and fetches a new copy if it is updated.
-func PollURL(installationURL string, interval time.Duration) (chan<- struct{}, error) {
-	destDir, err := ioutil.TempDir("", InstallationDirectory)
-	if err != nil {
-		log.Error("failed to create temp directory for charts")
-		return nil, err
+// Fetcher returns the URLFetcher used by the poller, so that its verification settings can be configured before
+// the poller is started.
+func (p *URLPoller) Fetcher() *URLFetcher {
+	return p.urlFetcher
+}
+
+// Interval returns the time between two checks.
+func (p *URLPoller) Interval() time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.interval
+}
+
+// SetInterval changes the time between two checks. If the poller is running, the next check is one interval after
+// the change.
+func (p *URLPoller) SetInterval(interval time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("poll interval must be positive, got %s", interval)
 	}
+	p.mu.Lock()
+	p.interval = interval
+	p.mu.Unlock()
+	select {
+	case p.intervalChanged <- struct{}{}:
+	default:
+		// A change is already pending and the polling goroutine will read the new interval.
+	}
+	return nil
+}
 
-	po, err := NewPoller(installationURL, destDir, interval)
+// Start checks the url for a package once and then keeps checking it in the background at every interval, calling
+// onCheck, if not nil, after each background check. It returns the error of the first check.
+func (p *URLPoller) Start(onCheck func(updated bool, err error)) error {
+	_, err := p.check()
+	go p.poll(onCheck)
+	return err
+}
+
+// Stop ends background polling.
+func (p *URLPoller) Stop() {
+	close(p.stop)
+}
+
+// Package returns the most recently fetched package, or nil if no package was fetched yet.
+func (p *URLPoller) Package() *CachedPackage {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.pkg
+}
+
+// LastCheck returns the time of the last check and its error.
+func (p *URLPoller) LastCheck() (time.Time, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.lastCheck, p.lastErr
+}
+
+// PollURL continuously polls the given url, which points to a directory containing an
+// installation package at the given interval and fetches a new copy into the default package cache if it is updated.
+// The returned channel receives a value after every update.
+func PollURL(installationURL string, interval time.Duration) (<-chan struct{}, error) {
+	po, err := NewPoller(installationURL, "", interval)
 	if err != nil {
-		log.Fatalf("failed to create new poller for: %s", err)
+		return nil, err
 	}
 	updated := make(chan struct{}, 1)
-	go po.poll(updated)
-
-	os.RemoveAll(destDir)
+	notify := func(u bool, _ error) {
+		if !u {
+			return
+		}
+		select {
+		case updated <- struct{}{}:
+		default:
+			// An update is already pending.
+		}
+	}
+	if err := po.Start(notify); err != nil {
+		log.Errorf("Initial check of install package %s failed: %s", installationURL, err)
+	}
+	notify(po.Package() != nil, nil)
 	return updated, nil
 }
@@ -0,0 +1,136 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestURLPoller(t *testing.T) {
+	tmp, err := ioutil.TempDir("", InstallationDirectory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	serveDir := filepath.Join(tmp, "serve")
+	if err := os.Mkdir(serveDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	server := NewServer(serveDir)
+	defer server.srv.Close()
+	if _, err := server.moveFiles("testdata/*.tar.gz*"); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := NewPoller(server.URL()+"/"+testPackageName, filepath.Join(tmp, "cache"), time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Fetcher().SetInsecureSkipVerify(true)
+	if err := p.Start(nil); err != nil {
+		t.Fatal(err)
+	}
+	defer p.Stop()
+	first := p.Package()
+	if first == nil {
+		t.Fatalf("got no package after first check")
+	}
+	if lastCheck, err := p.LastCheck(); lastCheck.IsZero() || err != nil {
+		t.Errorf("LastCheck: got %s, %v, want a check time and no error", lastCheck, err)
+	}
+
+	if updated, err := p.check(); updated || err != nil {
+		t.Fatalf("check of unchanged package: got %v, %v, want false, nil", updated, err)
+	}
+
+	// Publish a new package. Changing the gzip header modification time gives a different but valid tarball.
+	tarball := filepath.Join(serveDir, testPackageName)
+	b, err := ioutil.ReadFile(tarball)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b[4]++
+	if err := ioutil.WriteFile(tarball, b, 0644); err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256(b)
+	digest := hex.EncodeToString(sum[:])
+	if err := ioutil.WriteFile(tarball+SHAFileSuffix, []byte(digest+"  "+testPackageName), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	updated, err := p.check()
+	if !updated || err != nil {
+		t.Fatalf("check of updated package: got %v, %v, want true, nil", updated, err)
+	}
+	if got := p.Package().Digest; got != digest {
+		t.Errorf("got digest %s, want %s", got, digest)
+	}
+	if _, err := os.Stat(first.Path); err != nil {
+		t.Errorf("previous package was removed from the cache: %s", err)
+	}
+}
+
+func TestURLPollerSetInterval(t *testing.T) {
+	tmp, err := ioutil.TempDir("", InstallationDirectory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	server := NewServer(tmp)
+	defer server.srv.Close()
+	if _, err := server.moveFiles("testdata/*.tar.gz*"); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := NewPoller(server.URL()+"/"+testPackageName, filepath.Join(tmp, "cache"), time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Fetcher().SetInsecureSkipVerify(true)
+	checked := make(chan struct{}, 1)
+	err = p.Start(func(bool, error) {
+		select {
+		case checked <- struct{}{}:
+		default:
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Stop()
+
+	if err := p.SetInterval(0); err == nil {
+		t.Error("got no error for a zero interval, want one")
+	}
+	if err := p.SetInterval(10 * time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if got := p.Interval(); got != 10*time.Millisecond {
+		t.Errorf("got interval %s, want %s", got, 10*time.Millisecond)
+	}
+	select {
+	case <-checked:
+	case <-time.After(5 * time.Second):
+		t.Fatal("got no background check after shortening the interval")
+	}
+}
@@ -84,7 +84,7 @@ type RenderingInput interface {
 	GetTargetNamespace() string
 	// GetProcessingOrder returns a dependency tree for the given manifests. ComponentNameToListMap is a map of
 	// each component to its dependencies. DependencyWaitCh is a map of channels, indexed by name. The component with
-	// the given name must wait on the channel before starting its processing.
+	// the given name must wait on the channel once for each component it depends on before starting its processing.
 	GetProcessingOrder(manifests ChartManifestsMap) (ComponentNameToListMap, DependencyWaitCh)
 }
 
@@ -17,6 +17,7 @@ package helmreconciler
 import (
 	"sync"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -24,6 +25,8 @@ import (
 	"istio.io/api/operator/v1alpha1"
 	iop "istio.io/operator/pkg/apis/istio/v1alpha1"
 	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/policy"
+	"istio.io/operator/pkg/postrender"
 	"istio.io/operator/pkg/util"
 	"istio.io/pkg/log"
 )
@@ -35,12 +38,30 @@ type HelmReconciler struct {
 	customizer         RenderingCustomizer
 	instance           *iop.IstioOperator
 	needUpdateAndPrune bool
+	// restMapper tells which API versions the cluster serves. Rendered objects are not checked against the cluster if
+	// it is nil.
+	restMapper meta.RESTMapper
+	// policy is the guardrail policy that the installation must follow, if any.
+	policy *policy.Policy
+	// strictOverlays fails the reconciliation if overlays, overlay paths or K8S settings match nothing.
+	strictOverlays bool
+	// postRenderer transforms the rendered manifests, if set.
+	postRenderer postrender.PostRenderer
 }
 
 // Factory is a factory for creating HelmReconciler objects using the specified CustomizerFactory.
 type Factory struct {
 	// CustomizerFactory is a factory for creating the Customizer object for the HelmReconciler.
 	CustomizerFactory RenderingCustomizerFactory
+	// RESTMapper is used to check that the cluster serves the API versions of the rendered objects, if it is set.
+	RESTMapper meta.RESTMapper
+	// Policy is the guardrail policy that installations must follow, if it is set.
+	Policy *policy.Policy
+	// StrictOverlays fails the reconciliation of installations with overlays, overlay paths or K8S settings that match
+	// nothing in the rendered manifests. They are logged otherwise.
+	StrictOverlays bool
+	// PostRenderer transforms the rendered manifests of installations before they are applied, if it is set.
+	PostRenderer postrender.PostRenderer
 }
 
 // New Returns a new HelmReconciler for the custom resource.
@@ -56,7 +77,8 @@ func (f *Factory) New(instance *iop.IstioOperator, client client.Client) (*HelmR
 	if err != nil {
 		return nil, err
 	}
-	reconciler := &HelmReconciler{client: client, customizer: wrappedcustomizer, instance: instance, needUpdateAndPrune: true}
+	reconciler := &HelmReconciler{client: client, customizer: wrappedcustomizer, instance: instance, needUpdateAndPrune: true,
+		restMapper: f.RESTMapper, policy: f.Policy, strictOverlays: f.StrictOverlays, postRenderer: f.PostRenderer}
 	wrappedcustomizer.RegisterReconciler(reconciler)
 	return reconciler, nil
 }
@@ -121,10 +143,16 @@ func (h *HelmReconciler) Reconcile() error {
 	return errs.ToError()
 }
 
-// processRecursive processes the given manifests in an order of dependencies defined in h. Dependencies are a tree,
-// where a child must wait for the parent to complete before starting.
+// processRecursive processes the given manifests in an order of dependencies defined in h. Dependencies are a graph,
+// where a child must wait for all its parents to complete before starting.
 func (h *HelmReconciler) processRecursive(manifests ChartManifestsMap) *v1alpha1.InstallStatus {
 	deps, dch := h.customizer.Input().GetProcessingOrder(manifests)
+	numParents := make(map[name.ComponentName]int)
+	for _, children := range deps {
+		for _, c := range children {
+			numParents[c]++
+		}
+	}
 	componentStatus := make(map[string]*v1alpha1.InstallStatus_VersionStatus)
 
 	// mu protects the shared InstallStatus componentStatus across goroutines
@@ -140,7 +168,9 @@ func (h *HelmReconciler) processRecursive(manifests ChartManifestsMap) *v1alpha1
 			cn := name.ComponentName(c)
 			if s := dch[cn]; s != nil {
 				log.Infof("%s is waiting on dependency...", c)
-				<-s
+				for i := 0; i < numParents[cn]; i++ {
+					<-s
+				}
 				log.Infof("Dependency for %s has completed, proceeding.", c)
 			}
 
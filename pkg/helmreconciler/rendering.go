@@ -19,8 +19,11 @@ import (
 	"fmt"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/helm/pkg/manifest"
 	kubectl "k8s.io/kubectl/pkg/util"
@@ -33,6 +36,8 @@ import (
 	istiomanifest "istio.io/operator/pkg/manifest"
 	"istio.io/operator/pkg/name"
 	"istio.io/operator/pkg/object"
+	"istio.io/operator/pkg/policy"
+	"istio.io/operator/pkg/postrender"
 	"istio.io/operator/pkg/translate"
 	"istio.io/operator/pkg/util"
 	"istio.io/operator/pkg/validate"
@@ -55,53 +60,201 @@ func (h *HelmReconciler) renderCharts(in RenderingInput) (ChartManifestsMap, err
 	if err != nil {
 		return nil, err
 	}
+	if err := h.checkRules(iop, mergedIOPS); err != nil {
+		return nil, err
+	}
 
-	t, err := translate.NewTranslator(binversion.OperatorBinaryVersion.MinorVersion)
+	manifests, unmatched, err := RenderManifests(mergedIOPS, h.postRenderer)
 	if err != nil {
 		return nil, err
 	}
+	if err := h.checkOverlays(iop, unmatched); err != nil {
+		return nil, err
+	}
+	if err := h.checkAPIs(iop, manifests); err != nil {
+		return nil, err
+	}
+	if err := h.checkPolicy(iop, mergedIOPS, manifests); err != nil {
+		return nil, err
+	}
+	return toChartManifestsMap(manifests), nil
+}
+
+// RenderManifests renders the manifests of all the components of the IstioOperatorSpec mergedIOPS, which must already
+// be merged with its profile, and transforms them with postRenderer unless it is nil. It also returns the overlays,
+// overlay paths and K8S settings that match nothing in them.
+func RenderManifests(mergedIOPS *v1alpha1.IstioOperatorSpec, postRenderer postrender.PostRenderer) (name.ManifestMap, util.Errors, error) {
+	t, err := translate.NewTranslator(binversion.OperatorBinaryVersion.MinorVersion)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	cp, err := controlplane.NewIstioOperator(mergedIOPS, t)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if err := cp.Run(); err != nil {
-		return nil, fmt.Errorf("failed to create Istio control plane with spec: \n%v\nerror: %s", mergedIOPS, err)
+		return nil, nil, fmt.Errorf("failed to create Istio control plane with spec: \n%v\nerror: %s", mergedIOPS, err)
+	}
+	if postRenderer != nil {
+		cp.SetPostRenderer(postRenderer)
 	}
 
 	manifests, errs := cp.RenderManifest()
-	if errs != nil {
-		err = errs.ToError()
-	}
+	return manifests, cp.Warnings(), errs.ToError()
+}
 
-	return toChartManifestsMap(manifests), err
+// checkRules runs the semantic rules that are not disabled in iop on its merged IstioOperatorSpec mergedIOPS, looking up
+// namespaces in the cluster. Warnings are logged and errors returned.
+func (h *HelmReconciler) checkRules(iop *valuesv1alpha1.IstioOperator, mergedIOPS *v1alpha1.IstioOperatorSpec) error {
+	ds := validate.CheckRules(mergedIOPS, validate.DisabledRules(iop.Annotations), h.namespaceExists)
+	return logDiagnostics(iop, ds, "the configuration breaks validation rules")
 }
 
-// MergeIOPSWithProfile overlays the values in iop on top of the defaults for the profile given by iop.profile and
-// returns the merged result.
-func MergeIOPSWithProfile(iop *v1alpha1.IstioOperatorSpec) (*v1alpha1.IstioOperatorSpec, error) {
-	profile := iop.Profile
+// CheckDeprecatedFields logs the fields set in the spec of iop that are deprecated in the operator version, and
+// returns the fields that are removed in it as errors. It must be called before the spec is merged with its profile,
+// since only the fields set by users can be changed by them.
+func CheckDeprecatedFields(iop *valuesv1alpha1.IstioOperator) error {
+	d, err := translate.NewDeprecations()
+	if err != nil {
+		return err
+	}
+	specYAML, err := util.MarshalWithJSONPB(iop.Spec)
+	if err != nil {
+		return err
+	}
+	ds, err := validate.CheckDeprecatedFields(specYAML, d, binversion.OperatorBinaryVersion.MinorVersion)
+	if err != nil {
+		return err
+	}
+	return logDiagnostics(iop, ds, "the configuration uses removed fields")
+}
 
-	// This contains the IstioOperator CR.
-	baseCRYAML, err := helm.ReadProfileYAML(profile)
+// checkOverlays logs the overlays, overlay paths and K8S settings in unmatched that matched nothing in the rendered
+// manifests of iop, and returns them as errors if the overlays of h are strict. K8S settings are only reported if they
+// are set in the spec of iop.
+func (h *HelmReconciler) checkOverlays(iop *valuesv1alpha1.IstioOperator, unmatched util.Errors) error {
+	if len(unmatched) == 0 {
+		return nil
+	}
+	specYAML, err := util.MarshalWithJSONPB(iop.Spec)
+	if err != nil {
+		return err
+	}
+	ds, err := validate.UnmatchedOverlayDiagnostics(unmatched, specYAML, h.strictOverlays)
 	if err != nil {
-		return nil, fmt.Errorf("could not read the profile values for %s: %s", profile, err)
+		return err
 	}
+	return logDiagnostics(iop, ds, "overlays match nothing in the manifests")
+}
 
-	if !helm.IsDefaultProfile(profile) {
-		// Profile definitions are relative to the default profile, so read that first.
-		dfn, err := helm.DefaultFilenameForProfile(profile)
-		if err != nil {
-			return nil, err
+// checkAPIs logs the objects in manifests with a Kubernetes API version that is deprecated, and returns the objects
+// with an API version that the cluster does not serve as errors.
+func (h *HelmReconciler) checkAPIs(iop *valuesv1alpha1.IstioOperator, manifests name.ManifestMap) error {
+	d, err := translate.NewDeprecations()
+	if err != nil {
+		return err
+	}
+	objs, err := manifestObjects(manifests)
+	if err != nil {
+		return err
+	}
+	var served validate.ServedFunc
+	if h.restMapper != nil {
+		served = h.apiServed
+	}
+	return logDiagnostics(iop, validate.CheckAPIs(objs, d, served), "the manifests use APIs the cluster does not serve")
+}
+
+// checkPolicy logs all the violations of the policy of h by the merged IstioOperatorSpec mergedIOPS of iop and its
+// rendered manifests, and returns an error if a violation of an error rule is not exempted by the annotations of iop.
+func (h *HelmReconciler) checkPolicy(iop *valuesv1alpha1.IstioOperator, mergedIOPS *v1alpha1.IstioOperatorSpec,
+	manifests name.ManifestMap) error {
+	if h.policy == nil {
+		return nil
+	}
+	exempt, err := policy.ExemptionsFromAnnotations(iop.Annotations)
+	if err != nil {
+		return err
+	}
+	objs, err := manifestObjects(manifests)
+	if err != nil {
+		return err
+	}
+	ds, err := h.policy.Check(mergedIOPS, objs, exempt)
+	if err != nil {
+		return err
+	}
+	for _, d := range ds {
+		if d.Severity == validate.SeverityInfo {
+			log.Infof("IstioOperator %s/%s: %s", iop.Namespace, iop.Name, d)
 		}
-		defaultYAML, err := helm.ReadProfileYAML(dfn)
-		if err != nil {
-			return nil, fmt.Errorf("could not read the default profile values for %s: %s", dfn, err)
+	}
+	return logDiagnostics(iop, ds, "the installation violates the policy")
+}
+
+// manifestObjects returns the objects in manifests.
+func manifestObjects(manifests name.ManifestMap) (object.K8sObjects, error) {
+	var objs object.K8sObjects
+	for _, ms := range manifests {
+		for _, m := range ms {
+			mo, err := object.ParseK8sObjectsFromYAMLManifest(m)
+			if err != nil {
+				return nil, err
+			}
+			objs = append(objs, mo...)
 		}
-		baseCRYAML, err = util.OverlayYAML(defaultYAML, baseCRYAML)
-		if err != nil {
-			return nil, fmt.Errorf("could not overlay the profile over the default %s: %s", profile, err)
+	}
+	return objs, nil
+}
+
+// apiServed reports whether the cluster serves kind in apiVersion.
+func (h *HelmReconciler) apiServed(apiVersion, kind string) (bool, error) {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return false, err
+	}
+	_, err = h.restMapper.RESTMapping(gv.WithKind(kind).GroupKind(), gv.Version)
+	if meta.IsNoMatchError(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// logDiagnostics logs the warnings in ds about iop and returns the errors in ds, prefixed with msg.
+func logDiagnostics(iop *valuesv1alpha1.IstioOperator, ds validate.Diagnostics, msg string) error {
+	var errs util.Errors
+	for _, d := range ds {
+		if d.Severity != validate.SeverityError {
+			log.Warnf("IstioOperator %s/%s: %s", iop.Namespace, iop.Name, d)
+			continue
 		}
+		errs = util.AppendErr(errs, fmt.Errorf("%s", d))
+	}
+	if len(errs) != 0 {
+		return fmt.Errorf("%s: %s", msg, errs)
+	}
+	return nil
+}
+
+// namespaceExists reports whether the namespace with the given name exists in the cluster.
+func (h *HelmReconciler) namespaceExists(name string) (bool, error) {
+	err := h.client.Get(context.TODO(), client.ObjectKey{Name: name}, &corev1.Namespace{})
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// MergeIOPSWithProfile overlays the values in iop on top of the defaults for the profile given by iop.profile and
+// returns the merged result.
+func MergeIOPSWithProfile(iop *v1alpha1.IstioOperatorSpec) (*v1alpha1.IstioOperatorSpec, error) {
+	profile := iop.Profile
+
+	// This contains the IstioOperator CR, overlaid on its chain of base profiles.
+	baseCRYAML, err := helm.ReadMergedProfileYAML(profile)
+	if err != nil {
+		return nil, err
 	}
 
 	_, baseYAML, err := unmarshalAndValidateIOP(baseCRYAML)
@@ -227,6 +380,13 @@ func (h *HelmReconciler) ProcessObject(chartName string, obj *unstructured.Unstr
 			namespacedResourceMap[gvk] = true
 		} else if _, ok := nonNamespacedResourceMap[gvk]; ok {
 			nonNamespacedResourceMap[gvk] = true
+		} else if obj.GetLabels()[name.ExtraManifestLabel] == "true" {
+			// Extra manifests may hold kinds that are not rendered from the charts, which are pruned from then on.
+			if obj.GetNamespace() != "" {
+				namespacedResourceMap[gvk] = true
+			} else {
+				nonNamespacedResourceMap[gvk] = true
+			}
 		}
 		pruningDetailsMU.Unlock()
 	}
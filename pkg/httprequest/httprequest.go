@@ -15,36 +15,373 @@
 package httprequest
 
 import (
+	"bufio"
 	"bytes"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"istio.io/pkg/log"
 )
 
-// Get sends an HTTP GET request and returns the result.
-func Get(url string) ([]byte, error) {
-	buf := bytes.NewBuffer(nil)
+const (
+	// TimeoutEnvVar sets the timeout of each HTTP request, as a duration like 30s.
+	TimeoutEnvVar = "ISTIO_HTTP_TIMEOUT"
+	// RetriesEnvVar sets the number of retries of failed HTTP requests.
+	RetriesEnvVar = "ISTIO_HTTP_RETRIES"
+	// CAFileEnvVar sets the path to a PEM bundle of CA certificates to trust in addition to the system ones.
+	CAFileEnvVar = "ISTIO_HTTP_CA_FILE"
+	// CertFileEnvVar sets the path to the PEM client certificate.
+	CertFileEnvVar = "ISTIO_HTTP_CLIENT_CERT"
+	// KeyFileEnvVar sets the path to the PEM client key.
+	KeyFileEnvVar = "ISTIO_HTTP_CLIENT_KEY"
+	// HeadersEnvVar sets extra request headers, as "Name: value" pairs separated by newlines.
+	HeadersEnvVar = "ISTIO_HTTP_HEADERS"
+	// HeadersFileEnvVar sets the path to a file with extra request headers, one "Name: value" pair per line.
+	HeadersFileEnvVar = "ISTIO_HTTP_HEADERS_FILE"
 
-	req, err := http.NewRequest("GET", url, nil)
+	// DefaultTimeout is the default timeout of each HTTP request.
+	DefaultTimeout = 5 * time.Minute
+	// DefaultRetries is the default number of retries of failed HTTP requests.
+	DefaultRetries = 3
+	// DefaultRetryBackoff is the default delay before the first retry, doubled for every following retry.
+	DefaultRetryBackoff = time.Second
+
+	// progressStep is the fraction of a download with a known size after which progress is reported.
+	progressStep = 10
+	// progressUnknownSizeStep is the number of bytes after which progress is reported for downloads of unknown size.
+	progressUnknownSizeStep = 10 << 20
+)
+
+// Options configures a Client.
+type Options struct {
+	// Timeout is the timeout of each request, including reading the response body. Zero means no timeout.
+	Timeout time.Duration
+	// Retries is the number of times a request is retried after a connection error or a 5xx response.
+	Retries int
+	// RetryBackoff is the delay before the first retry, doubled for every following retry.
+	RetryBackoff time.Duration
+	// CAFile is the path to a PEM bundle of CA certificates to trust in addition to the system ones.
+	CAFile string
+	// CertFile and KeyFile are the paths to a PEM client certificate and key.
+	CertFile string
+	KeyFile  string
+	// Headers are extra headers added to every request.
+	Headers map[string]string
+	// HeadersFile is the path to a file with extra headers, one "Name: value" pair per line. It is read on every
+	// request, so that short lived tokens can be rotated without restarting.
+	HeadersFile string
+	// Progress, if set, receives download progress messages.
+	Progress io.Writer
+}
+
+// Client is an HTTP client with timeouts, retries, custom TLS and header injection.
+type Client struct {
+	opts       Options
+	httpClient *http.Client
+}
+
+var (
+	defaultClientMu sync.RWMutex
+	defaultClient   *Client
+)
+
+// DefaultOptions returns the default Options, overridden by any options set through environment variables.
+func DefaultOptions() (*Options, error) {
+	opts := &Options{
+		Timeout:      DefaultTimeout,
+		Retries:      DefaultRetries,
+		RetryBackoff: DefaultRetryBackoff,
+		CAFile:       os.Getenv(CAFileEnvVar),
+		CertFile:     os.Getenv(CertFileEnvVar),
+		KeyFile:      os.Getenv(KeyFileEnvVar),
+		HeadersFile:  os.Getenv(HeadersFileEnvVar),
+	}
+	if v := os.Getenv(TimeoutEnvVar); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("bad %s value %q: %s", TimeoutEnvVar, v, err)
+		}
+		opts.Timeout = d
+	}
+	if v := os.Getenv(RetriesEnvVar); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("bad %s value %q, must be a non-negative integer", RetriesEnvVar, v)
+		}
+		opts.Retries = n
+	}
+	if v := os.Getenv(HeadersEnvVar); v != "" {
+		h, err := ParseHeaders(v)
+		if err != nil {
+			return nil, fmt.Errorf("bad %s value: %s", HeadersEnvVar, err)
+		}
+		opts.Headers = h
+	}
+	return opts, nil
+}
+
+// NewClient creates a Client with the given options and returns a pointer to it.
+func NewClient(opts *Options) (*Client, error) {
+	tlsConfig := &tls.Config{}
+	if opts.CAFile != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		b, err := ioutil.ReadFile(opts.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %s", err)
+		}
+		if !pool.AppendCertsFromPEM(b) {
+			return nil, fmt.Errorf("no PEM certificates found in CA file %s", opts.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if opts.CertFile != "" || opts.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(opts.CertFile, opts.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	if opts.HeadersFile != "" {
+		// Fail early on a bad file rather than on the first request.
+		if _, err := readHeadersFile(opts.HeadersFile); err != nil {
+			return nil, err
+		}
+	}
+	return &Client{
+		opts: *opts,
+		httpClient: &http.Client{
+			Timeout: opts.Timeout,
+			Transport: &http.Transport{
+				DisableCompression: true,
+				Proxy:              http.ProxyFromEnvironment,
+				TLSClientConfig:    tlsConfig,
+			},
+		},
+	}, nil
+}
+
+// DefaultClient returns the client used by Get, creating it from DefaultOptions if it has not been set.
+func DefaultClient() (*Client, error) {
+	defaultClientMu.RLock()
+	c := defaultClient
+	defaultClientMu.RUnlock()
+	if c != nil {
+		return c, nil
+	}
+	opts, err := DefaultOptions()
 	if err != nil {
 		return nil, err
 	}
+	c, err = NewClient(opts)
+	if err != nil {
+		return nil, err
+	}
+	SetDefaultClient(c)
+	return c, nil
+}
 
-	client := &http.Client{
-		Transport: &http.Transport{
-			DisableCompression: true,
-			Proxy:              http.ProxyFromEnvironment,
-		}}
+// SetDefaultClient sets the client used by Get.
+func SetDefaultClient(c *Client) {
+	defaultClientMu.Lock()
+	defer defaultClientMu.Unlock()
+	defaultClient = c
+}
 
-	resp, err := client.Do(req)
+// Get sends an HTTP GET request with the default client and returns the result.
+func Get(url string) ([]byte, error) {
+	c, err := DefaultClient()
 	if err != nil {
 		return nil, err
 	}
+	return c.Get(url)
+}
+
+// Get sends an HTTP GET request and returns the result.
+func (c *Client) Get(url string) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	if err := c.get(url, func(resp *http.Response) error {
+		buf.Reset()
+		_, err := io.Copy(buf, resp.Body)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GetToFile sends an HTTP GET request and streams the result to the file at path, reporting progress if the
+// client has a progress writer. The file is only created once the download completes.
+func (c *Client) GetToFile(url, path string) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".download")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	if err := c.get(url, func(resp *http.Response) error {
+		if err := tmp.Truncate(0); err != nil {
+			return err
+		}
+		if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		var w io.Writer = tmp
+		if c.opts.Progress != nil {
+			w = io.MultiWriter(tmp, &progressWriter{
+				out:   c.opts.Progress,
+				name:  filepath.Base(path),
+				total: resp.ContentLength,
+			})
+		}
+		_, err := io.Copy(w, resp.Body)
+		return err
+	}); err != nil {
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// get sends an HTTP GET request, retrying on connection errors, body read errors and 5xx responses, and passes
+// every successful response to handle.
+func (c *Client) get(url string, handle func(resp *http.Response) error) error {
+	backoff := c.opts.RetryBackoff
+	var err error
+	for attempt := 0; ; attempt++ {
+		var retryable bool
+		retryable, err = c.try(url, handle)
+		if err == nil || !retryable || attempt >= c.opts.Retries {
+			return err
+		}
+		log.Warnf("Retrying request to %s in %s after error: %s", url, backoff, err)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// try sends a single request and reports whether a failure is worth retrying.
+func (c *Client) try(url string, handle func(resp *http.Response) error) (bool, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return false, err
+	}
+	if err := c.addHeaders(req); err != nil {
+		return false, err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return true, err
+	}
 	defer resp.Body.Close()
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("failed to fetch URL %s : %s", url, resp.Status)
+	if resp.StatusCode != http.StatusOK {
+		return resp.StatusCode >= 500, fmt.Errorf("failed to fetch URL %s : %s", url, resp.Status)
+	}
+	if err := handle(resp); err != nil {
+		return true, fmt.Errorf("failed to read response from URL %s : %s", url, err)
+	}
+	return false, nil
+}
+
+func (c *Client) addHeaders(req *http.Request) error {
+	for k, v := range c.opts.Headers {
+		req.Header.Set(k, v)
+	}
+	if c.opts.HeadersFile == "" {
+		return nil
 	}
+	h, err := readHeadersFile(c.opts.HeadersFile)
+	if err != nil {
+		return err
+	}
+	for k, v := range h {
+		req.Header.Set(k, v)
+	}
+	return nil
+}
+
+// ParseHeaders parses "Name: value" pairs, one per line. Empty lines and lines starting with # are ignored.
+func ParseHeaders(s string) (map[string]string, error) {
+	out := make(map[string]string)
+	scanner := bufio.NewScanner(strings.NewReader(s))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		kv := strings.SplitN(line, ":", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+			return nil, fmt.Errorf("bad header %q, expect format Name: value", line)
+		}
+		out[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+	return out, scanner.Err()
+}
+
+func readHeadersFile(path string) (map[string]string, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read headers file: %s", err)
+	}
+	h, err := ParseHeaders(string(b))
+	if err != nil {
+		return nil, fmt.Errorf("bad headers file %s: %s", path, err)
+	}
+	return h, nil
+}
+
+// progressWriter reports the progress of a download every progressStep percent, or every progressUnknownSizeStep
+// bytes if the total size is unknown.
+type progressWriter struct {
+	out      io.Writer
+	name     string
+	total    int64
+	written  int64
+	reported int64
+}
 
-	_, err = io.Copy(buf, resp.Body)
-	return buf.Bytes(), err
+func (p *progressWriter) Write(b []byte) (int, error) {
+	p.written += int64(len(b))
+	switch {
+	case p.total > 0:
+		pct := p.written * 100 / p.total
+		if pct/progressStep > p.reported/progressStep {
+			_, _ = fmt.Fprintf(p.out, "Downloading %s: %s / %s (%d%%)\n", p.name, byteCount(p.written),
+				byteCount(p.total), pct)
+			p.reported = pct
+		}
+	case p.written-p.reported >= progressUnknownSizeStep:
+		_, _ = fmt.Fprintf(p.out, "Downloading %s: %s\n", p.name, byteCount(p.written))
+		p.reported = p.written
+	}
+	return len(b), nil
+}
+
+// byteCount formats a number of bytes in human readable form.
+func byteCount(b int64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+	div, exp := int64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(b)/float64(div), "KMGTPE"[exp])
 }
@@ -0,0 +1,232 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httprequest
+
+import (
+	"bytes"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetRetries(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	tests := []struct {
+		desc      string
+		retries   int
+		wantErr   bool
+		wantCalls int32
+	}{
+		{
+			desc:      "not enough retries",
+			retries:   1,
+			wantErr:   true,
+			wantCalls: 2,
+		},
+		{
+			desc:      "succeeds after retries",
+			retries:   2,
+			wantCalls: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			atomic.StoreInt32(&calls, 0)
+			c, err := NewClient(&Options{Retries: tt.retries, RetryBackoff: time.Millisecond})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := c.Get(srv.URL)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error: %v, want error: %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && string(got) != "ok" {
+				t.Errorf("got body %q, want ok", got)
+			}
+			if n := atomic.LoadInt32(&calls); n != tt.wantCalls {
+				t.Errorf("got %d calls, want %d", n, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestGetNoRetryOnClientError(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(&Options{Retries: 3, RetryBackoff: time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get(srv.URL); err == nil {
+		t.Fatalf("got no error for 404 response")
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("got %d calls, want 1", n)
+	}
+}
+
+func TestGetHeaders(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.Header.Get("Authorization") + "," + r.Header.Get("X-Extra")))
+	}))
+	defer srv.Close()
+
+	tmp, err := ioutil.TempDir("", "httprequest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	headersFile := filepath.Join(tmp, "headers")
+	if err := ioutil.WriteFile(headersFile, []byte("# token\nAuthorization: Bearer abc\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := NewClient(&Options{Headers: map[string]string{"X-Extra": "1"}, HeadersFile: headersFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := c.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Bearer abc,1"; string(got) != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	// The headers file is read again on every request.
+	if err := ioutil.WriteFile(headersFile, []byte("Authorization: Bearer def\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	got, err = c.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Bearer def,1"; string(got) != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestGetCAFile(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(&Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get(srv.URL); err == nil {
+		t.Fatalf("got no error for untrusted server certificate")
+	}
+
+	tmp, err := ioutil.TempDir("", "httprequest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	caFile := filepath.Join(tmp, "ca.pem")
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	if err := ioutil.WriteFile(caFile, ca, 0600); err != nil {
+		t.Fatal(err)
+	}
+	c, err = NewClient(&Options{CAFile: caFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get(srv.URL); err != nil {
+		t.Errorf("got error with CA file: %s", err)
+	}
+}
+
+func TestGetTimeout(t *testing.T) {
+	done := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer srv.Close()
+	defer close(done)
+
+	c, err := NewClient(&Options{Timeout: 50 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get(srv.URL); err == nil {
+		t.Errorf("got no error for request exceeding the timeout")
+	}
+}
+
+func TestGetToFile(t *testing.T) {
+	body := bytes.Repeat([]byte("x"), 1000)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "1000")
+		_, _ = w.Write(body)
+	}))
+	defer srv.Close()
+
+	tmp, err := ioutil.TempDir("", "httprequest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	progress := &bytes.Buffer{}
+	c, err := NewClient(&Options{Progress: progress})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(tmp, "package.tar.gz")
+	if err := c.GetToFile(srv.URL, path); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, body) {
+		t.Errorf("got %d bytes, want %d", len(got), len(body))
+	}
+	if !strings.Contains(progress.String(), "(100%)") {
+		t.Errorf("got progress %q, want it to report completion", progress.String())
+	}
+	fis, err := ioutil.ReadDir(tmp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fis) != 1 {
+		t.Errorf("got %d files in download dir, want only the downloaded file", len(fis))
+	}
+}
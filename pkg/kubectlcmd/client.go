@@ -32,6 +32,12 @@ func New() *Client {
 	return &Client{cmdSite: &console{}}
 }
 
+// NewWithRunner creates a Client that runs the kubectl commands with run instead of executing them. It is meant for
+// tests, which can check the commands and write their output to the Stdout of the command.
+func NewWithRunner(run func(*exec.Cmd) error) *Client {
+	return &Client{cmdSite: runnerFunc(run)}
+}
+
 // Client provides an interface to kubectl
 type Client struct {
 	cmdSite commandSite
@@ -97,6 +103,18 @@ func (c *Client) GetAll(opts *Options) (string, string, error) {
 	return c.kubectl([]string{"get", "all"}, opts)
 }
 
+// Get runs the `kubectl get` command for resources, a comma separated list of resource types, with the given options.
+// It returns stdout, stderr from the `kubectl` command as strings, and error for errors external to kubectl.
+func (c *Client) Get(resources string, opts *Options) (string, string, error) {
+	return c.kubectl([]string{"get", resources}, opts)
+}
+
+// APIResources runs the `kubectl api-resources` command with the given options.
+// It returns stdout, stderr from the `kubectl` command as strings, and error for errors external to kubectl.
+func (c *Client) APIResources(opts *Options) (string, string, error) {
+	return c.kubectl([]string{"api-resources"}, opts)
+}
+
 // GetConfigMap runs the `kubectl get cm` command with the given options.
 // name - name of the config map to get
 // It returns stdout, stderr from the `kubectl` command as strings, and error for errors external to kubectl.
@@ -173,3 +191,10 @@ type console struct {
 func (console) Run(c *exec.Cmd) error {
 	return c.Run()
 }
+
+// runnerFunc is a commandSite that runs commands with a function.
+type runnerFunc func(*exec.Cmd) error
+
+func (f runnerFunc) Run(c *exec.Cmd) error {
+	return f(c)
+}
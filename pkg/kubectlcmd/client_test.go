@@ -256,3 +256,68 @@ func TestKubectlGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestKubectlGet(t *testing.T) {
+	tests := []struct {
+		name       string
+		resources  string
+		namespace  string
+		args       []string
+		err        error
+		expectArgs []string
+	}{
+		{
+			name:       "default",
+			resources:  "configmaps,gateways.networking.istio.io",
+			expectArgs: []string{"kubectl", "get", "configmaps,gateways.networking.istio.io"},
+		},
+		{
+			name:       "selector",
+			resources:  "configmaps",
+			args:       []string{"--all-namespaces", "--selector", "app=foo"},
+			expectArgs: []string{"kubectl", "get", "configmaps", "--all-namespaces", "--selector", "app=foo"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cs := collector{Error: test.err}
+			kubectl := &Client{cmdSite: &cs}
+			opts := &Options{
+				Namespace: test.namespace,
+				ExtraArgs: test.args,
+			}
+			_, _, err := kubectl.Get(test.resources, opts)
+
+			if test.err != nil && err == nil {
+				t.Error("expected error to occur")
+			} else if test.err == nil && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if len(cs.Cmds) != 1 {
+				t.Errorf("expected 1 command to be invoked, got: %d", len(cs.Cmds))
+			}
+
+			cmd := cs.Cmds[0]
+			if !reflect.DeepEqual(cmd.Args, test.expectArgs) {
+				t.Errorf("argument mistmatch, expected: %v, got: %v", test.expectArgs, cmd.Args)
+			}
+		})
+	}
+}
+
+func TestKubectlAPIResources(t *testing.T) {
+	cs := collector{}
+	kubectl := &Client{cmdSite: &cs}
+	if _, _, err := kubectl.APIResources(&Options{Output: "name", ExtraArgs: []string{"--verbs=list,delete"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cs.Cmds) != 1 {
+		t.Fatalf("expected 1 command to be invoked, got: %d", len(cs.Cmds))
+	}
+	expectArgs := []string{"kubectl", "api-resources", "-o", "name", "--verbs=list,delete"}
+	if !reflect.DeepEqual(cs.Cmds[0].Args, expectArgs) {
+		t.Errorf("argument mistmatch, expected: %v, got: %v", expectArgs, cs.Cmds[0].Args)
+	}
+}
@@ -18,7 +18,9 @@ import (
 	"fmt"
 
 	"github.com/docker/distribution/reference"
+	goversion "github.com/hashicorp/go-version"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/rest"
 
 	"istio.io/operator/pkg/util"
@@ -45,6 +47,7 @@ func (cv ComponentVersion) String() string {
 // ExecClient is an interface for remote execution
 type ExecClient interface {
 	GetIstioVersions(namespace string) ([]ComponentVersion, error)
+	GetKubernetesVersion() (*goversion.Version, error)
 	GetPods(namespace string, params map[string]string) (*v1.PodList, error)
 	PodsForSelector(namespace, labelSelector string) (*v1.PodList, error)
 	ConfigMapForSelector(namespace, labelSelector string) (*v1.ConfigMapList, error)
@@ -113,6 +116,30 @@ func (client *Client) GetIstioVersions(namespace string) ([]ComponentVersion, er
 	return res, errs.ToError()
 }
 
+// GetKubernetesVersion returns the version of the Kubernetes API server, without any vendor suffix such as -gke.1.
+func (client *Client) GetKubernetesVersion() (*goversion.Version, error) {
+	dc, err := discovery.NewDiscoveryClientForConfig(client.Config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create discovery client, error: %v", err)
+	}
+	info, err := dc.ServerVersion()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve Kubernetes version, error: %v", err)
+	}
+	return parseKubernetesVersion(info.GitVersion)
+}
+
+// parseKubernetesVersion parses a Kubernetes git version like v1.15.4-gke.22 into a plain major.minor.patch version,
+// so that it can be checked against version constraints.
+func parseKubernetesVersion(gitVersion string) (*goversion.Version, error) {
+	v, err := goversion.NewVersion(gitVersion)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse Kubernetes version %s, error: %v", gitVersion, err)
+	}
+	s := v.Segments()
+	return goversion.NewVersion(fmt.Sprintf("%d.%d.%d", s[0], s[1], s[2]))
+}
+
 func parseTag(image string) (string, error) {
 	ref, err := reference.Parse(image)
 	if err != nil {
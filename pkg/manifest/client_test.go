@@ -0,0 +1,63 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"testing"
+)
+
+func TestParseKubernetesVersion(t *testing.T) {
+	tests := []struct {
+		gitVersion string
+		want       string
+		wantErr    bool
+	}{
+		{
+			gitVersion: "v1.15.3",
+			want:       "1.15.3",
+		},
+		{
+			gitVersion: "v1.15.3-gke.1",
+			want:       "1.15.3",
+		},
+		{
+			gitVersion: "v1.14.10-eks-bac369",
+			want:       "1.14.10",
+		},
+		{
+			gitVersion: "v1.16.2+k3s.1",
+			want:       "1.16.2",
+		},
+		{
+			gitVersion: "1.13.0",
+			want:       "1.13.0",
+		},
+		{
+			gitVersion: "not-a-version",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.gitVersion, func(t *testing.T) {
+			got, err := parseKubernetesVersion(tt.gitVersion)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseKubernetesVersion(%s): got error %v, wantErr %v", tt.gitVersion, err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("parseKubernetesVersion(%s): got %s, want %s", tt.gitVersion, got, tt.want)
+			}
+		})
+	}
+}
@@ -29,12 +29,15 @@ import (
 	v1 "k8s.io/api/core/v1"
 	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 
@@ -72,6 +75,12 @@ var (
 	istioComponentLabelStr = name.OperatorAPINamespace + "/component"
 	// istioVersionLabelStr indicates the Istio version of the installation.
 	istioVersionLabelStr = name.OperatorAPINamespace + "/version"
+
+	// InstallPackageDigestAnnotationStr records the SHA256 digest of the install package a resource was rendered from.
+	InstallPackageDigestAnnotationStr = name.OperatorAPINamespace + "/install-package-digest"
+	// InstallPackageSignerAnnotationStr records the identity of the key that signed the install package a resource
+	// was rendered from.
+	InstallPackageSignerAnnotationStr = name.OperatorAPINamespace + "/install-package-signer"
 )
 
 // ComponentApplyOutput is used to capture errors and stdout/stderr outputs for a command, per component.
@@ -115,9 +124,8 @@ var (
 		},
 	}
 
-	installTree      = make(componentTree)
-	dependencyWaitCh = make(map[name.ComponentName]chan struct{})
-	kubectl          = kubectlcmd.New()
+	installTree = make(componentTree)
+	kubectl     = kubectlcmd.New()
 
 	k8sRESTConfig     *rest.Config
 	currentKubeconfig string
@@ -126,12 +134,6 @@ var (
 
 func init() {
 	buildInstallTree()
-	for _, parent := range componentDependencies {
-		for _, child := range parent {
-			dependencyWaitCh[child] = make(chan struct{}, 1)
-		}
-	}
-
 }
 
 // ParseK8SYAMLToIstioOperatorSpec parses a IstioOperator CustomResource YAML string and unmarshals in into
@@ -159,7 +161,7 @@ func ParseK8SYAMLToIstioOperatorSpec(yml string) (*v1alpha1.IstioOperatorSpec, *
 
 // RenderToDir writes manifests to a local filesystem directory tree.
 func RenderToDir(manifests name.ManifestMap, outputDir string, dryRun bool) error {
-	logAndPrint("Component dependencies tree: \n%s", installTreeString())
+	logAndPrint("Component dependencies tree: \n%s", installTreeString(componentDependencies))
 	logAndPrint("Rendering manifests to output dir %s", outputDir)
 	return renderRecursive(manifests, installTree, outputDir, dryRun)
 }
@@ -196,20 +198,64 @@ func renderRecursive(manifests name.ManifestMap, installTree componentTree, outp
 	return nil
 }
 
-// ApplyAll applies all given manifests using kubectl client.
-func ApplyAll(manifests name.ManifestMap, version pkgversion.Version, opts *kubectlcmd.Options) (CompositeOutput, error) {
+// ApplyAll applies all given manifests using kubectl client. Addon components are applied once all the components in
+// addonsAfter that have manifests are applied, or after Base if there are none.
+func ApplyAll(manifests name.ManifestMap, version pkgversion.Version, opts *kubectlcmd.Options,
+	addonsAfter []name.ComponentName) (CompositeOutput, error) {
 	log.Infof("Preparing manifests for these components:")
 	for c := range manifests {
 		log.Infof("- %s", c)
 	}
-	log.Infof("Component dependencies tree: \n%s", installTreeString())
+	deps := installDependencies(manifests, addonsAfter)
+	log.Infof("Component dependencies tree: \n%s", installTreeString(deps))
 	if err := InitK8SRestClient(opts.Kubeconfig, opts.Context); err != nil {
 		return nil, err
 	}
-	return applyRecursive(manifests, version, opts)
+	return applyRecursive(manifests, version, opts, deps)
 }
 
-func applyRecursive(manifests name.ManifestMap, version pkgversion.Version, opts *kubectlcmd.Options) (CompositeOutput, error) {
+// installDependencies returns the components that each component in manifests must be applied before: the default
+// dependencies, with addons moved from Base to the components in addonsAfter that have manifests.
+func installDependencies(manifests name.ManifestMap, addonsAfter []name.ComponentName) componentNameToListMap {
+	var parents []name.ComponentName
+	for _, p := range addonsAfter {
+		if _, ok := manifests[p]; ok {
+			parents = append(parents, p)
+		}
+	}
+	if len(parents) == 0 {
+		return componentDependencies
+	}
+	out := make(componentNameToListMap)
+	for p, children := range componentDependencies {
+		for _, c := range children {
+			if c != name.AddonComponentName {
+				out[p] = append(out[p], c)
+			}
+		}
+	}
+	for _, p := range parents {
+		out[p] = append(out[p], name.AddonComponentName)
+	}
+	return out
+}
+
+func applyRecursive(manifests name.ManifestMap, version pkgversion.Version, opts *kubectlcmd.Options,
+	deps componentNameToListMap) (CompositeOutput, error) {
+	// Each component waits for a signal from each of its parents that has manifests.
+	dependencyWaitCh := make(map[name.ComponentName]chan struct{})
+	numParents := make(map[name.ComponentName]int)
+	for p, children := range deps {
+		if _, ok := manifests[p]; !ok {
+			continue
+		}
+		for _, c := range children {
+			numParents[c]++
+		}
+	}
+	for c, n := range numParents {
+		dependencyWaitCh[c] = make(chan struct{}, n)
+	}
 	var wg sync.WaitGroup
 	var mu sync.Mutex
 	out := CompositeOutput{}
@@ -221,7 +267,9 @@ func applyRecursive(manifests name.ManifestMap, version pkgversion.Version, opts
 		go func() {
 			if s := dependencyWaitCh[c]; s != nil {
 				log.Infof("%s is waiting on a prerequisite...", c)
-				<-s
+				for i := 0; i < numParents[c]; i++ {
+					<-s
+				}
 				log.Infof("Prerequisite for %s has completed, proceeding with install.", c)
 			}
 			applyOut, appliedObjects := ApplyManifest(c, strings.Join(m, helm.YAMLSeparator), version.String(), *opts)
@@ -231,7 +279,7 @@ func applyRecursive(manifests name.ManifestMap, version pkgversion.Version, opts
 			mu.Unlock()
 
 			// Signal all the components that depend on us.
-			for _, ch := range componentDependencies[c] {
+			for _, ch := range deps[c] {
 				log.Infof("unblocking child %s.", ch)
 				dependencyWaitCh[ch] <- struct{}{}
 			}
@@ -245,6 +293,28 @@ func applyRecursive(manifests name.ManifestMap, version pkgversion.Version, opts
 	return out, nil
 }
 
+// AnnotateManifests adds the given annotations to every object in manifests.
+func AnnotateManifests(manifests name.ManifestMap, annotations map[string]string) error {
+	for cn, ms := range manifests {
+		for i, m := range ms {
+			objects, err := object.ParseK8sObjectsFromYAMLManifest(m)
+			if err != nil {
+				return err
+			}
+			if len(objects) == 0 {
+				continue
+			}
+			for _, o := range objects {
+				o.AddAnnotations(annotations)
+			}
+			if manifests[cn][i], err = objects.YAMLManifest(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func ApplyManifest(componentName name.ComponentName, manifestStr, version string,
 	opts kubectlcmd.Options) (*ComponentApplyOutput, object.K8sObjects) {
 	stdout, stderr := "", ""
@@ -273,6 +343,8 @@ func ApplyManifest(componentName name.ComponentName, manifestStr, version string
 			return buildComponentApplyOutput(stdout, stderr, appliedObjects, err), appliedObjects
 		}
 		if len(items) == 0 {
+			// `kubectl get all` only lists the default kinds, so extra manifests of other kinds may remain.
+			stdout, stderr, err = pruneExtraManifests(componentName, nil, opts, stdout, stderr)
 			return buildComponentApplyOutput(stdout, stderr, appliedObjects, err), appliedObjects
 		}
 
@@ -292,6 +364,7 @@ func ApplyManifest(componentName name.ComponentName, manifestStr, version string
 		}
 		appliedObjects = append(appliedObjects, delObjects...)
 		logAndPrint("✔ Finished pruning objects for disabled component %s.", componentName)
+		stdout, stderr, err = pruneExtraManifests(componentName, nil, opts, stdout, stderr)
 		return buildComponentApplyOutput(stdout, stderr, appliedObjects, err), appliedObjects
 	}
 
@@ -343,9 +416,70 @@ func ApplyManifest(componentName name.ComponentName, manifestStr, version string
 		return buildComponentApplyOutput(stdout, stderr, appliedObjects, err), appliedObjects
 	}
 	appliedObjects = append(appliedObjects, nonNsCrdObjects...)
+	if opts.Prune == nil || *opts.Prune {
+		// The extra manifests are pruned on their own, since `kubectl --prune` only prunes the default kinds and the
+		// extra manifests may hold other kinds, such as Gateways, and since the other resources of Base are not pruned.
+		stdout, stderr, err = pruneExtraManifests(componentName, objects, opts, stdout, stderr)
+	}
 	return buildComponentApplyOutput(stdout, stderr, appliedObjects, err), appliedObjects
 }
 
+// pruneExtraManifests deletes the resources of the extra manifests of componentName that are in the cluster but not in
+// objects. All the resource types that can be listed are searched, since a type may no longer be in objects.
+func pruneExtraManifests(componentName name.ComponentName, objects object.K8sObjects, opts kubectlcmd.Options,
+	stdout, stderr string) (string, string, error) {
+	opts.Prune = nil
+	resOpts := opts
+	resOpts.Output = "name"
+	resOpts.ExtraArgs = []string{"--verbs=list,delete"}
+	stdoutRes, stderrRes, err := kubectl.APIResources(&resOpts)
+	if err != nil {
+		return stdout + "\n" + stdoutRes, stderr + "\n" + stderrRes, err
+	}
+	resources := strings.Fields(stdoutRes)
+	if len(resources) == 0 {
+		return stdout, stderr, nil
+	}
+	getOpts := opts
+	getOpts.Output = "yaml"
+	getOpts.ExtraArgs = []string{"--all-namespaces", "--selector",
+		fmt.Sprintf("%s=%s,%s=true", istioComponentLabelStr, componentName, name.ExtraManifestLabel)}
+	stdoutGet, stderrGet, err := kubectl.Get(strings.Join(resources, ","), &getOpts)
+	if err != nil {
+		return stdout + "\n" + stdoutGet, stderr + "\n" + stderrGet, err
+	}
+	items, err := GetKubectlGetItems(stdoutGet)
+	if err != nil {
+		return stdout, stderr, err
+	}
+	current := objects.ToMap()
+	var stale object.K8sObjects
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return stdout, stderr, fmt.Errorf("`kubectl get` returned an item that is not an object")
+		}
+		o := object.NewK8sObject(&unstructured.Unstructured{Object: m}, nil, nil)
+		// Resources without a namespace in the manifest are in the namespace kubectl applied them to.
+		if current[o.Hash()] != nil || current[object.Hash(o.Kind, "", o.Name)] != nil {
+			continue
+		}
+		stale = append(stale, o)
+	}
+	if len(stale) == 0 {
+		return stdout, stderr, nil
+	}
+	logAndPrint("- Pruning removed extra manifests of component %s...", componentName)
+	mns, err := stale.JSONManifest()
+	if err != nil {
+		return stdout, stderr, err
+	}
+	delOpts := opts
+	delOpts.ExtraArgs = nil
+	stdoutDel, stderrDel, err := kubectl.Delete(mns, &delOpts)
+	return stdout + "\n" + stdoutDel, stderr + "\n" + stderrDel, err
+}
+
 func GetKubectlGetItems(stdoutGet string) ([]interface{}, error) {
 	yamlGet := make(map[string]interface{})
 	err := yaml.Unmarshal([]byte(stdoutGet), &yamlGet)
@@ -385,6 +519,49 @@ func DeploymentExists(kubeconfig, context, namespace, name string) (bool, error)
 	return d != nil, nil
 }
 
+// NamespaceExists reports whether the namespace with the given name exists in the cluster.
+func NamespaceExists(kubeconfig, context, name string) (bool, error) {
+	if err := InitK8SRestClient(kubeconfig, context); err != nil {
+		return false, err
+	}
+
+	cs, err := kubernetes.NewForConfig(k8sRESTConfig)
+	if err != nil {
+		return false, fmt.Errorf("k8s client error: %s", err)
+	}
+
+	_, err = cs.CoreV1().Namespaces().Get(name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// APIServed reports whether the cluster serves kind in apiVersion, according to its discovery API.
+func APIServed(kubeconfig, context, apiVersion, kind string) (bool, error) {
+	if err := InitK8SRestClient(kubeconfig, context); err != nil {
+		return false, err
+	}
+
+	dc, err := discovery.NewDiscoveryClientForConfig(k8sRESTConfig)
+	if err != nil {
+		return false, fmt.Errorf("failed to create discovery client, error: %v", err)
+	}
+	resources, err := dc.ServerResourcesForGroupVersion(apiVersion)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	for _, r := range resources.APIResources {
+		if r.Kind == kind {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func applyObjects(objs object.K8sObjects, opts *kubectlcmd.Options, stdout, stderr string) (string, string, error) {
 	if len(objs) == 0 {
 		return stdout, stderr, nil
@@ -493,6 +670,9 @@ func waitForCRDs(objects object.K8sObjects, dryRun bool) error {
 		log.Info("Not waiting for CRDs in dry run mode.")
 		return nil
 	}
+	if len(objects) == 0 {
+		return nil
+	}
 
 	log.Info("Waiting for CRDs to be applied.")
 	cs, err := apiextensionsclient.NewForConfig(k8sRESTConfig)
@@ -548,6 +728,9 @@ func waitForResources(objects object.K8sObjects, opts *kubectlcmd.Options) error
 		logAndPrint("Not waiting for resources ready in dry run mode.")
 		return nil
 	}
+	if len(objects) == 0 {
+		return nil
+	}
 
 	cs, err := kubernetes.NewForConfig(k8sRESTConfig)
 	if err != nil {
@@ -734,19 +917,16 @@ func insertChildrenRecursive(componentName name.ComponentName, tree componentTre
 	}
 }
 
-func installTreeString() string {
+func installTreeString(deps componentNameToListMap) string {
 	var sb strings.Builder
-	buildInstallTreeString(name.IstioBaseComponentName, "", &sb)
+	buildInstallTreeString(name.IstioBaseComponentName, "", deps, &sb)
 	return sb.String()
 }
 
-func buildInstallTreeString(componentName name.ComponentName, prefix string, sb io.StringWriter) {
+func buildInstallTreeString(componentName name.ComponentName, prefix string, deps componentNameToListMap, sb io.StringWriter) {
 	_, _ = sb.WriteString(prefix + string(componentName) + "\n")
-	if _, ok := installTree[componentName].(componentTree); !ok {
-		return
-	}
-	for k := range installTree[componentName].(componentTree) {
-		buildInstallTreeString(k, prefix+"  ", sb)
+	for _, k := range deps[componentName] {
+		buildInstallTreeString(k, prefix+"  ", deps, sb)
 	}
 }
 
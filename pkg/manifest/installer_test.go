@@ -0,0 +1,118 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+	"testing"
+
+	"istio.io/operator/pkg/kubectlcmd"
+	"istio.io/operator/pkg/name"
+)
+
+// extraGatewayYAML returns the YAML of a Gateway in the extra manifests of the ingress gateway component.
+func extraGatewayYAML(gwName string) string {
+	return fmt.Sprintf(`apiVersion: networking.istio.io/v1alpha3
+kind: Gateway
+metadata:
+  name: %s
+  namespace: istio-system
+  labels:
+    %s: %s
+    %s: "true"
+spec:
+  selector:
+    istio: ingressgateway
+`, gwName, istioComponentLabelStr, name.IngressComponentName, name.ExtraManifestLabel)
+}
+
+// fakeCluster stubs the kubectl commands run when applying a manifest without waiting and pruning its extra manifests. It lists the objects in items and records
+// the names of the objects deleted.
+type fakeCluster struct {
+	items   []string
+	deleted []string
+}
+
+func (f *fakeCluster) run(c *exec.Cmd) error {
+	switch {
+	case c.Args[1] == "apply":
+		return nil
+	case c.Args[1] == "api-resources":
+		_, err := fmt.Fprintln(c.Stdout, "configmaps\ngateways.networking.istio.io")
+		return err
+	case c.Args[1] == "get" && c.Args[2] == "all":
+		_, err := fmt.Fprint(c.Stdout, "apiVersion: v1\nkind: List\nitems: []\n")
+		return err
+	case c.Args[1] == "get":
+		var items []string
+		for _, i := range f.items {
+			items = append(items, "- "+strings.Replace(strings.TrimSpace(i), "\n", "\n  ", -1))
+		}
+		_, err := fmt.Fprintf(c.Stdout, "apiVersion: v1\nkind: List\nitems:\n%s\n", strings.Join(items, "\n"))
+		return err
+	case c.Args[1] == "delete":
+		// The objects to delete are passed as a stream of JSON objects.
+		d := json.NewDecoder(c.Stdin)
+		for d.More() {
+			var o struct {
+				Kind     string
+				Metadata struct{ Name string }
+			}
+			if err := d.Decode(&o); err != nil {
+				return err
+			}
+			f.deleted = append(f.deleted, o.Kind+":"+o.Metadata.Name)
+		}
+		return nil
+	}
+	return fmt.Errorf("unexpected command %v", c.Args)
+}
+
+func TestPruneExtraManifests(t *testing.T) {
+	defer func(k *kubectlcmd.Client) { kubectl = k }(kubectl)
+	tests := []struct {
+		desc     string
+		manifest string
+		want     []string
+	}{
+		{
+			desc:     "removed gateway",
+			manifest: extraGatewayYAML("kept"),
+			want:     []string{"Gateway:stale"},
+		},
+		{
+			desc: "disabled component",
+			want: []string{"Gateway:kept", "Gateway:stale"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			f := &fakeCluster{items: []string{extraGatewayYAML("kept"), extraGatewayYAML("stale")}}
+			kubectl = kubectlcmd.NewWithRunner(f.run)
+			out, _ := ApplyManifest(name.IngressComponentName, tt.manifest, "1.5.0", kubectlcmd.Options{})
+			if out.Err != nil {
+				t.Fatalf("got error %s, stderr:\n%s", out.Err, out.Stderr)
+			}
+			sort.Strings(f.deleted)
+			if got, want := strings.Join(f.deleted, ","), strings.Join(tt.want, ","); got != want {
+				t.Errorf("got deleted %s, want %s", got, want)
+			}
+		})
+	}
+}
@@ -27,6 +27,9 @@ const (
 	// OperatorAPINamespace is the API namespace for operator config.
 	// TODO: move this to a base definitions file when one is created.
 	OperatorAPINamespace = "operator.istio.io"
+	// ExtraManifestLabel is the label of the resources of the extra manifests in values.global.extraManifests, which
+	// are installed with a component in addition to its rendered resources.
+	ExtraManifestLabel = OperatorAPINamespace + "/extra-manifest"
 )
 
 // ComponentName is a component name string, typed to constrain allowed values.
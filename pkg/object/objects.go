@@ -207,6 +207,23 @@ func (o *K8sObject) AddLabels(labels map[string]string) {
 	o.yaml = nil
 }
 
+// AddAnnotations adds annotations to the K8sObject.
+func (o *K8sObject) AddAnnotations(annotations map[string]string) {
+	merged := make(map[string]string)
+	for k, v := range o.object.GetAnnotations() {
+		merged[k] = v
+	}
+
+	for k, v := range annotations {
+		merged[k] = v
+	}
+
+	o.object.SetAnnotations(merged)
+	// Invalidate cached json
+	o.json = nil
+	o.yaml = nil
+}
+
 // K8sObjects holds a collection of k8s objects, so that we can filter / sequence them
 type K8sObjects []*K8sObject
 
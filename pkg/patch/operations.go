@@ -0,0 +1,246 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package patch
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/ghodss/yaml"
+	yaml2 "gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"istio.io/api/operator/v1alpha1"
+	"istio.io/operator/pkg/object"
+	"istio.io/operator/pkg/util"
+)
+
+const (
+	// JSONPatchPath is the path of an overlay patch whose value is a list of RFC 6902 JSON Patch operations, e.g.
+	//   path: $jsonPatch
+	//   value:
+	//   - op: move
+	//     from: /spec/template/spec/containers/0/args/1
+	//     path: /spec/template/spec/containers/0/args/0
+	JSONPatchPath = "$jsonPatch"
+	// StrategicMergePath is the path of an overlay patch whose value is a partial object that is merged into the
+	// object with a Kubernetes strategic merge, or a JSON merge patch for kinds without strategic merge metadata, e.g.
+	//   path: $strategicMerge
+	//   value:
+	//     spec:
+	//       template:
+	//         spec:
+	//           containers:
+	//           - name: discovery
+	//             securityContext:
+	//               runAsNonRoot: true
+	StrategicMergePath = "$strategicMerge"
+)
+
+// CheckReservedPath returns an error if the overlay patch path starts with $, which marks the reserved paths, but is
+// not one of JSONPatchPath, StrategicMergePath and SelectorPath.
+func CheckReservedPath(path string) error {
+	path = strings.TrimSpace(path)
+	if !strings.HasPrefix(path, "$") {
+		return nil
+	}
+	switch path {
+	case JSONPatchPath, StrategicMergePath, SelectorPath:
+		return nil
+	}
+	return fmt.Errorf("unknown reserved path %s, paths starting with $ must be one of %s, %s and %s", path, JSONPatchPath,
+		StrategicMergePath, SelectorPath)
+}
+
+// isOperation reports whether p is a JSON Patch or strategic merge patch rather than a path patch.
+func isOperation(p *v1alpha1.K8SObjectOverlay_PathValue) bool {
+	path := strings.TrimSpace(p.Path)
+	return path == JSONPatchPath || path == StrategicMergePath
+}
+
+// applyOperations applies the JSON Patch and strategic merge patches in patches to the YAML of base in order, and
+// returns the patched YAML. A patch that fails is skipped and its error returned with the others.
+func applyOperations(base *object.K8sObject, baseYAML []byte, patches []*v1alpha1.K8SObjectOverlay_PathValue) ([]byte, util.Errors) {
+	doc, err := yaml.YAMLToJSON(baseYAML)
+	if err != nil {
+		return nil, util.NewErrs(err)
+	}
+	var errs util.Errors
+	for _, p := range patches {
+		var err error
+		var out []byte
+		switch strings.TrimSpace(p.Path) {
+		case JSONPatchPath:
+			out, err = applyJSONPatch(doc, p.Value)
+		case StrategicMergePath:
+			out, err = applyStrategicMerge(base, doc, p.Value)
+		}
+		if err != nil {
+			errs = util.AppendErr(errs, fmt.Errorf("%s: %s", base.Hash(), err))
+			continue
+		}
+		doc = out
+	}
+	y, err := yaml.JSONToYAML(doc)
+	if err != nil {
+		return nil, util.AppendErr(errs, err)
+	}
+	return y, errs
+}
+
+// applyJSONPatch applies the JSON Patch operations in value to the JSON document doc one by one, so that errors
+// identify the failing operation.
+func applyJSONPatch(doc []byte, value interface{}) ([]byte, error) {
+	if value == nil {
+		return nil, fmt.Errorf("%s has no operations, set them in value", JSONPatchPath)
+	}
+	var ops []map[string]interface{}
+	if err := unmarshalValue(value, &ops); err != nil {
+		return nil, fmt.Errorf("%s must be a list of JSON Patch operations: %s", JSONPatchPath, err)
+	}
+	for i, op := range ops {
+		desc := fmt.Sprintf("%s operation %d (%v %v)", JSONPatchPath, i, op["op"], op["path"])
+		var d interface{}
+		if err := json.Unmarshal(doc, &d); err != nil {
+			return nil, err
+		}
+		if err := checkOperation(d, op); err != nil {
+			return nil, fmt.Errorf("%s: %s", desc, err)
+		}
+		b, err := json.Marshal([]map[string]interface{}{op})
+		if err != nil {
+			return nil, err
+		}
+		jp, err := jsonpatch.DecodePatch(b)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", desc, err)
+		}
+		if doc, err = jp.Apply(doc); err != nil {
+			return nil, fmt.Errorf("%s: %s", desc, err)
+		}
+	}
+	return doc, nil
+}
+
+// checkOperation checks that the targets of the JSON Patch operation op exist in the unmarshaled JSON document doc.
+func checkOperation(doc interface{}, op map[string]interface{}) error {
+	opName, _ := op["op"].(string)
+	path, ok := op["path"].(string)
+	if !ok {
+		return fmt.Errorf("path is not set")
+	}
+	switch opName {
+	case "add":
+		return checkPointer(doc, path, true)
+	case "remove", "replace", "test":
+		return checkPointer(doc, path, false)
+	case "move", "copy":
+		from, ok := op["from"].(string)
+		if !ok {
+			return fmt.Errorf("from is not set")
+		}
+		if err := checkPointer(doc, from, false); err != nil {
+			return err
+		}
+		return checkPointer(doc, path, true)
+	}
+	return fmt.Errorf("unknown op %q, must be add, remove, replace, move, copy or test", opName)
+}
+
+// checkPointer checks that the value at the JSON pointer ptr exists in doc, or only its parent if forAdd is set, in
+// which case a list index may also be the list length or -.
+func checkPointer(doc interface{}, ptr string, forAdd bool) error {
+	if ptr == "" {
+		return nil
+	}
+	if !strings.HasPrefix(ptr, "/") {
+		return fmt.Errorf("%s is not a JSON pointer, it must start with /", ptr)
+	}
+	missing := ptr
+	if forAdd {
+		missing = "the parent of " + ptr
+	}
+	tokens := strings.Split(ptr[1:], "/")
+	node := doc
+	for i, t := range tokens {
+		t = strings.ReplaceAll(strings.ReplaceAll(t, "~1", "/"), "~0", "~")
+		last := i == len(tokens)-1
+		at := "/" + strings.Join(tokens[:i], "/")
+		switch n := node.(type) {
+		case map[string]interface{}:
+			v, ok := n[t]
+			if !ok && !(last && forAdd) {
+				return fmt.Errorf("%s does not exist, %s has no key %s", missing, at, t)
+			}
+			node = v
+		case []interface{}:
+			idx, err := strconv.Atoi(t)
+			switch {
+			case last && forAdd && (t == "-" || err == nil && idx >= 0 && idx <= len(n)):
+				return nil
+			case err != nil:
+				return fmt.Errorf("%s does not exist, %s is a list and %s is not an index", missing, at, t)
+			case idx < 0 || idx >= len(n):
+				return fmt.Errorf("%s does not exist, %s has %d items", missing, at, len(n))
+			}
+			node = n[idx]
+		default:
+			return fmt.Errorf("%s does not exist, %s is not a map or list", missing, at)
+		}
+	}
+	return nil
+}
+
+// applyStrategicMerge merges the partial object in value into the JSON document doc of base. Kinds that are not in
+// the client-go scheme, such as custom resources, have no patch strategies and are merged with a JSON merge patch.
+func applyStrategicMerge(base *object.K8sObject, doc []byte, value interface{}) ([]byte, error) {
+	if value == nil {
+		return nil, fmt.Errorf("%s has no partial object, set it in value", StrategicMergePath)
+	}
+	partial := make(map[string]interface{})
+	if err := unmarshalValue(value, &partial); err != nil {
+		return nil, fmt.Errorf("%s must be a partial object: %s", StrategicMergePath, err)
+	}
+	pb, err := json.Marshal(partial)
+	if err != nil {
+		return nil, err
+	}
+	dataStruct, err := scheme.Scheme.New(base.GroupVersionKind())
+	if err != nil {
+		out, err := jsonpatch.MergePatch(doc, pb)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", StrategicMergePath, err)
+		}
+		return out, nil
+	}
+	out, err := strategicpatch.StrategicMergePatch(doc, pb, dataStruct)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", StrategicMergePath, err)
+	}
+	return out, nil
+}
+
+// unmarshalValue unmarshals the value of an overlay patch, which may have been decoded from JSON or YAML, into out.
+func unmarshalValue(value interface{}, out interface{}) error {
+	y, err := yaml2.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return yaml.Unmarshal(y, out)
+}
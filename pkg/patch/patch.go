@@ -76,6 +76,34 @@ ADD
   value:
     new_attr: v3
 
+JSON PATCH AND STRATEGIC MERGE
+
+Patches with the reserved paths $jsonPatch and $strategicMerge hold a list of RFC 6902 operations or a partial object
+to merge into the resource. They are applied in order after all the path patches of an overlay, see JSONPatchPath and
+StrategicMergePath.
+
+Paths starting with $ are reserved. Only $jsonPatch, $strategicMerge and $selector are valid, see CheckReservedPath.
+
+TARGETS
+
+An overlay applies to every object of the manifest in its namespace, or cluster scoped, that it matches:
+ -  kind is a glob, e.g. * for any kind
+ -  name is a glob, e.g. istio-*, an anchored regexp between slashes, e.g. /istio-(ingress|egress)gateway/, or empty
+    for any name
+ -  patches with the reserved path $selector hold label selectors that the objects must match, see SelectorPath.
+
+For example, to set a pull secret in all the Deployments of the ingress gateway:
+
+  kind: Deployment
+  patches:
+  - path: $selector
+    value: app=istio-ingressgateway
+  - path: spec.template.spec.imagePullSecrets
+    value:
+    - name: my-secret
+
+The patches of all the overlays that match an object are applied to it in order.
+
 *NOTES*
 - Due to loss of string quoting during unmarshaling, keys and values should not be string quoted, even if they appear
 that way in the object being patched.
@@ -88,7 +116,6 @@ import (
 	"sort"
 	"strings"
 
-	"github.com/kr/pretty"
 	"gopkg.in/yaml.v2"
 
 	"istio.io/api/operator/v1alpha1"
@@ -103,97 +130,129 @@ var (
 )
 
 // YAMLManifestPatch patches a base YAML in the given namespace with a list of overlays.
-// Each overlay has the format described in the K8SObjectOverlay definition.
+// Each overlay has the format described in the K8SObjectOverlay definition, and may target several objects, see
+// TARGETS in the package documentation. It is an error for an overlay to match no object, or for the path of a patch
+// not to exist in an object.
 // It returns the patched manifest YAML.
 func YAMLManifestPatch(baseYAML string, namespace string, overlays []*v1alpha1.K8SObjectOverlay) (string, error) {
+	out, warnings, errs := patchManifest(baseYAML, namespace, overlays, false)
+	return out, util.AppendErrs(warnings, errs).ToError()
+}
+
+// PatchManifest is like YAMLManifestPatch, except that overlays that match no object and patch paths that do not exist
+// are returned as warnings rather than errors. If global is set, the overlays apply to the manifests of all components
+// and overlays that match no object are skipped.
+func PatchManifest(baseYAML string, namespace string, overlays []*v1alpha1.K8SObjectOverlay, global bool) (string, util.Errors, error) {
+	out, warnings, errs := patchManifest(baseYAML, namespace, overlays, global)
+	return out, warnings, errs.ToError()
+}
+
+// patchManifest patches baseYAML in namespace with overlays. The patches of all the overlays that match an object
+// are applied to it in order. It returns the overlays that match no object, unless global is set, and the patch paths
+// that do not exist as warnings, separately from the errors.
+func patchManifest(baseYAML string, namespace string, overlays []*v1alpha1.K8SObjectOverlay, global bool) (string, util.Errors, util.Errors) {
 	baseObjs, err := object.ParseK8sObjectsFromYAMLManifest(baseYAML)
 	if err != nil {
-		return "", err
+		return "", nil, util.NewErrs(err)
 	}
 
 	bom := baseObjs.ToMap()
-	oom := objectOverrideMap(overlays, namespace)
-
-	var ret strings.Builder
-
-	var errs util.Errors
 	keys := make([]string, 0)
-	for key := range oom {
+	for key := range bom {
 		keys = append(keys, key)
 	}
 	sort.Strings(keys)
-	// Try to apply the defined overlays.
-	for _, k := range keys {
-		oo := oom[k]
-		bo := bom[k]
-		if bo == nil {
-			os := ""
-			for k2 := range bom {
-				os += k2 + "\n"
-			}
-			errs = util.AppendErr(errs, fmt.Errorf("overlay for %s does not match any object in output manifest:\n%s\n\nAvailable objects are:\n%s",
-				k, pretty.Sprint(oo), os))
-			continue
-		}
-		patched, err := applyPatches(bo, oo)
+
+	var errs, warnings util.Errors
+	// Match the overlays against the objects, in the sorted object order.
+	matched := make(map[string]bool)
+	oom := make(map[string][]*v1alpha1.K8SObjectOverlay_PathValue)
+	for _, o := range overlays {
+		t, err := newTarget(o, namespace)
 		if err != nil {
-			errs = util.AppendErr(errs, fmt.Errorf("patch error: %s", err))
+			errs = util.AppendErr(errs, err)
 			continue
 		}
-		if _, err := ret.Write(patched); err != nil {
-			errs = util.AppendErr(errs, fmt.Errorf("write: %s", err))
+		found := false
+		for _, k := range keys {
+			if t.matches(bom[k]) {
+				found = true
+				matched[k] = true
+				oom[k] = append(oom[k], t.patches...)
+			}
 		}
-		if _, err := ret.WriteString("\n---\n"); err != nil {
-			errs = util.AppendErr(errs, fmt.Errorf("patch WriteString error: %s", err))
+		if !found && !global {
+			warnings = util.AppendErr(warnings, fmt.Errorf("overlay for %s does not match any object in output manifest. Available objects are: %s",
+				t, strings.Join(keys, ", ")))
 		}
 	}
-	// Render the remaining objects with no overlays.
-	// keep the original sorted order
-	keys = make([]string, 0)
-	for key := range bom {
-		keys = append(keys, key)
-	}
-	sort.Strings(keys)
-	for _, k := range keys {
-		oo := bom[k]
-		if oom[k] != nil {
-			// Skip objects that have overlays, these were rendered above.
-			continue
-		}
-		oy, err := oo.YAML()
-		if err != nil {
-			errs = util.AppendErr(errs, fmt.Errorf("object to YAML error (%s) for base object: \n%v", err, oo))
-			continue
-		}
-		if _, err := ret.Write(oy); err != nil {
-			errs = util.AppendErr(errs, fmt.Errorf("write: %s", err))
-		}
-		if _, err := ret.WriteString("\n---\n"); err != nil {
-			errs = util.AppendErr(errs, fmt.Errorf("writeString: %s", err))
+
+	var ret strings.Builder
+	// Try to apply the defined overlays, then render the remaining objects with no overlays.
+	// keep the original sorted order within each
+	for _, withOverlays := range []bool{true, false} {
+		for _, k := range keys {
+			if matched[k] != withOverlays {
+				continue
+			}
+			bo := bom[k]
+			var y []byte
+			if withOverlays {
+				patched, unmatched, err := applyPatches(bo, oom[k])
+				warnings = util.AppendErrs(warnings, unmatched)
+				if err != nil {
+					errs = util.AppendErr(errs, fmt.Errorf("patch error: %s", err))
+					continue
+				}
+				y = patched
+			} else {
+				oy, err := bo.YAML()
+				if err != nil {
+					errs = util.AppendErr(errs, fmt.Errorf("object to YAML error (%s) for base object: \n%v", err, bo))
+					continue
+				}
+				y = oy
+			}
+			if _, err := ret.Write(y); err != nil {
+				errs = util.AppendErr(errs, fmt.Errorf("write: %s", err))
+			}
+			if _, err := ret.WriteString("\n---\n"); err != nil {
+				errs = util.AppendErr(errs, fmt.Errorf("writeString: %s", err))
+			}
 		}
 	}
-	return ret.String(), errs.ToError()
+	return ret.String(), warnings, errs
 }
 
-// applyPatches applies the given patches against the given object. It returns the resulting patched YAML if successful,
-// or a list of errors otherwise.
-func applyPatches(base *object.K8sObject, patches []*v1alpha1.K8SObjectOverlay_PathValue) (outYAML []byte, errs util.Errors) {
+// applyPatches applies the given patches against the given object. Path patches are applied first, then the JSON Patch
+// and strategic merge patches in order. It returns the resulting patched YAML if successful, or a list of errors
+// otherwise. Path patches whose path does not exist in the object are skipped and returned as unmatched.
+func applyPatches(base *object.K8sObject, patches []*v1alpha1.K8SObjectOverlay_PathValue) (outYAML []byte, unmatched, errs util.Errors) {
 	bo := make(map[interface{}]interface{})
 	by, err := base.YAML()
 	if err != nil {
-		return nil, util.NewErrs(err)
+		return nil, nil, util.NewErrs(err)
 	}
 	err = yaml.Unmarshal(by, bo)
 	if err != nil {
-		return nil, util.NewErrs(err)
+		return nil, nil, util.NewErrs(err)
 	}
+	var ops []*v1alpha1.K8SObjectOverlay_PathValue
 	for _, p := range patches {
+		if isOperation(p) {
+			ops = append(ops, p)
+			continue
+		}
 		if strings.TrimSpace(p.Path) == "" {
 			scope.Warnf("value=%s has empty path, skip\n", p.Value)
 			continue
 		}
 		scope.Debugf("applying path=%s, value=%s\n", p.Path, p.Value)
 		inc, _, err := tpath.GetPathContext(bo, util.PathFromString(p.Path))
+		if tpath.IsNotFound(err) {
+			unmatched = util.AppendErr(unmatched, fmt.Errorf("%s: %s", base.Hash(), err))
+			continue
+		}
 		if err != nil {
 			errs = util.AppendErr(errs, err)
 			continue
@@ -202,17 +261,11 @@ func applyPatches(base *object.K8sObject, patches []*v1alpha1.K8SObjectOverlay_P
 	}
 	oy, err := yaml.Marshal(bo)
 	if err != nil {
-		return nil, util.AppendErr(errs, err)
+		return nil, unmatched, util.AppendErr(errs, err)
 	}
-	return oy, errs
-}
-
-// objectOverrideMap converts oos, a slice of object overlays, into a map of the same overlays where the key is the
-// object manifest.Hash.
-func objectOverrideMap(oos []*v1alpha1.K8SObjectOverlay, namespace string) map[string][]*v1alpha1.K8SObjectOverlay_PathValue {
-	ret := make(map[string][]*v1alpha1.K8SObjectOverlay_PathValue)
-	for _, o := range oos {
-		ret[object.Hash(o.Kind, namespace, o.Name)] = o.Patches
+	if len(ops) == 0 {
+		return oy, unmatched, errs
 	}
-	return ret
+	oy, opErrs := applyOperations(base, oy, ops)
+	return oy, unmatched, util.AppendErrs(errs, opErrs)
 }
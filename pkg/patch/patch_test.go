@@ -466,6 +466,182 @@ spec:
 	}
 }
 
+func TestPatchYAMLManifestOperations(t *testing.T) {
+	base := `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: istio-pilot
+  namespace: istio-system
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+      - name: discovery
+        args:
+        - discovery
+        - --monitoringAddr=:15014
+        image: pilot
+      - name: istio-proxy
+        image: proxyv2
+---
+apiVersion: networking.istio.io/v1alpha3
+kind: Gateway
+metadata:
+  name: ingressgateway
+  namespace: istio-system
+spec:
+  selector:
+    istio: ingressgateway
+  servers:
+  - port:
+      number: 80
+`
+	tests := []struct {
+		desc     string
+		overlays string
+		want     string
+		wantErr  string
+	}{
+		{
+			desc: "path patches first, then operations in order",
+			overlays: `
+overlays:
+- kind: Deployment
+  name: istio-pilot
+  patches:
+  - path: $jsonPatch
+    value:
+    - op: test
+      path: /spec/replicas
+      value: 2
+    - op: add
+      path: /spec/template/spec/containers/0/args/1
+      value: --log_output_level=debug
+    - op: move
+      from: /spec/template/spec/containers/0/args/2
+      path: /spec/template/spec/containers/0/args/0
+  - path: $strategicMerge
+    value:
+      spec:
+        template:
+          spec:
+            containers:
+            - name: istio-proxy
+              securityContext:
+                runAsNonRoot: true
+  - path: spec.replicas
+    value: 2
+- kind: Gateway
+  name: ingressgateway
+  patches:
+  - path: $strategicMerge
+    value:
+      spec:
+        selector:
+          istio: null
+          app: ingress
+`,
+			want: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: istio-pilot
+  namespace: istio-system
+spec:
+  replicas: 2
+  template:
+    spec:
+      containers:
+      - name: discovery
+        args:
+        - --monitoringAddr=:15014
+        - discovery
+        - --log_output_level=debug
+        image: pilot
+      - name: istio-proxy
+        image: proxyv2
+        securityContext:
+          runAsNonRoot: true
+---
+apiVersion: networking.istio.io/v1alpha3
+kind: Gateway
+metadata:
+  name: ingressgateway
+  namespace: istio-system
+spec:
+  selector:
+    app: ingress
+  servers:
+  - port:
+      number: 80
+`,
+		},
+		{
+			desc: "missing targets",
+			overlays: `
+overlays:
+- kind: Deployment
+  name: istio-pilot
+  patches:
+  - path: $jsonPatch
+    value:
+    - op: remove
+      path: /spec/template/spec/volumes
+  - path: $jsonPatch
+    value:
+    - op: move
+      from: /spec/template/spec/containers/2/image
+      path: /spec/template/spec/containers/0/image
+  - path: $jsonPatch
+    value:
+    - op: add
+      path: /spec/strategy/type
+      value: Recreate
+  - path: $jsonPatch
+    value:
+    - op: replace
+      path: /spec/template/spec/containers/name
+      value: x
+  - path: $jsonPatch
+    value:
+    - op: delete
+      path: /spec
+  - path: $strategicMerge
+    value: [a]
+`,
+			wantErr: "patch error: Deployment:istio-system:istio-pilot: $jsonPatch operation 0 (remove /spec/template/spec/volumes): " +
+				"/spec/template/spec/volumes does not exist, /spec/template/spec has no key volumes" +
+				", Deployment:istio-system:istio-pilot: $jsonPatch operation 0 (move /spec/template/spec/containers/0/image): " +
+				"/spec/template/spec/containers/2/image does not exist, /spec/template/spec/containers has 2 items" +
+				", Deployment:istio-system:istio-pilot: $jsonPatch operation 0 (add /spec/strategy/type): " +
+				"the parent of /spec/strategy/type does not exist, /spec has no key strategy" +
+				", Deployment:istio-system:istio-pilot: $jsonPatch operation 0 (replace /spec/template/spec/containers/name): " +
+				"/spec/template/spec/containers/name does not exist, /spec/template/spec/containers is a list and name is not an index" +
+				", Deployment:istio-system:istio-pilot: $jsonPatch operation 0 (delete /spec): " +
+				`unknown op "delete", must be add, remove, replace, move, copy or test` +
+				", Deployment:istio-system:istio-pilot: $strategicMerge must be a partial object: " +
+				"error unmarshaling JSON: json: cannot unmarshal array into Go value of type map[string]interface {}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			rc := &v1alpha1.KubernetesResourcesSpec{}
+			if err := util.UnmarshalWithJSONPB(tt.overlays, rc); err != nil {
+				t.Fatal(err)
+			}
+			got, err := YAMLManifestPatch(base, "istio-system", rc.Overlays)
+			if gotErr := errToString(err); gotErr != tt.wantErr {
+				t.Fatalf("got error:\n%s\nwant:\n%s", gotErr, tt.wantErr)
+			}
+			if tt.want != "" && !util.IsYAMLEqual(got, tt.want) {
+				t.Errorf("got:\n%s\n\nwant:\n%s\nDiff:\n%s\n", got, tt.want, util.YAMLDiff(got, tt.want))
+			}
+		})
+	}
+}
+
 func makeOverlayHeader(path, value string) string {
 	const (
 		patchCommon = `overlays:
@@ -492,3 +668,73 @@ func errToString(err error) string {
 	}
 	return err.Error()
 }
+
+func TestPatchManifestWarnings(t *testing.T) {
+	base := `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: istio-pilot
+  namespace: istio-system
+spec:
+  template:
+    spec:
+      containers:
+      - name: discovery
+        args:
+        - discovery
+`
+	overlays := `
+overlays:
+- kind: Deployment
+  name: istio-pilot
+  patches:
+  - path: spec.template.spec.containers.[name:discovery].args.[discovery]
+    value: pilot-discovery
+  - path: spec.template.spec.containers.[name:istio-proxy].image
+    value: proxyv2
+  - path: spec.strategy.type
+    value: Recreate
+- kind: Service
+  name: istio-pilot
+  patches:
+  - path: spec.type
+    value: NodePort
+`
+	want := `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: istio-pilot
+  namespace: istio-system
+spec:
+  template:
+    spec:
+      containers:
+      - name: discovery
+        args:
+        - pilot-discovery
+`
+	wantWarnings := "overlay for Service:istio-system:istio-pilot does not match any object in output manifest. " +
+		"Available objects are: Deployment:istio-system:istio-pilot" +
+		", Deployment:istio-system:istio-pilot: path spec.template.spec.containers.[name:istio-proxy].image: " +
+		"element [name:istio-proxy] not found" +
+		", Deployment:istio-system:istio-pilot: path not found at element strategy in path spec.strategy.type"
+	rc := &v1alpha1.KubernetesResourcesSpec{}
+	if err := util.UnmarshalWithJSONPB(overlays, rc); err != nil {
+		t.Fatal(err)
+	}
+	got, warnings, err := PatchManifest(base, "istio-system", rc.Overlays, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotWarnings := warnings.String(); gotWarnings != wantWarnings {
+		t.Errorf("got warnings:\n%s\nwant:\n%s", gotWarnings, wantWarnings)
+	}
+	if !util.IsYAMLEqual(got, want) {
+		t.Errorf("got:\n%s\n\nwant:\n%s\nDiff:\n%s\n", got, want, util.YAMLDiff(got, want))
+	}
+	if _, err := YAMLManifestPatch(base, "istio-system", rc.Overlays); errToString(err) != wantWarnings {
+		t.Errorf("got error:\n%s\nwant:\n%s", errToString(err), wantWarnings)
+	}
+}
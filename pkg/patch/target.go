@@ -0,0 +1,148 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package patch
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"istio.io/api/operator/v1alpha1"
+	"istio.io/operator/pkg/object"
+)
+
+const (
+	// SelectorPath is the path of an overlay patch whose value is a label selector that the objects the overlay
+	// applies to must match, either in the kubectl format or as a map of labels, e.g.
+	//   path: $selector
+	//   value: app=istio-ingressgateway,istio in (ingressgateway)
+	SelectorPath = "$selector"
+)
+
+// target selects the objects of a manifest that an overlay applies to.
+type target struct {
+	// kind is the kind of the objects, a glob such as * for any kind.
+	kind string
+	// name is the name of the objects, a glob. It is empty for any name or if nameRegexp is set.
+	name string
+	// nameRegexp is the anchored regexp of the object names, for names of the form /regexp/.
+	nameRegexp *regexp.Regexp
+	// namespace is the namespace of the objects. Cluster scoped objects match any namespace.
+	namespace string
+	// selectors are the label selectors that the objects must all match.
+	selectors []labels.Selector
+	// patches are the patches of the overlay, without the selectors.
+	patches []*v1alpha1.K8SObjectOverlay_PathValue
+	// overlay is the overlay that the target was created from.
+	overlay *v1alpha1.K8SObjectOverlay
+}
+
+// newTarget returns the target of the overlay o in namespace.
+func newTarget(o *v1alpha1.K8SObjectOverlay, namespace string) (*target, error) {
+	t := &target{kind: o.Kind, namespace: namespace, overlay: o}
+	if _, err := path.Match(o.Kind, ""); err != nil {
+		return nil, fmt.Errorf("overlay kind %s is not a valid glob: %s", o.Kind, err)
+	}
+	switch {
+	case len(o.Name) > 1 && strings.HasPrefix(o.Name, "/") && strings.HasSuffix(o.Name, "/"):
+		re, err := regexp.Compile("^(?:" + o.Name[1:len(o.Name)-1] + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("overlay name %s is not a valid regexp: %s", o.Name, err)
+		}
+		t.nameRegexp = re
+	default:
+		if _, err := path.Match(o.Name, ""); err != nil {
+			return nil, fmt.Errorf("overlay name %s is not a valid glob: %s", o.Name, err)
+		}
+		t.name = o.Name
+	}
+	for _, p := range o.Patches {
+		if strings.TrimSpace(p.Path) != SelectorPath {
+			t.patches = append(t.patches, p)
+			continue
+		}
+		s, err := parseSelector(p.Value)
+		if err != nil {
+			return nil, fmt.Errorf("overlay %s: %s", t, err)
+		}
+		t.selectors = append(t.selectors, s)
+	}
+	return t, nil
+}
+
+// parseSelector returns the label selector in value, a selector string or a map of labels.
+func parseSelector(value interface{}) (labels.Selector, error) {
+	switch v := value.(type) {
+	case string:
+		s, err := labels.Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid label selector: %s", SelectorPath, err)
+		}
+		return s, nil
+	case nil:
+		return nil, fmt.Errorf("%s has no label selector, set it in value", SelectorPath)
+	}
+	set := make(map[string]string)
+	if err := unmarshalValue(value, &set); err != nil {
+		return nil, fmt.Errorf("%s must be a label selector or a map of labels: %s", SelectorPath, err)
+	}
+	s, err := labels.Parse(labels.Set(set).String())
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a valid map of labels: %s", SelectorPath, err)
+	}
+	return s, nil
+}
+
+// matches reports whether the object o is a target.
+func (t *target) matches(o *object.K8sObject) bool {
+	if o.Namespace != "" && o.Namespace != t.namespace {
+		return false
+	}
+	if ok, _ := path.Match(t.kind, o.Kind); !ok {
+		return false
+	}
+	switch {
+	case t.nameRegexp != nil:
+		if !t.nameRegexp.MatchString(o.Name) {
+			return false
+		}
+	case t.name != "":
+		if ok, _ := path.Match(t.name, o.Name); !ok {
+			return false
+		}
+	}
+	if len(t.selectors) == 0 {
+		return true
+	}
+	ls := labels.Set(o.UnstructuredObject().GetLabels())
+	for _, s := range t.selectors {
+		if !s.Matches(ls) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the target as an object hash, followed by its label selectors if it has any.
+func (t *target) String() string {
+	out := object.Hash(t.overlay.Kind, t.namespace, t.overlay.Name)
+	for _, s := range t.selectors {
+		out += fmt.Sprintf(" with labels %s", s)
+	}
+	return out
+}
@@ -0,0 +1,238 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package patch
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"istio.io/api/operator/v1alpha1"
+	"istio.io/operator/pkg/object"
+	"istio.io/operator/pkg/util"
+)
+
+func TestPatchYAMLManifestTargets(t *testing.T) {
+	base := `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: istio-ingressgateway
+  namespace: istio-system
+  labels:
+    app: istio-ingressgateway
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: istio-egressgateway
+  namespace: istio-system
+  labels:
+    app: istio-egressgateway
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: istio-ingressgateway
+  namespace: other
+  labels:
+    app: istio-ingressgateway
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: istio-ingressgateway-service-account
+  namespace: istio-system
+  labels:
+    app: istio-ingressgateway
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: istio-reader
+  labels:
+    app: istio-reader
+`
+	const patchLabel = `
+  - path: $jsonPatch
+    value:
+    - op: add
+      path: /metadata/labels/patched
+      value: "true"
+`
+	tests := []struct {
+		desc        string
+		overlays    string
+		global      bool
+		wantPatched []string
+		wantErr     string
+	}{
+		{
+			desc: "exact",
+			overlays: `
+overlays:
+- kind: Deployment
+  name: istio-ingressgateway
+  patches:` + patchLabel,
+			wantPatched: []string{"Deployment:istio-system:istio-ingressgateway"},
+		},
+		{
+			desc: "kind wildcard and name glob",
+			overlays: `
+overlays:
+- kind: "*"
+  name: istio-*
+  patches:` + patchLabel,
+			wantPatched: []string{
+				"ClusterRole::istio-reader",
+				"Deployment:istio-system:istio-egressgateway",
+				"Deployment:istio-system:istio-ingressgateway",
+				"ServiceAccount:istio-system:istio-ingressgateway-service-account",
+			},
+		},
+		{
+			desc: "name regexp",
+			overlays: `
+overlays:
+- kind: Deployment
+  name: /istio-(ingress|egress)gateway/
+  patches:` + patchLabel,
+			wantPatched: []string{
+				"Deployment:istio-system:istio-egressgateway",
+				"Deployment:istio-system:istio-ingressgateway",
+			},
+		},
+		{
+			desc: "label selector",
+			overlays: `
+overlays:
+- kind: Deployment
+  patches:
+  - path: $selector
+    value: app=istio-ingressgateway` + patchLabel,
+			wantPatched: []string{"Deployment:istio-system:istio-ingressgateway"},
+		},
+		{
+			desc: "label map and kind wildcard",
+			overlays: `
+overlays:
+- kind: "*"
+  patches:
+  - path: $selector
+    value:
+      app: istio-ingressgateway` + patchLabel,
+			wantPatched: []string{
+				"Deployment:istio-system:istio-ingressgateway",
+				"ServiceAccount:istio-system:istio-ingressgateway-service-account",
+			},
+		},
+		{
+			desc: "overlays for the same object are all applied",
+			overlays: `
+overlays:
+- kind: Deployment
+  name: istio-ingressgateway
+  patches:
+  - path: metadata.labels.app
+    value: gw
+- kind: Deployment
+  patches:
+  - path: $selector
+    value: app=istio-ingressgateway` + patchLabel,
+			wantPatched: []string{"Deployment:istio-system:istio-ingressgateway"},
+		},
+		{
+			desc: "no match",
+			overlays: `
+overlays:
+- kind: Deployment
+  patches:
+  - path: $selector
+    value: app=nope` + patchLabel,
+			wantErr: "overlay for Deployment:istio-system: with labels app=nope does not match any object in output manifest",
+		},
+		{
+			desc:   "no match for global overlays",
+			global: true,
+			overlays: `
+overlays:
+- kind: Service
+  name: "*"
+  patches:` + patchLabel,
+		},
+		{
+			desc: "bad regexp",
+			overlays: `
+overlays:
+- kind: Deployment
+  name: /istio-(/
+  patches:` + patchLabel,
+			wantErr: "overlay name /istio-(/ is not a valid regexp",
+		},
+		{
+			desc: "bad selector",
+			overlays: `
+overlays:
+- kind: Deployment
+  patches:
+  - path: $selector
+    value: app in istio`,
+			wantErr: "overlay Deployment:istio-system:: $selector is not a valid label selector",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			rc := &v1alpha1.KubernetesResourcesSpec{}
+			if err := util.UnmarshalWithJSONPB(tt.overlays, rc); err != nil {
+				t.Fatal(err)
+			}
+			var got string
+			var err error
+			if tt.global {
+				var warnings util.Errors
+				got, warnings, err = PatchManifest(base, "istio-system", rc.Overlays, true)
+				if len(warnings) != 0 {
+					t.Errorf("got warnings %s, want none", warnings)
+				}
+			} else {
+				got, err = YAMLManifestPatch(base, "istio-system", rc.Overlays)
+			}
+			if gotErr := errToString(err); !strings.HasPrefix(gotErr, tt.wantErr) || (tt.wantErr == "") != (gotErr == "") {
+				t.Fatalf("got error:\n%s\nwant prefix:\n%s", gotErr, tt.wantErr)
+			}
+			if tt.wantErr != "" {
+				return
+			}
+			objs, err := object.ParseK8sObjectsFromYAMLManifest(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(objs) != 5 {
+				t.Fatalf("got %d objects, want 5:\n%s", len(objs), got)
+			}
+			var gotPatched []string
+			for _, o := range objs {
+				if o.UnstructuredObject().GetLabels()["patched"] == "true" {
+					gotPatched = append(gotPatched, o.Hash())
+				}
+			}
+			sort.Strings(gotPatched)
+			if !reflect.DeepEqual(gotPatched, tt.wantPatched) {
+				t.Errorf("got patched objects %v, want %v", gotPatched, tt.wantPatched)
+			}
+		})
+	}
+}
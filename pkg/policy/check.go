@@ -0,0 +1,239 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+
+	"sigs.k8s.io/yaml"
+
+	"istio.io/api/operator/v1alpha1"
+	"istio.io/operator/pkg/object"
+	"istio.io/operator/pkg/util"
+	"istio.io/operator/pkg/validate"
+)
+
+const (
+	// wildcardPathElement stands for every item of a list or value of a map in an Assertion path.
+	wildcardPathElement = "*"
+)
+
+// Check returns a Diagnostic for each violation of the rules of p by the merged IstioOperatorSpec iops or the rendered
+// objects objs. Violations that exempt allows are reported with SeverityInfo, so that they stay visible.
+func (p *Policy) Check(iops *v1alpha1.IstioOperatorSpec, objs object.K8sObjects, exempt Exemptions) (validate.Diagnostics, error) {
+	specYAML, err := util.MarshalWithJSONPB(iops)
+	if err != nil {
+		return nil, err
+	}
+	spec := make(map[string]interface{})
+	if err := yaml.Unmarshal([]byte(specYAML), &spec); err != nil {
+		return nil, err
+	}
+
+	var ds validate.Diagnostics
+	for _, r := range p.Rules {
+		if r.Target == TargetSpec {
+			ds = append(ds, r.check(spec, "", "", exempt)...)
+			continue
+		}
+		for _, o := range objs {
+			if !r.Match.matches(o) {
+				continue
+			}
+			name := o.Kind + " " + o.Name
+			if o.Namespace != "" {
+				name = o.Kind + " " + o.Namespace + "/" + o.Name
+			}
+			ds = append(ds, r.check(o.UnstructuredObject().Object, name, o.Hash(), exempt)...)
+		}
+	}
+	return ds, nil
+}
+
+// matches reports whether o is selected by m. Every object is selected by a nil Match.
+func (m *Match) matches(o *object.K8sObject) bool {
+	if m == nil {
+		return true
+	}
+	if len(m.Kinds) != 0 && !containsString(m.Kinds, o.Kind) {
+		return false
+	}
+	// Globs are checked when the policy is parsed.
+	if ok, _ := path.Match(m.Name, o.Name); m.Name != "" && !ok {
+		return false
+	}
+	if ok, _ := path.Match(m.Namespace, o.Namespace); m.Namespace != "" && !ok {
+		return false
+	}
+	labels := o.UnstructuredObject().GetLabels()
+	for k, v := range m.Labels {
+		if lv, ok := labels[k]; !ok || lv != v {
+			return false
+		}
+	}
+	return true
+}
+
+// check returns the violations of r by the tree root, which is the object with the given name and hash, or the spec
+// if they are empty.
+func (r *Rule) check(root map[string]interface{}, name, objHash string, exempt Exemptions) validate.Diagnostics {
+	exempted := exempt.exempted(r.ID, objHash)
+	severity := r.Severity
+	if exempted {
+		severity = validate.SeverityInfo
+	}
+	var ds validate.Diagnostics
+	for _, a := range r.Assert {
+		for _, v := range resolve(root, util.PathFromString(a.Path), nil) {
+			msg := a.violation(v)
+			if msg == "" {
+				continue
+			}
+			if exempted {
+				msg += " (exempted)"
+			}
+			d := &validate.Diagnostic{RuleID: r.ID, Severity: severity, Message: msg}
+			if name == "" {
+				d.Path = v.path.String()
+			} else {
+				d.Message = name + ": " + msg
+			}
+			ds = append(ds, d)
+		}
+	}
+	return ds
+}
+
+// pathValue is the value at a path, after wildcards are expanded.
+type pathValue struct {
+	path util.Path
+	// value is the value at path, if found is set.
+	value interface{}
+	found bool
+}
+
+// resolve returns the values at p in the tree node, which is at prefix. Wildcards in p are expanded in order. If an
+// element is missing, the rest of p is not expanded and returned as not found.
+func resolve(node interface{}, p, prefix util.Path) []pathValue {
+	if len(p) == 0 {
+		return []pathValue{{path: prefix, value: node, found: true}}
+	}
+	child := func(pe string) util.Path {
+		return append(append(util.Path{}, prefix...), pe)
+	}
+	var out []pathValue
+	switch n := node.(type) {
+	case map[string]interface{}:
+		if p[0] == wildcardPathElement {
+			keys := make([]string, 0, len(n))
+			for k := range n {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				out = append(out, resolve(n[k], p[1:], child(k))...)
+			}
+			return out
+		}
+		if v, ok := n[p[0]]; ok {
+			return resolve(v, p[1:], child(p[0]))
+		}
+	case []interface{}:
+		if p[0] == wildcardPathElement {
+			for i, v := range n {
+				out = append(out, resolve(v, p[1:], child(strconv.Itoa(i)))...)
+			}
+			return out
+		}
+		if i, err := strconv.Atoi(p[0]); err == nil && i >= 0 && i < len(n) {
+			return resolve(n[i], p[1:], child(p[0]))
+		}
+	}
+	return []pathValue{{path: append(append(util.Path{}, prefix...), p...)}}
+}
+
+// violation describes how v violates a, or returns an empty string if it does not.
+func (a *Assertion) violation(v pathValue) string {
+	if !v.found {
+		if a.Exists != nil && *a.Exists {
+			return fmt.Sprintf("%s must be set", v.path)
+		}
+		return ""
+	}
+	if v.value == nil {
+		// A null value is the same as a missing one.
+		return a.violation(pathValue{path: v.path})
+	}
+	if a.Exists != nil && !*a.Exists {
+		return fmt.Sprintf("%s must not be set", v.path)
+	}
+	s := valueString(v.value)
+	if a.In != nil && !containsValue(a.In, s) {
+		return fmt.Sprintf("%s is %s, must be one of %s", v.path, s, valuesString(a.In))
+	}
+	if containsValue(a.NotIn, s) {
+		return fmt.Sprintf("%s must not be %s", v.path, s)
+	}
+	if a.pattern != nil && !a.pattern.MatchString(s) {
+		return fmt.Sprintf("%s is %s, must match %s", v.path, s, a.Pattern)
+	}
+	return ""
+}
+
+// valueString returns v in the form it is compared with the values of assertions. Numbers are compared by their
+// string form, since they have different types in the policy and in objects.
+func valueString(v interface{}) string {
+	switch v.(type) {
+	case map[string]interface{}, []interface{}:
+		b, err := yaml.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(b)
+	}
+	return fmt.Sprint(v)
+}
+
+// containsValue reports whether the string form of a value in values is s.
+func containsValue(values []interface{}, s string) bool {
+	for _, v := range values {
+		if valueString(v) == s {
+			return true
+		}
+	}
+	return false
+}
+
+// valuesString returns values as a list for users.
+func valuesString(values []interface{}) string {
+	var out []string
+	for _, v := range values {
+		out = append(out, valueString(v))
+	}
+	return fmt.Sprint(out)
+}
+
+// containsString reports whether ss contains s.
+func containsString(ss []string, s string) bool {
+	for _, x := range ss {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
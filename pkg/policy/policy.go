@@ -0,0 +1,241 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package policy implements guardrail policies: declarative rules that an installation must follow, e.g. that images
+are only pulled from approved hubs. A rule matches either the IstioOperatorSpec merged with its profile or some of the
+rendered K8s objects, and asserts the values at paths in them.
+*/
+package policy
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path"
+	"regexp"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"istio.io/operator/pkg/validate"
+)
+
+const (
+	// TargetSpec selects the IstioOperatorSpec merged with its profile.
+	TargetSpec = "spec"
+	// TargetObjects selects the rendered K8s objects.
+	TargetObjects = "objects"
+
+	// ExemptAnnotationKey is the annotation of an IstioOperator CR that lists its policy exemptions, in the form of
+	// the --policy-exempt flag, separated by commas.
+	ExemptAnnotationKey = "install.istio.io/policy-exempt"
+)
+
+// Policy is a set of guardrail rules.
+type Policy struct {
+	// Rules are the rules of the policy, in the order they are checked.
+	Rules []*Rule `json:"rules"`
+}
+
+// Rule asserts the values of some paths in its target.
+type Rule struct {
+	// ID identifies the rule in reports and exemptions.
+	ID string `json:"id"`
+	// Description describes the rule to users.
+	Description string `json:"description,omitempty"`
+	// Severity is the severity of violations, error by default.
+	Severity validate.Severity `json:"severity,omitempty"`
+	// Target is what the rule checks, TargetObjects by default.
+	Target string `json:"target,omitempty"`
+	// Match selects the objects the rule checks. All objects are checked if it is not set. It is only valid for
+	// TargetObjects.
+	Match *Match `json:"match,omitempty"`
+	// Assert are the assertions each matched object, or the spec, must satisfy.
+	Assert []*Assertion `json:"assert"`
+}
+
+// Match selects K8s objects. An object is selected if it matches all the fields that are set.
+type Match struct {
+	// Kinds are the kinds of the objects to select.
+	Kinds []string `json:"kinds,omitempty"`
+	// Name is a glob, in path.Match syntax, that the names of the objects must match.
+	Name string `json:"name,omitempty"`
+	// Namespace is a glob, in path.Match syntax, that the namespaces of the objects must match.
+	Namespace string `json:"namespace,omitempty"`
+	// Labels are labels the objects must have.
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// Assertion checks the values at a path. Path elements are separated by dots, and a * element stands for every item of
+// a list or every value of a map. Values that are not set are only checked by Exists.
+type Assertion struct {
+	// Path is the path of the values.
+	Path string `json:"path"`
+	// Exists, if set, is whether values must be set.
+	Exists *bool `json:"exists,omitempty"`
+	// In are the values allowed, if set.
+	In []interface{} `json:"in,omitempty"`
+	// NotIn are values that are not allowed.
+	NotIn []interface{} `json:"notIn,omitempty"`
+	// Pattern is a regular expression that values must match, if set.
+	Pattern string `json:"pattern,omitempty"`
+
+	pattern *regexp.Regexp
+}
+
+// Load reads the policy in the file at path.
+func Load(path string) (*Policy, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read policy file %s: %s", path, err)
+	}
+	p, err := Parse(string(b))
+	if err != nil {
+		return nil, fmt.Errorf("bad policy file %s: %s", path, err)
+	}
+	return p, nil
+}
+
+// Parse parses and checks the policy in policyYAML.
+func Parse(policyYAML string) (*Policy, error) {
+	p := &Policy{}
+	if err := yaml.UnmarshalStrict([]byte(policyYAML), p); err != nil {
+		return nil, err
+	}
+	ids := make(map[string]bool)
+	for i, r := range p.Rules {
+		if r.ID == "" {
+			return nil, fmt.Errorf("rule %d has no id", i)
+		}
+		if ids[r.ID] {
+			return nil, fmt.Errorf("rule %s is defined more than once", r.ID)
+		}
+		ids[r.ID] = true
+		if err := r.init(); err != nil {
+			return nil, fmt.Errorf("rule %s: %s", r.ID, err)
+		}
+	}
+	return p, nil
+}
+
+// init sets the defaults of r and checks it.
+func (r *Rule) init() error {
+	switch r.Severity {
+	case "":
+		r.Severity = validate.SeverityError
+	case validate.SeverityError, validate.SeverityWarning, validate.SeverityInfo:
+	default:
+		return fmt.Errorf("unknown severity %s", r.Severity)
+	}
+	switch r.Target {
+	case "":
+		r.Target = TargetObjects
+	case TargetObjects:
+	case TargetSpec:
+		if r.Match != nil {
+			return fmt.Errorf("match is only valid for the %s target", TargetObjects)
+		}
+	default:
+		return fmt.Errorf("unknown target %s, must be %s or %s", r.Target, TargetSpec, TargetObjects)
+	}
+	if r.Match != nil {
+		for _, glob := range []string{r.Match.Name, r.Match.Namespace} {
+			if _, err := path.Match(glob, ""); err != nil {
+				return fmt.Errorf("bad glob %q: %s", glob, err)
+			}
+		}
+	}
+	if len(r.Assert) == 0 {
+		return fmt.Errorf("no assertions")
+	}
+	for _, a := range r.Assert {
+		if a.Path == "" {
+			return fmt.Errorf("assertion without a path")
+		}
+		if a.Exists == nil && a.In == nil && a.NotIn == nil && a.Pattern == "" {
+			return fmt.Errorf("assertion of %s checks nothing, set exists, in, notIn or pattern", a.Path)
+		}
+		if a.Pattern != "" {
+			var err error
+			if a.pattern, err = regexp.Compile(a.Pattern); err != nil {
+				return fmt.Errorf("bad pattern of %s: %s", a.Path, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Exemptions are the violations that are allowed, by rule ID. A rule ID maps to nil if all its violations are allowed,
+// or else to the objects, in object.Hash form, whose violations are allowed.
+type Exemptions map[string]map[string]bool
+
+// ParseExemptions parses exemptions of the form rule-id, which allows all the violations of a rule, or
+// rule-id=Kind:namespace:name, which allows the violations of one object. The namespace of cluster scoped objects is
+// empty.
+func ParseExemptions(exempts []string) (Exemptions, error) {
+	out := make(Exemptions)
+	for _, e := range exempts {
+		e = strings.TrimSpace(e)
+		if e == "" {
+			continue
+		}
+		kv := strings.SplitN(e, "=", 2)
+		id := kv[0]
+		if len(kv) == 1 {
+			out[id] = nil
+			continue
+		}
+		if len(strings.Split(kv[1], ":")) != 3 {
+			return nil, fmt.Errorf("bad policy exemption %s, the object must be Kind:namespace:name", e)
+		}
+		if objs, ok := out[id]; ok && objs == nil {
+			// The whole rule is already exempted.
+			continue
+		}
+		if out[id] == nil {
+			out[id] = make(map[string]bool)
+		}
+		out[id][kv[1]] = true
+	}
+	return out, nil
+}
+
+// ExemptionsFromAnnotations returns the exemptions listed in the ExemptAnnotationKey annotation in annotations.
+func ExemptionsFromAnnotations(annotations map[string]string) (Exemptions, error) {
+	return ParseExemptions(strings.Split(annotations[ExemptAnnotationKey], ","))
+}
+
+// Merge adds the exemptions in other to e.
+func (e Exemptions) Merge(other Exemptions) {
+	for id, objs := range other {
+		cur, ok := e[id]
+		if objs == nil || (ok && cur == nil) {
+			e[id] = nil
+			continue
+		}
+		if !ok {
+			e[id] = make(map[string]bool)
+		}
+		for o := range objs {
+			e[id][o] = true
+		}
+	}
+}
+
+// exempted reports whether the violations of rule id by the object with the given hash, or by the spec if it is empty,
+// are allowed.
+func (e Exemptions) exempted(id, objHash string) bool {
+	objs, ok := e[id]
+	return ok && (objs == nil || objs[objHash])
+}
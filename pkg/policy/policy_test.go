@@ -0,0 +1,238 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"istio.io/api/operator/v1alpha1"
+	"istio.io/operator/pkg/object"
+	"istio.io/operator/pkg/util"
+)
+
+const testManifest = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: istio-pilot
+  namespace: istio-system
+spec:
+  template:
+    spec:
+      containers:
+      - name: discovery
+        image: docker.io/istio/pilot:1.5.0
+        resources:
+          limits:
+            cpu: "1"
+      - name: istio-proxy
+        image: evil.example.com/proxyv2:1.5.0
+---
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: istio-cni-node
+  namespace: kube-system
+spec:
+  template:
+    spec:
+      hostNetwork: true
+      containers:
+      - name: install-cni
+        image: docker.io/istio/install-cni:1.5.0
+        resources:
+          limits:
+            cpu: 100m
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: istio-ingressgateway
+  namespace: istio-system
+spec:
+  type: LoadBalancer
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: istio-pilot
+  namespace: istio-system
+spec:
+  type: ClusterIP
+`
+
+func TestCheck(t *testing.T) {
+	p, err := Load("../../samples/policy.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	objs, err := object.ParseK8sObjectsFromYAMLManifest(testManifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	iops := &v1alpha1.IstioOperatorSpec{}
+	if err := util.UnmarshalWithJSONPB("hub: quay.io/someone", iops); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		desc    string
+		exempts []string
+		want    []string
+	}{
+		{
+			desc: "no exemptions",
+			want: []string{
+				"error: hub is quay.io/someone, must be one of [docker.io/istio gcr.io/istio-release] [approved-hubs]",
+				"error: Deployment istio-system/istio-pilot: spec.template.spec.containers.1.image is evil.example.com/proxyv2:1.5.0, " +
+					`must match ^(docker\.io/istio|gcr\.io/istio-release|docker\.io/prom|grafana|docker\.io/jaegertracing|quay\.io/kiali)/ ` +
+					"[approved-images]",
+				"error: Service istio-system/istio-ingressgateway: spec.type must not be LoadBalancer [no-loadbalancer-gateways]",
+				"error: Deployment istio-system/istio-pilot: spec.template.spec.containers.1.resources.limits must be set [container-limits]",
+				"error: DaemonSet kube-system/istio-cni-node: spec.template.spec.hostNetwork must not be true [no-host-network]",
+			},
+		},
+		{
+			desc:    "exemptions",
+			exempts: []string{"approved-hubs", "no-host-network=DaemonSet:kube-system:istio-cni-node", "approved-images=Deployment:other:istio-pilot"},
+			want: []string{
+				"info: hub is quay.io/someone, must be one of [docker.io/istio gcr.io/istio-release] (exempted) [approved-hubs]",
+				"error: Deployment istio-system/istio-pilot: spec.template.spec.containers.1.image is evil.example.com/proxyv2:1.5.0, " +
+					`must match ^(docker\.io/istio|gcr\.io/istio-release|docker\.io/prom|grafana|docker\.io/jaegertracing|quay\.io/kiali)/ ` +
+					"[approved-images]",
+				"error: Service istio-system/istio-ingressgateway: spec.type must not be LoadBalancer [no-loadbalancer-gateways]",
+				"error: Deployment istio-system/istio-pilot: spec.template.spec.containers.1.resources.limits must be set [container-limits]",
+				"info: DaemonSet kube-system/istio-cni-node: spec.template.spec.hostNetwork must not be true (exempted) [no-host-network]",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			exempt, err := ParseExemptions(tt.exempts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ds, err := p.Check(iops, objs, exempt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, d := range ds {
+				got = append(got, d.String())
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		desc    string
+		yaml    string
+		wantErr string
+	}{
+		{
+			desc: "unknown field",
+			yaml: `
+rules:
+- id: a
+  asert: []
+`,
+			wantErr: `unknown field "asert"`,
+		},
+		{
+			desc: "duplicate ID",
+			yaml: `
+rules:
+- id: a
+  assert: [{path: a, exists: true}]
+- id: a
+  assert: [{path: a, exists: true}]
+`,
+			wantErr: "rule a is defined more than once",
+		},
+		{
+			desc: "match on spec",
+			yaml: `
+rules:
+- id: a
+  target: spec
+  match: {kinds: [Service]}
+  assert: [{path: a, exists: true}]
+`,
+			wantErr: "rule a: match is only valid for the objects target",
+		},
+		{
+			desc: "empty assertion",
+			yaml: `
+rules:
+- id: a
+  assert: [{path: a}]
+`,
+			wantErr: "rule a: assertion of a checks nothing, set exists, in, notIn or pattern",
+		},
+		{
+			desc: "bad pattern",
+			yaml: `
+rules:
+- id: a
+  assert: [{path: a, pattern: "("}]
+`,
+			wantErr: "rule a: bad pattern of a",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := Parse(tt.yaml)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExemptions(t *testing.T) {
+	e, err := ParseExemptions([]string{"a=Service:ns:x", " b ", "a=Service:ns:y"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := ExemptionsFromAnnotations(map[string]string{ExemptAnnotationKey: "b=Service:ns:z,c"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.Merge(other)
+	for _, tt := range []struct {
+		id, obj string
+		want    bool
+	}{
+		{"a", "Service:ns:x", true},
+		{"a", "Service:ns:z", false},
+		{"a", "", false},
+		{"b", "Service:ns:x", true},
+		{"c", "", true},
+		{"d", "", false},
+	} {
+		if got := e.exempted(tt.id, tt.obj); got != tt.want {
+			t.Errorf("%s %s: got exempted %v, want %v", tt.id, tt.obj, got, tt.want)
+		}
+	}
+	if _, err := ParseExemptions([]string{"a=Service:x"}); err == nil {
+		t.Error("got no error for an object without namespace, want one")
+	}
+}
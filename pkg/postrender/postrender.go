@@ -0,0 +1,231 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package postrender transforms rendered manifests with an external program or a kustomization, for the changes that the
+IstioOperator API cannot express.
+
+A post-renderer is either an executable, which reads the manifest as YAML on stdin and writes the transformed manifest
+as YAML on stdout, or a local directory with a kustomization. The kustomization must list ManifestFile in its
+resources: the directory is copied, the manifest is written to ManifestFile in the copy, and the copy is built with
+kubectl kustomize.
+
+The manifests of all the components are post-rendered together, so that a kustomization sees all the objects it
+patches. Each object is annotated with ComponentAnnotation before, and is returned in the manifest of the component
+named by the annotation after. Objects added by the post-renderer belong to the component they are annotated with, or
+to the Base component.
+*/
+package postrender
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/object"
+	"istio.io/operator/pkg/util"
+	"istio.io/pkg/log"
+)
+
+const (
+	// ComponentAnnotation is the annotation that holds the name of the component of an object while it is
+	// post-rendered.
+	ComponentAnnotation = "install.operator.istio.io/component"
+	// ManifestFile is the file that the manifest is written to in the copy of a kustomization directory.
+	ManifestFile = "manifest.yaml"
+)
+
+// PostRenderer transforms a rendered manifest.
+type PostRenderer interface {
+	// Run returns the transformed manifest.
+	Run(manifest string) (string, error)
+}
+
+// New returns the PostRenderer at path: a kustomization if path is a directory, otherwise an executable, looked up in
+// PATH if path has no separator, which is run with args.
+func New(path string, args []string) (PostRenderer, error) {
+	if fi, err := os.Stat(path); err == nil && fi.IsDir() {
+		if len(args) != 0 {
+			return nil, fmt.Errorf("post-renderer %s is a kustomization directory, it takes no arguments", path)
+		}
+		return newKustomization(path)
+	}
+	p, err := exec.LookPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("post-renderer %s is neither a kustomization directory nor an executable: %s", path, err)
+	}
+	return &execRenderer{path: p, args: args}, nil
+}
+
+// execRenderer runs an executable with the manifest on stdin.
+type execRenderer struct {
+	path string
+	args []string
+}
+
+// Run implements the PostRenderer interface.
+func (r *execRenderer) Run(manifest string) (string, error) {
+	return run(exec.Command(r.path, r.args...), manifest)
+}
+
+// kustomization builds a kustomization directory that includes the manifest.
+type kustomization struct {
+	dir string
+}
+
+// newKustomization returns the kustomization in dir, which must have a kustomization file.
+func newKustomization(dir string) (*kustomization, error) {
+	for _, f := range []string{"kustomization.yaml", "kustomization.yml", "Kustomization"} {
+		if _, err := os.Stat(filepath.Join(dir, f)); err == nil {
+			return &kustomization{dir: dir}, nil
+		}
+	}
+	return nil, fmt.Errorf("post-renderer directory %s has no kustomization file", dir)
+}
+
+// Run implements the PostRenderer interface.
+func (k *kustomization) Run(manifest string) (string, error) {
+	tmp, err := ioutil.TempDir("", "istio-post-render")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(tmp)
+	if err := copyDir(k.dir, tmp); err != nil {
+		return "", fmt.Errorf("could not copy kustomization %s: %s", k.dir, err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(tmp, ManifestFile), []byte(manifest), 0644); err != nil {
+		return "", err
+	}
+	return run(exec.Command("kubectl", "kustomize", tmp), "")
+}
+
+// copyDir copies the files in the directory tree src to the existing directory dst.
+func copyDir(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(target, b, info.Mode().Perm())
+	})
+}
+
+// run runs cmd with stdin, and returns its stdout. The error includes its stderr if it fails.
+func run(cmd *exec.Cmd, stdin string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd.Stdin = strings.NewReader(stdin)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	log.Infof("running post-renderer: %s", strings.Join(cmd.Args, " "))
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("post-renderer %s failed: %s: %s", strings.Join(cmd.Args, " "), err,
+			util.ConsolidateLog(stderr.String()))
+	}
+	return stdout.String(), nil
+}
+
+// RunOnManifests post-renders the manifests of all the components together with r, and returns the manifests of each
+// component after post-rendering. Components with no objects, like disabled ones, are returned unchanged.
+func RunOnManifests(r PostRenderer, manifests name.ManifestMap) (name.ManifestMap, error) {
+	var cns []string
+	for cn := range manifests {
+		cns = append(cns, string(cn))
+	}
+	sort.Strings(cns)
+
+	var in object.K8sObjects
+	out := make(name.ManifestMap)
+	rendered := make(map[name.ComponentName]bool)
+	for _, c := range cns {
+		cn := name.ComponentName(c)
+		var objs object.K8sObjects
+		for _, m := range manifests[cn] {
+			mobjs, err := object.ParseK8sObjectsFromYAMLManifest(m)
+			if err != nil {
+				return nil, err
+			}
+			objs = append(objs, mobjs...)
+		}
+		if len(objs) == 0 {
+			out[cn] = manifests[cn]
+			continue
+		}
+		for _, o := range objs {
+			o.AddAnnotations(map[string]string{ComponentAnnotation: c})
+		}
+		in = append(in, objs...)
+		rendered[cn] = true
+	}
+	if len(in) == 0 {
+		return out, nil
+	}
+	iy, err := in.YAMLManifest()
+	if err != nil {
+		return nil, err
+	}
+	oy, err := r.Run(iy)
+	if err != nil {
+		return nil, err
+	}
+	objs, err := object.ParseK8sObjectsFromYAMLManifest(oy)
+	if err != nil {
+		return nil, err
+	}
+
+	byComponent := make(map[name.ComponentName]object.K8sObjects)
+	for _, o := range objs {
+		u := o.UnstructuredObject()
+		annotations := u.GetAnnotations()
+		cn := name.ComponentName(annotations[ComponentAnnotation])
+		if cn == "" {
+			cn = name.IstioBaseComponentName
+		} else if _, ok := manifests[cn]; !ok {
+			return nil, fmt.Errorf("object %s in the post-renderer output has unknown component %s in annotation %s",
+				o.Hash(), cn, ComponentAnnotation)
+		}
+		delete(annotations, ComponentAnnotation)
+		if len(annotations) == 0 {
+			annotations = nil
+		}
+		u.SetAnnotations(annotations)
+		byComponent[cn] = append(byComponent[cn], object.NewK8sObject(u, nil, nil))
+		rendered[cn] = true
+	}
+	// Components whose objects are all removed get an empty manifest, so that they are pruned.
+	for cn := range rendered {
+		y, err := byComponent[cn].YAMLManifest()
+		if err != nil {
+			return nil, err
+		}
+		out[cn] = []string{y}
+	}
+	return out, nil
+}
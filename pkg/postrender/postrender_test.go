@@ -0,0 +1,196 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postrender
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/object"
+)
+
+const (
+	pilotManifest = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: istio-pilot
+  namespace: istio-system
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: istio-pilot
+  namespace: istio-system
+`
+	galleyManifest = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: istio-galley
+  namespace: istio-system
+`
+)
+
+// funcRenderer is a PostRenderer that runs a function.
+type funcRenderer func(string) (string, error)
+
+func (f funcRenderer) Run(manifest string) (string, error) {
+	return f(manifest)
+}
+
+func TestRunOnManifests(t *testing.T) {
+	manifests := name.ManifestMap{
+		name.IstioBaseComponentName: {"# Base component is disabled.\n"},
+		name.PilotComponentName:     {pilotManifest},
+		name.GalleyComponentName:    {galleyManifest},
+	}
+	var gotIn string
+	r := funcRenderer(func(in string) (string, error) {
+		gotIn = in
+		// Rename the pilot Deployment, drop galley, and add a Namespace.
+		out := strings.Replace(in, "name: istio-pilot\n  namespace", "name: istiod\n  namespace", 1)
+		var kept []string
+		for _, y := range strings.Split(out, "---\n") {
+			if !strings.Contains(y, "istio-galley") {
+				kept = append(kept, y)
+			}
+		}
+		kept = append(kept, "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: istio-system\n")
+		return strings.Join(kept, "---\n"), nil
+	})
+	got, err := RunOnManifests(r, manifests)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(gotIn, ComponentAnnotation+": Pilot") != 2 || strings.Count(gotIn, ComponentAnnotation+": Galley") != 1 {
+		t.Errorf("post-renderer input is not annotated with the components:\n%s", gotIn)
+	}
+	want := map[name.ComponentName][]string{
+		name.IstioBaseComponentName: {"Namespace::istio-system"},
+		name.PilotComponentName:     {"Deployment:istio-system:istiod", "Service:istio-system:istio-pilot"},
+		name.GalleyComponentName:    nil,
+	}
+	for cn, wantHashes := range want {
+		if len(got[cn]) != 1 {
+			t.Fatalf("%s: got %d manifests, want 1", cn, len(got[cn]))
+		}
+		objs, err := object.ParseK8sObjectsFromYAMLManifest(got[cn][0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		var gotHashes []string
+		for _, o := range objs {
+			gotHashes = append(gotHashes, o.Hash())
+			if _, ok := o.UnstructuredObject().GetAnnotations()[ComponentAnnotation]; ok {
+				t.Errorf("%s: %s still has annotation %s", cn, o.Hash(), ComponentAnnotation)
+			}
+		}
+		if strings.Join(gotHashes, ",") != strings.Join(wantHashes, ",") {
+			t.Errorf("%s: got objects %v, want %v", cn, gotHashes, wantHashes)
+		}
+	}
+}
+
+func TestRunOnManifestsUnknownComponent(t *testing.T) {
+	r := funcRenderer(func(in string) (string, error) {
+		return strings.Replace(in, ComponentAnnotation+": Pilot", ComponentAnnotation+": Foo", -1), nil
+	})
+	_, err := RunOnManifests(r, name.ManifestMap{name.PilotComponentName: {pilotManifest}})
+	want := "object Deployment:istio-system:istio-pilot in the post-renderer output has unknown component Foo"
+	if err == nil || !strings.HasPrefix(err.Error(), want) {
+		t.Errorf("got error %v, want prefix %s", err, want)
+	}
+}
+
+func TestExec(t *testing.T) {
+	r, err := New("sed", []string{"s/istio-pilot/istiod/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := r.Run(pilotManifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := strings.Replace(pilotManifest, "istio-pilot", "istiod", -1); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+
+	r, err = New("sh", []string{"-c", "echo bad patch >&2; exit 3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Run(pilotManifest); err == nil || !strings.Contains(err.Error(), "bad patch") {
+		t.Errorf("got error %v, want one with the stderr of the post-renderer", err)
+	}
+
+	if _, err := New("no-such-post-renderer", nil); err == nil {
+		t.Error("got no error for a missing post-renderer")
+	}
+}
+
+func TestKustomization(t *testing.T) {
+	dir, err := ioutil.TempDir("", "post-render-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	// A stand-in for kubectl kustomize, which outputs the manifest of the kustomization with its patch applied.
+	bin := filepath.Join(dir, "bin")
+	kustomization := filepath.Join(dir, "kustomization")
+	for _, d := range []string{bin, filepath.Join(kustomization, "patches")} {
+		if err := os.MkdirAll(d, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	files := map[string]string{
+		filepath.Join(bin, "kubectl"): `#!/bin/sh
+[ "$1" = kustomize ] || exit 1
+sed "$(cat "$2/patches/rename.sed")" "$2/` + ManifestFile + `"
+`,
+		filepath.Join(kustomization, "kustomization.yaml"):    "resources:\n- " + ManifestFile + "\n",
+		filepath.Join(kustomization, "patches", "rename.sed"): "s/istio-pilot/istiod/",
+	}
+	for f, c := range files {
+		if err := ioutil.WriteFile(f, []byte(c), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	path := os.Getenv("PATH")
+	defer os.Setenv("PATH", path)
+	if err := os.Setenv("PATH", bin+string(os.PathListSeparator)+path); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := New(kustomization, []string{"-v"}); err == nil {
+		t.Error("got no error for the arguments of a kustomization")
+	}
+	if _, err := New(bin, nil); err == nil || !strings.Contains(err.Error(), "has no kustomization file") {
+		t.Errorf("got error %v, want one for the missing kustomization file", err)
+	}
+	r, err := New(kustomization, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := r.Run(pilotManifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := strings.Replace(pilotManifest, "istio-pilot", "istiod", -1); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
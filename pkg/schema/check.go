@@ -0,0 +1,190 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	yaml3 "gopkg.in/yaml.v3"
+
+	"istio.io/operator/pkg/util"
+)
+
+const (
+	// maxSuggestions is the maximum number of field names suggested for an unknown field.
+	maxSuggestions = 3
+)
+
+// UnknownFieldError is the error for a field of an IstioOperatorSpec that is not in the schema.
+type UnknownFieldError struct {
+	// Path is the path of the unknown field in IstioOperatorSpec YAML.
+	Path util.Path
+	// Source is where the field was set, e.g. a file:line or --set flag, or empty if unknown.
+	Source string
+	// Suggestions are the closest valid field names, best first.
+	Suggestions []string
+}
+
+// Error implements the error interface.
+func (e *UnknownFieldError) Error() string {
+	msg := e.Path.String() + ": unknown field"
+	if len(e.Suggestions) != 0 {
+		msg += ", did you mean " + strings.Join(e.Suggestions, " or ") + "?"
+	}
+	if e.Source != "" {
+		msg = e.Source + ": " + msg
+	}
+	return msg
+}
+
+// CheckFields checks that all fields of the IstioOperatorSpec at root in YAML string y, e.g. spec in an
+// IstioOperator CR, are in the schema. It returns an UnknownFieldError for each unknown field, whose source is file and
+// the line of the field in y if file is not empty.
+func CheckFields(y string, root util.Path, file string) util.Errors {
+	tree := make(map[string]interface{})
+	if err := yaml.Unmarshal([]byte(y), &tree); err != nil {
+		return util.NewErrs(err)
+	}
+	var node interface{} = tree
+	for _, pe := range root {
+		m, ok := node.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		node = m[pe]
+	}
+	var errs util.Errors
+	var doc *yaml3.Node
+	if file != "" {
+		// y was already parsed above, so it can only fail to parse as a node tree if yaml.v2 and yaml.v3 disagree,
+		// in which case the errors are reported without lines.
+		doc, _ = util.ParseYAMLNode(y)
+	}
+	checkNode(Root(), node, func(path util.Path, suggestions []string) {
+		e := &UnknownFieldError{Path: path, Suggestions: suggestions, Source: file}
+		if _, line := util.YAMLNodeAt(doc, append(append(util.Path{}, root...), path...)); line != 0 {
+			e.Source = fmt.Sprintf("%s:%d", file, line)
+		}
+		errs = append(errs, e)
+	})
+	return errs
+}
+
+// checkNode calls unknown with the path and suggestions of each field of the YAML tree node, which has the schema of
+// f, that is not in the schema.
+func checkNode(f *Field, node interface{}, unknown func(util.Path, []string)) {
+	t := deref(f.Type)
+	switch n := node.(type) {
+	case []interface{}:
+		if t.Kind() != reflect.Slice || !f.hasMessageElements() {
+			return
+		}
+		for i, e := range n {
+			checkNode(f.element(strconv.Itoa(i)), e, unknown)
+		}
+	case map[string]interface{}:
+		switch {
+		case t.Kind() == reflect.Map && f.hasMessageElements():
+			for k, v := range n {
+				checkNode(f.element(k), v, unknown)
+			}
+			return
+		case !isMessage(t):
+			return
+		}
+		children := f.Fields()
+		for k, v := range n {
+			c := child(children, k)
+			if c == nil {
+				unknown(append(append(util.Path{}, f.Path...), k), Suggest(k, names(children)))
+				continue
+			}
+			checkNode(c, v, unknown)
+		}
+	}
+}
+
+// child returns the field in fields with YAML or proto name pe, or nil if there is none.
+func child(fields []*Field, pe string) *Field {
+	for _, c := range fields {
+		if c.Name() == pe || c.protoFieldName() == pe {
+			return c
+		}
+	}
+	return nil
+}
+
+// Suggest returns the names in candidates closest to name by case insensitive edit distance, best first. Names that
+// are too different to be a likely typo of name are omitted.
+func Suggest(name string, candidates []string) []string {
+	type scored struct {
+		name string
+		dist int
+	}
+	// Allow about one edit for every three characters, and at least two.
+	maxDist := len(name) / 3
+	if maxDist < 2 {
+		maxDist = 2
+	}
+	var s []scored
+	for _, c := range candidates {
+		if d := editDistance(strings.ToLower(name), strings.ToLower(c)); d <= maxDist {
+			s = append(s, scored{name: c, dist: d})
+		}
+	}
+	sort.SliceStable(s, func(i, j int) bool { return s[i].dist < s[j].dist })
+	var out []string
+	for i := 0; i < len(s) && i < maxSuggestions; i++ {
+		out = append(out, s[i].name)
+	}
+	return out
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
+func minInt(v ...int) int {
+	out := v[0]
+	for _, x := range v[1:] {
+		if x < out {
+			out = x
+		}
+	}
+	return out
+}
@@ -0,0 +1,180 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"istio.io/operator/pkg/helm"
+	"istio.io/operator/pkg/util"
+)
+
+func TestCheckFields(t *testing.T) {
+	tests := []struct {
+		desc string
+		yaml string
+		root util.Path
+		file string
+		want []string
+	}{
+		{
+			desc: "valid",
+			yaml: `
+hub: docker.io/istio
+components:
+  pilot:
+    enabled: true
+    k8s:
+      nodeSelector:
+        anyKey: anyValue
+  ingressGateways:
+  - name: istio-ingressgateway
+    k8s:
+      replicaCount: 2
+meshConfig:
+  rootNamespace: istio-system
+values:
+  global:
+    proxy:
+      resources:
+        limits:
+          cpu: 100m
+`,
+		},
+		{
+			desc: "proto field names",
+			yaml: `
+install_package_path: /tmp
+`,
+		},
+		{
+			desc: "typos",
+			yaml: `
+componets:
+  pilot:
+    enabled: true
+values:
+  global:
+    proxy:
+      resorces: {}
+`,
+			want: []string{
+				"componets: unknown field, did you mean components?",
+				"values.global.proxy.resorces: unknown field, did you mean resources?",
+			},
+		},
+		{
+			desc: "list element",
+			yaml: `
+components:
+  ingressGateways:
+  - name: istio-ingressgateway
+    k8s:
+      replicaCont: 2
+`,
+			want: []string{"components.ingressGateways.0.k8s.replicaCont: unknown field, did you mean replicaCount?"},
+		},
+		{
+			desc: "no suggestion",
+			yaml: `
+values:
+  notAValuesField: true
+`,
+			want: []string{"values.notAValuesField: unknown field"},
+		},
+		{
+			desc: "file and line",
+			yaml: `apiVersion: install.istio.io/v1alpha1
+kind: IstioOperator
+spec:
+  values:
+    global:
+      proxy:
+        resorces: {}
+`,
+			root: util.Path{"spec"},
+			file: "my.yaml",
+			want: []string{"my.yaml:7: values.global.proxy.resorces: unknown field, did you mean resources?"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var got []string
+			for _, err := range CheckFields(tt.yaml, tt.root, tt.file) {
+				got = append(got, err.Error())
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CheckFields(): got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckFieldsBuiltinProfiles(t *testing.T) {
+	for _, profile := range helm.ListBuiltinProfiles() {
+		t.Run(profile, func(t *testing.T) {
+			y, err := helm.LoadValuesVFS(profile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if errs := CheckFields(y, util.Path{"spec"}, helm.BuiltinProfileToFilename(profile)); len(errs) != 0 {
+				t.Errorf("got unknown fields in built-in profile %s:\n%s", profile, errs)
+			}
+		})
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	tests := []struct {
+		desc       string
+		name       string
+		candidates []string
+		want       []string
+	}{
+		{
+			desc:       "transposition",
+			name:       "resorces",
+			candidates: []string{"resources", "readinessPort", "image"},
+			want:       []string{"resources"},
+		},
+		{
+			desc:       "case",
+			name:       "Hub",
+			candidates: []string{"hub", "tag"},
+			want:       []string{"hub"},
+		},
+		{
+			desc:       "best first",
+			name:       "tags",
+			candidates: []string{"tls", "tag"},
+			want:       []string{"tag", "tls"},
+		},
+		{
+			desc:       "too different",
+			name:       "foo",
+			candidates: []string{"components", "values"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			if got := Suggest(tt.name, tt.candidates); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Suggest(%s): got %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
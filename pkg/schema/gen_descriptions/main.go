@@ -0,0 +1,176 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+/*
+Generated Go code does not keep the comments of the proto definitions, so this binary extracts them into a map from
+proto message and field names to descriptions, e.g.
+
+	"istio.operator.v1alpha1.IstioOperatorSpec.hub": "Root for docker image paths e.g. docker.io/istio",
+
+The proto files are given as arguments. Paths of the form <module>//<path> are relative to the directory of the given
+Go module, as reported by go list.
+*/
+
+var (
+	packageRegexp = regexp.MustCompile(`^package\s+([\w.]+)\s*;`)
+	messageRegexp = regexp.MustCompile(`^message\s+(\w+)\s*{`)
+	blockRegexp   = regexp.MustCompile(`^(enum|oneof)\s+\w+\s*{`)
+	fieldRegexp   = regexp.MustCompile(`^(?:repeated\s+|optional\s+)?(?:map\s*<[^>]*>|[\w.]+)\s+(\w+)\s*=\s*\d+[^;]*;\s*(?://\s*(.*))?$`)
+)
+
+func main() {
+	out := flag.String("o", "descriptions.gen.go", "Output file.")
+	pkg := flag.String("p", "schema", "Package name of the output file.")
+	flag.Parse()
+
+	descriptions := make(map[string]string)
+	for _, f := range flag.Args() {
+		path, err := resolve(f)
+		if err != nil {
+			fail(err)
+		}
+		if err := parseProto(path, descriptions); err != nil {
+			fail(err)
+		}
+	}
+
+	var keys []string
+	for k := range descriptions {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by gen_descriptions. DO NOT EDIT.\n\npackage %s\n\n", *pkg)
+	b.WriteString("// descriptions maps proto message and field names to their comments in the proto definitions.\n")
+	b.WriteString("var descriptions = map[string]string{\n")
+	for _, k := range keys {
+		fmt.Fprintf(&b, "\t%q: %q,\n", k, descriptions[k])
+	}
+	b.WriteString("}\n")
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		fail(err)
+	}
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		fail(err)
+	}
+}
+
+// resolve returns the file path of the proto file argument f.
+func resolve(f string) (string, error) {
+	i := strings.Index(f, "//")
+	if i < 0 {
+		return f, nil
+	}
+	dir, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", f[:i]).Output()
+	if err != nil {
+		return "", fmt.Errorf("could not find module %s: %s", f[:i], err)
+	}
+	return filepath.Join(strings.TrimSpace(string(dir)), f[i+2:]), nil
+}
+
+// parseProto adds the descriptions of the messages and fields in the proto file at path to descriptions.
+func parseProto(path string, descriptions map[string]string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	pkg := ""
+	// scopes holds the names of the enclosing messages, or the empty string for other blocks.
+	var scopes []string
+	var comment []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			comment = nil
+			continue
+		case strings.HasPrefix(line, "//"):
+			comment = appendComment(comment, strings.TrimPrefix(line, "//"))
+			continue
+		}
+
+		if m := packageRegexp.FindStringSubmatch(line); m != nil {
+			pkg = m[1]
+		} else if m := messageRegexp.FindStringSubmatch(line); m != nil {
+			name := pkg + "." + m[1]
+			if len(scopes) != 0 && scopes[len(scopes)-1] != "" {
+				name = scopes[len(scopes)-1] + "." + m[1]
+			}
+			addDescription(descriptions, name, comment)
+			if !strings.HasSuffix(line, "}") {
+				scopes = append(scopes, name)
+			}
+		} else if blockRegexp.MatchString(line) {
+			scope := ""
+			if strings.HasPrefix(line, "oneof") && len(scopes) != 0 {
+				// Fields in a oneof belong to the enclosing message.
+				scope = scopes[len(scopes)-1]
+			}
+			scopes = append(scopes, scope)
+		} else if strings.HasPrefix(line, "}") {
+			if len(scopes) != 0 {
+				scopes = scopes[:len(scopes)-1]
+			}
+		} else if m := fieldRegexp.FindStringSubmatch(line); m != nil && len(scopes) != 0 && scopes[len(scopes)-1] != "" {
+			if len(comment) == 0 && m[2] != "" {
+				comment = appendComment(nil, m[2])
+			}
+			addDescription(descriptions, scopes[len(scopes)-1]+"."+m[1], comment)
+		}
+		comment = nil
+	}
+	return scanner.Err()
+}
+
+// appendComment appends the comment line l to comment, dropping generator directives.
+func appendComment(comment []string, l string) []string {
+	l = strings.TrimSpace(l)
+	if strings.HasPrefix(l, "$") || strings.HasPrefix(l, "+") || strings.HasPrefix(l, "GOTYPE:") ||
+		strings.HasPrefix(l, "GOFIELD:") {
+		return comment
+	}
+	return append(comment, l)
+}
+
+func addDescription(descriptions map[string]string, name string, comment []string) {
+	if d := strings.TrimSpace(strings.Join(comment, "\n")); d != "" {
+		descriptions[name] = d
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
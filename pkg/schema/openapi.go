@@ -0,0 +1,275 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+
+	"istio.io/operator/pkg/util"
+)
+
+const (
+	// JSONSchemaDraft is the JSON Schema version of the schema returned by JSONSchema.
+	JSONSchemaDraft = "http://json-schema.org/draft-07/schema#"
+)
+
+// OpenAPIV3 returns the OpenAPI v3 schema of the IstioOperatorSpec API, including the Helm values. The schema is
+// structural, as CRDs require: the fixed up map[string]interface{} (TypeMapStringInterface) fields are objects that
+// preserve unknown fields and the interface{} (TypeInterface) fields accept any value. Every field is nullable, like in
+// proto JSON. Descriptions are taken from the proto definitions if withDescriptions is set.
+func OpenAPIV3(withDescriptions bool) *apiextensionsv1beta1.JSONSchemaProps {
+	g := &openAPIGenerator{descriptions: withDescriptions, visiting: make(map[reflect.Type]bool)}
+	root := Root()
+	s := g.schema(root)
+	if withDescriptions {
+		s.Description = root.Description()
+	}
+	return &s
+}
+
+// CRDValidation returns the validation of the IstioOperator CRD, whose spec has the OpenAPIV3 schema without
+// descriptions, since the CRD is applied with kubectl and must fit in its last-applied-configuration annotation.
+func CRDValidation() *apiextensionsv1beta1.CustomResourceValidation {
+	return &apiextensionsv1beta1.CustomResourceValidation{OpenAPIV3Schema: istioOperatorSchema(OpenAPIV3(false))}
+}
+
+// JSONSchema returns a standalone JSON Schema of IstioOperator CRs, with descriptions, for editors. It is the
+// OpenAPIV3 schema with nullable types turned into JSON Schema type lists.
+func JSONSchema() (map[string]interface{}, error) {
+	b, err := json.Marshal(istioOperatorSchema(OpenAPIV3(true)))
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]interface{})
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	toJSONSchema(out)
+	out["$schema"] = JSONSchemaDraft
+	out["title"] = "IstioOperator"
+	return out, nil
+}
+
+// istioOperatorSchema returns the schema of IstioOperator objects whose spec has the schema spec.
+func istioOperatorSchema(spec *apiextensionsv1beta1.JSONSchemaProps) *apiextensionsv1beta1.JSONSchemaProps {
+	spec.Type = "object"
+	spec.Nullable = false
+	return &apiextensionsv1beta1.JSONSchemaProps{
+		Type: "object",
+		Properties: map[string]apiextensionsv1beta1.JSONSchemaProps{
+			"apiVersion": {Type: "string"},
+			"kind":       {Type: "string"},
+			"metadata":   {Type: "object"},
+			"spec":       *spec,
+			"status":     {Type: "object", XPreserveUnknownFields: boolPtr(true)},
+		},
+	}
+}
+
+// toJSONSchema replaces the OpenAPI nullable fields in the unmarshaled schema s and its children with JSON Schema
+// type lists that include null.
+func toJSONSchema(s interface{}) {
+	switch n := s.(type) {
+	case map[string]interface{}:
+		if nullable, _ := n["nullable"].(bool); nullable {
+			delete(n, "nullable")
+			if t, ok := n["type"].(string); ok {
+				n["type"] = []interface{}{t, "null"}
+			}
+		}
+		for _, v := range n {
+			toJSONSchema(v)
+		}
+	case []interface{}:
+		for _, v := range n {
+			toJSONSchema(v)
+		}
+	}
+}
+
+// openAPIGenerator generates the OpenAPI schemas of fields.
+type openAPIGenerator struct {
+	// descriptions is whether the schemas of message fields have descriptions.
+	descriptions bool
+	// visiting are the message types whose schemas are being generated, so that recursive types end.
+	visiting map[reflect.Type]bool
+}
+
+// schema returns the schema of the values of f.
+func (g *openAPIGenerator) schema(f *Field) apiextensionsv1beta1.JSONSchemaProps {
+	t := deref(f.Type)
+	switch {
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		return apiextensionsv1beta1.JSONSchemaProps{Type: "string", Format: "byte"}
+	case t.Kind() == reflect.Slice:
+		items := g.schema(f.element(""))
+		return apiextensionsv1beta1.JSONSchemaProps{Type: "array", Items: &apiextensionsv1beta1.JSONSchemaPropsOrArray{Schema: &items}}
+	case t.Kind() == reflect.Map && t.Elem().Kind() == reflect.Interface:
+		// TypeMapStringInterface.
+		return apiextensionsv1beta1.JSONSchemaProps{Type: "object", XPreserveUnknownFields: boolPtr(true)}
+	case t.Kind() == reflect.Map:
+		v := g.schema(f.element(MapKeyPathElement))
+		return apiextensionsv1beta1.JSONSchemaProps{
+			Type:                 "object",
+			AdditionalProperties: &apiextensionsv1beta1.JSONSchemaPropsOrBool{Allows: true, Schema: &v},
+		}
+	case t.Kind() == reflect.Interface:
+		// TypeInterface.
+		return apiextensionsv1beta1.JSONSchemaProps{XPreserveUnknownFields: boolPtr(true)}
+	case f.enum != "":
+		s := apiextensionsv1beta1.JSONSchemaProps{Type: "string"}
+		for _, v := range f.EnumValues() {
+			s.Enum = append(s.Enum, apiextensionsv1beta1.JSON{Raw: []byte(`"` + v + `"`)})
+		}
+		return s
+	case t.Kind() == reflect.Struct:
+		return g.messageSchema(f, t)
+	}
+	return scalarSchema(t.Kind())
+}
+
+// messageSchema returns the schema of the values of f, whose type is the struct t.
+func (g *openAPIGenerator) messageSchema(f *Field, t reflect.Type) apiextensionsv1beta1.JSONSchemaProps {
+	switch typeName(t) {
+	case "bool":
+		return apiextensionsv1beta1.JSONSchemaProps{Type: "boolean"}
+	case "int32", "uint32":
+		return apiextensionsv1beta1.JSONSchemaProps{Type: "integer", Format: "int32"}
+	case "int64", "uint64", "int-or-string", "quantity":
+		// Proto JSON has 64 bit integers as strings.
+		return intOrStringSchema()
+	case "float", "double":
+		return apiextensionsv1beta1.JSONSchemaProps{Type: "number"}
+	case "string", "duration":
+		return apiextensionsv1beta1.JSONSchemaProps{Type: "string"}
+	case "any", "object":
+		return apiextensionsv1beta1.JSONSchemaProps{Type: "object", XPreserveUnknownFields: boolPtr(true)}
+	}
+	if !isMessage(t) || g.visiting[t] {
+		return apiextensionsv1beta1.JSONSchemaProps{Type: "object", XPreserveUnknownFields: boolPtr(true)}
+	}
+	g.visiting[t] = true
+	defer delete(g.visiting, t)
+
+	s := apiextensionsv1beta1.JSONSchemaProps{Type: "object", Properties: make(map[string]apiextensionsv1beta1.JSONSchemaProps)}
+	for _, c := range append(f.Fields(), oneofFields(f, t)...) {
+		cs := g.schema(c)
+		cs.Nullable = true
+		if g.descriptions {
+			cs.Description = c.Description()
+		}
+		s.Properties[c.Name()] = cs
+	}
+	return s
+}
+
+// oneofFields returns the fields of the oneofs of the message field f, whose type is the struct t. Proto JSON has
+// them inline in the message, like other fields.
+func oneofFields(f *Field, t reflect.Type) []*Field {
+	m, ok := reflect.New(t).Interface().(interface{ XXX_OneofWrappers() []interface{} })
+	if !ok {
+		return nil
+	}
+	var out []*Field
+	for _, w := range m.XXX_OneofWrappers() {
+		wt := deref(reflect.TypeOf(w))
+		for i := 0; i < wt.NumField(); i++ {
+			sf := wt.Field(i)
+			tag := sf.Tag.Get("protobuf")
+			if tag == "" {
+				continue
+			}
+			name, jsonName, enum := parseProtobufTag(tag)
+			yamlName := name
+			if jsonName != "" {
+				yamlName = jsonName
+			}
+			out = append(out, &Field{
+				Path:      append(append(util.Path{}, f.Path...), yamlName),
+				GoPath:    append(append(util.Path{}, f.GoPath...), sf.Name),
+				Type:      sf.Type,
+				protoName: messageName(t) + "." + name,
+				enum:      enum,
+			})
+		}
+	}
+	return out
+}
+
+// scalarSchema returns the schema of values of the Go kind k.
+func scalarSchema(k reflect.Kind) apiextensionsv1beta1.JSONSchemaProps {
+	switch k {
+	case reflect.Bool:
+		return apiextensionsv1beta1.JSONSchemaProps{Type: "boolean"}
+	case reflect.Int32, reflect.Uint32:
+		return apiextensionsv1beta1.JSONSchemaProps{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint64:
+		return intOrStringSchema()
+	case reflect.Float32, reflect.Float64:
+		return apiextensionsv1beta1.JSONSchemaProps{Type: "number"}
+	case reflect.String:
+		return apiextensionsv1beta1.JSONSchemaProps{Type: "string"}
+	}
+	return apiextensionsv1beta1.JSONSchemaProps{XPreserveUnknownFields: boolPtr(true)}
+}
+
+// intOrStringSchema returns the structural schema of values that are integers or strings.
+func intOrStringSchema() apiextensionsv1beta1.JSONSchemaProps {
+	return apiextensionsv1beta1.JSONSchemaProps{
+		XIntOrString: true,
+		AnyOf:        []apiextensionsv1beta1.JSONSchemaProps{{Type: "integer"}, {Type: "string"}},
+	}
+}
+
+// structuralErrors returns the reasons the schema s at path is not structural, in the sense of the Kubernetes CRD API,
+// e.g. a node without a type. It returns nil for a structural schema.
+func structuralErrors(s *apiextensionsv1beta1.JSONSchemaProps, path string) []string {
+	var out []string
+	name := path
+	if name == "" {
+		name = "<root>"
+	}
+	preserve := s.XPreserveUnknownFields != nil && *s.XPreserveUnknownFields
+	switch {
+	case s.XIntOrString:
+		if s.Type != "" {
+			out = append(out, name+": int-or-string must not have a type")
+		}
+	case s.Type == "" && !preserve:
+		out = append(out, name+": type is not set")
+	}
+	if len(s.Properties) != 0 && s.AdditionalProperties != nil {
+		out = append(out, name+": properties and additionalProperties are both set")
+	}
+	for k, p := range s.Properties {
+		p := p
+		out = append(out, structuralErrors(&p, strings.TrimPrefix(path+"."+k, "."))...)
+	}
+	if s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil {
+		out = append(out, structuralErrors(s.AdditionalProperties.Schema, path+"."+MapKeyPathElement)...)
+	}
+	if s.Items != nil && s.Items.Schema != nil {
+		out = append(out, structuralErrors(s.Items.Schema, path+"[]")...)
+	}
+	return out
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,257 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+
+	"istio.io/operator/pkg/helm"
+)
+
+const (
+	// maxCRDValidationSize is the maximum size of the CRD validation in JSON. kubectl apply keeps the whole CRD in an
+	// annotation, and the annotations of an object must not exceed 256KiB.
+	maxCRDValidationSize = 240 * 1024
+)
+
+func TestCRDValidation(t *testing.T) {
+	v := CRDValidation()
+	if errs := structuralErrors(v.OpenAPIV3Schema, ""); len(errs) != 0 {
+		t.Errorf("schema is not structural:\n%v", errs)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b) > maxCRDValidationSize {
+		t.Errorf("got CRD validation of %d bytes, want at most %d", len(b), maxCRDValidationSize)
+	}
+}
+
+func TestOpenAPIV3(t *testing.T) {
+	s := CRDValidation().OpenAPIV3Schema
+	tests := []struct {
+		desc string
+		yaml string
+		want []string
+	}{
+		{
+			desc: "valid",
+			yaml: `
+spec:
+  hub: docker.io/istio
+  components:
+    pilot:
+      enabled: true
+      k8s:
+        nodeSelector:
+          anyKey: anyValue
+        replicaCount: 2
+        resources:
+          requests:
+            cpu: 100m
+    ingressGateways:
+    - name: istio-ingressgateway
+      k8s:
+        overlays:
+        - kind: Deployment
+          name: istio-ingressgateway
+          patches:
+          - path: spec.replicas
+            value: 3
+          - path: metadata.labels
+            value:
+              a: b
+  meshConfig:
+    defaultConfig:
+      tracing:
+        zipkin:
+          address: zipkin:9411
+  values:
+    global:
+      proxy:
+        accessLogEncoding: JSON
+      podDNSSearchNamespaces: [a]
+      arbitrary:
+        fields: [1, two]
+    pilot:
+      autoscaleEnabled: null
+  unvalidatedValues:
+    anything: 1
+`,
+		},
+		{
+			desc: "bad types",
+			yaml: `
+spec:
+  hub: 1
+  components:
+    pilot:
+      enabled: "yes"
+      k8s:
+        replicaCount: two
+    ingressGateways:
+      name: istio-ingressgateway
+  values:
+    global:
+      proxy:
+        accessLogEncoding: XML
+`,
+			want: []string{
+				"spec.components.ingressGateways: got object, want array",
+				"spec.components.pilot.enabled: got string, want boolean",
+				"spec.components.pilot.k8s.replicaCount: got string, want integer",
+				"spec.hub: got number, want string",
+				`spec.values.global.proxy.accessLogEncoding: got "XML", want one of ["JSON","TEXT"]`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var v interface{}
+			if err := yaml.Unmarshal([]byte(tt.yaml), &v); err != nil {
+				t.Fatal(err)
+			}
+			got := validateSchema(s, v, "")
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got:\n%v\nwant:\n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOpenAPIV3BuiltinProfiles(t *testing.T) {
+	s := CRDValidation().OpenAPIV3Schema
+	for _, profile := range helm.ListBuiltinProfiles() {
+		t.Run(profile, func(t *testing.T) {
+			y, err := helm.LoadValuesVFS(profile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var v interface{}
+			if err := yaml.Unmarshal([]byte(y), &v); err != nil {
+				t.Fatal(err)
+			}
+			if errs := validateSchema(s, v, ""); len(errs) != 0 {
+				t.Errorf("profile does not match the schema:\n%v", errs)
+			}
+		})
+	}
+}
+
+func TestJSONSchema(t *testing.T) {
+	s, err := JSONSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := s["$schema"]; got != JSONSchemaDraft {
+		t.Errorf("got $schema %v, want %s", got, JSONSchemaDraft)
+	}
+	hub := s["properties"].(map[string]interface{})["spec"].(map[string]interface{})["properties"].(map[string]interface{})["hub"].(map[string]interface{})
+	if got, want := fmt.Sprint(hub["type"]), "[string null]"; got != want {
+		t.Errorf("got hub type %s, want %s", got, want)
+	}
+	if _, ok := hub["nullable"]; ok {
+		t.Error("got nullable in JSON Schema, want a type list")
+	}
+	if hub["description"] == "" || hub["description"] == nil {
+		t.Error("got no description for hub, want one")
+	}
+}
+
+// validateSchema returns the mismatches of the unmarshaled YAML v at path with the schema s. It checks the types,
+// enums and fields that CRD validation checks for the schemas OpenAPIV3 generates.
+func validateSchema(s *apiextensionsv1beta1.JSONSchemaProps, v interface{}, path string) []string {
+	if v == nil {
+		if s.Nullable || path == "" {
+			return nil
+		}
+		return []string{path + ": got null, want " + s.Type}
+	}
+	if s.XIntOrString {
+		switch v.(type) {
+		case float64, string:
+			return nil
+		}
+		return []string{fmt.Sprintf("%s: got %s, want int-or-string", path, jsonType(v))}
+	}
+	if s.Type == "" {
+		return nil
+	}
+	got := jsonType(v)
+	if got != s.Type && !(s.Type == "integer" && got == "number" && v.(float64) == float64(int64(v.(float64)))) {
+		return []string{fmt.Sprintf("%s: got %s, want %s", path, got, s.Type)}
+	}
+	if len(s.Enum) != 0 {
+		b, _ := json.Marshal(v)
+		var enum []string
+		found := false
+		for _, e := range s.Enum {
+			enum = append(enum, string(e.Raw))
+			found = found || string(e.Raw) == string(b)
+		}
+		if !found {
+			return []string{fmt.Sprintf("%s: got %s, want one of [%s]", path, b, strings.Join(enum, ","))}
+		}
+	}
+	var out []string
+	switch n := v.(type) {
+	case map[string]interface{}:
+		for k, cv := range n {
+			cp := k
+			if path != "" {
+				cp = path + "." + k
+			}
+			switch {
+			case s.Properties != nil:
+				if ps, ok := s.Properties[k]; ok {
+					out = append(out, validateSchema(&ps, cv, cp)...)
+				}
+			case s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil:
+				out = append(out, validateSchema(s.AdditionalProperties.Schema, cv, cp)...)
+			}
+		}
+	case []interface{}:
+		for i, cv := range n {
+			out = append(out, validateSchema(s.Items.Schema, cv, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+	}
+	return out
+}
+
+func jsonType(v interface{}) string {
+	switch v.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	}
+	return fmt.Sprintf("%T", v)
+}
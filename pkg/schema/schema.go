@@ -0,0 +1,354 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package schema describes the fields of the IstioOperatorSpec API, including the Helm values, from their Go types
+// and the comments in their proto definitions.
+package schema
+
+//go:generate go run gen_descriptions/main.go -o descriptions.gen.go ../apis/istio/v1alpha1/values_types.proto istio.io/api//operator/v1alpha1/operator.proto istio.io/api//operator/v1alpha1/component.proto istio.io/api//operator/v1alpha1/kubernetes.proto istio.io/api//mesh/v1alpha1/config.proto istio.io/api//mesh/v1alpha1/proxy.proto istio.io/api//mesh/v1alpha1/network.proto
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/proto"
+
+	"istio.io/api/operator/v1alpha1"
+	valuesv1alpha1 "istio.io/operator/pkg/apis/istio/v1alpha1"
+	"istio.io/operator/pkg/util"
+)
+
+const (
+	// MapKeyPathElement is the path element standing for any key of a map field.
+	MapKeyPathElement = "<key>"
+
+	// valuesField is the Go name of the IstioOperatorSpec field holding the Helm values.
+	valuesField = "Values"
+)
+
+var (
+	// valuesType is the type of the Helm values, which IstioOperatorSpec holds as an untyped map.
+	valuesType = reflect.TypeOf(valuesv1alpha1.Values{})
+
+	// scalarTypeNames maps the Go names of wrapper, well-known and k8s types to the type names shown to users.
+	scalarTypeNames = map[string]string{
+		"BoolValue":        "bool",
+		"BoolValueForPB":   "bool",
+		"Int32Value":       "int32",
+		"UInt32Value":      "uint32",
+		"Int64Value":       "int64",
+		"UInt64Value":      "uint64",
+		"FloatValue":       "float",
+		"DoubleValue":      "double",
+		"StringValue":      "string",
+		"Duration":         "duration",
+		"Any":              "any",
+		"Struct":           "object",
+		"IntOrString":      "int-or-string",
+		"IntOrStringForPB": "int-or-string",
+		"Quantity":         "quantity",
+	}
+)
+
+// Field describes a field of the IstioOperatorSpec API.
+type Field struct {
+	// Path is the path of the field in IstioOperatorSpec YAML, e.g. components.pilot.k8s.
+	Path util.Path
+	// GoPath is the path of the Go struct fields, e.g. Components.Pilot.K8S, as used by translations and validations.
+	GoPath util.Path
+	// Type is the Go type of the field.
+	Type reflect.Type
+
+	// protoName is the full proto name of the field, e.g. istio.operator.v1alpha1.IstioOperatorSpec.hub.
+	protoName string
+	// enum is the full proto name of the enum type of enum fields.
+	enum string
+}
+
+// Root returns the root of the IstioOperatorSpec API.
+func Root() *Field {
+	t := reflect.TypeOf(v1alpha1.IstioOperatorSpec{})
+	return &Field{Type: t, protoName: messageName(t)}
+}
+
+// Find returns the field at path, given in IstioOperatorSpec YAML form. Map keys may have any value, list indexes
+// may be given or omitted.
+func Find(path util.Path) (*Field, error) {
+	f := Root()
+	for i := 0; i < len(path); i++ {
+		pe := path[i]
+		t := deref(f.Type)
+		switch {
+		case t.Kind() == reflect.Slice && isListElement(pe):
+			f = f.element(pe)
+			continue
+		case t.Kind() == reflect.Map && f.hasMessageElements():
+			f = f.element(pe)
+			continue
+		}
+		children := f.Fields()
+		next := child(children, pe)
+		if next == nil {
+			if len(children) == 0 {
+				return nil, fmt.Errorf("%s has type %s, which has no fields", f.PathString(), f.TypeName())
+			}
+			if s := Suggest(pe, names(children)); len(s) != 0 {
+				return nil, fmt.Errorf("unknown field %s in %s, did you mean %s?", pe, f.PathString(),
+					strings.Join(s, " or "))
+			}
+			return nil, fmt.Errorf("unknown field %s in %s, valid fields are: %s", pe, f.PathString(),
+				strings.Join(names(children), ", "))
+		}
+		f = next
+	}
+	return f, nil
+}
+
+// Name returns the name of the field in YAML.
+func (f *Field) Name() string {
+	if len(f.Path) == 0 {
+		return ""
+	}
+	return f.Path[len(f.Path)-1]
+}
+
+// PathString returns the path of the field, or a name for the root.
+func (f *Field) PathString() string {
+	if len(f.Path) == 0 {
+		return "IstioOperatorSpec"
+	}
+	return f.Path.String()
+}
+
+// TypeName returns the name of the type of the field shown to users, e.g. PilotConfig, []string or bool.
+func (f *Field) TypeName() string {
+	if f.enum != "" {
+		return "enum " + shortName(f.enum)
+	}
+	return typeName(f.Type)
+}
+
+// EnumValues returns the names of the values of an enum field, or nil if the field is not an enum.
+func (f *Field) EnumValues() []string {
+	if f.enum == "" {
+		return nil
+	}
+	var out []string
+	values := proto.EnumValueMap(f.enum)
+	if values == nil {
+		values = gogoproto.EnumValueMap(f.enum)
+	}
+	for k := range values {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Description returns the description of the field from the proto definitions, or of its message type if the field
+// has none.
+func (f *Field) Description() string {
+	if d := descriptions[f.protoName]; d != "" {
+		return d
+	}
+	return descriptions[messageName(deref(elem(f.Type)))]
+}
+
+// IsValues reports whether the field is a Helm values field.
+func (f *Field) IsValues() bool {
+	return len(f.GoPath) != 0 && f.GoPath[0] == valuesField
+}
+
+// Fields returns the fields of a message field, or of its elements for lists and maps of messages. It returns nil for
+// other fields.
+func (f *Field) Fields() []*Field {
+	t := deref(f.Type)
+	switch {
+	case t.Kind() == reflect.Slice:
+		return f.element("").Fields()
+	case t.Kind() == reflect.Map && f.hasMessageElements():
+		return f.element(MapKeyPathElement).Fields()
+	case !isMessage(t):
+		return nil
+	}
+	var out []*Field
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("protobuf")
+		if tag == "" || strings.HasPrefix(sf.Name, "XXX_") {
+			continue
+		}
+		name, jsonName, enum := parseProtobufTag(tag)
+		yamlName := name
+		if jsonName != "" {
+			yamlName = jsonName
+		}
+		c := &Field{
+			Path:      append(append(util.Path{}, f.Path...), yamlName),
+			GoPath:    append(append(util.Path{}, f.GoPath...), sf.Name),
+			Type:      sf.Type,
+			protoName: messageName(t) + "." + name,
+			enum:      enum,
+		}
+		if t == reflect.TypeOf(v1alpha1.IstioOperatorSpec{}) && sf.Name == valuesField {
+			c.Type = reflect.PtrTo(valuesType)
+		}
+		out = append(out, c)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name() < out[j].Name() })
+	return out
+}
+
+// element returns the field describing the elements of a list or map field, at path element pe. List elements have
+// the path of the list if pe is empty.
+func (f *Field) element(pe string) *Field {
+	out := &Field{
+		Path:      append(util.Path{}, f.Path...),
+		GoPath:    append(util.Path{}, f.GoPath...),
+		Type:      elem(deref(f.Type)),
+		protoName: f.protoName,
+		enum:      f.enum,
+	}
+	if pe != "" {
+		out.Path = append(out.Path, pe)
+		out.GoPath = append(out.GoPath, pe)
+	}
+	return out
+}
+
+// hasMessageElements reports whether f is a map or list of messages.
+func (f *Field) hasMessageElements() bool {
+	return isMessage(deref(elem(deref(f.Type))))
+}
+
+// protoFieldName returns the name of the field in the proto definition, which is accepted in YAML too.
+func (f *Field) protoFieldName() string {
+	return f.protoName[strings.LastIndex(f.protoName, ".")+1:]
+}
+
+// parseProtobufTag returns the proto name, JSON name and enum type name in the protobuf struct tag of a field.
+func parseProtobufTag(tag string) (name, jsonName, enum string) {
+	for _, kv := range strings.Split(tag, ",") {
+		switch {
+		case strings.HasPrefix(kv, "name="):
+			name = strings.TrimPrefix(kv, "name=")
+		case strings.HasPrefix(kv, "json="):
+			jsonName = strings.TrimPrefix(kv, "json=")
+		case strings.HasPrefix(kv, "enum="):
+			enum = strings.TrimPrefix(kv, "enum=")
+		}
+	}
+	return name, jsonName, enum
+}
+
+// isMessage reports whether t is a proto message struct that has fields shown to users.
+func isMessage(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	if _, ok := scalarTypeNames[t.Name()]; ok {
+		return false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Tag.Get("protobuf") != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// messageName returns the full proto name of the message type t, or the empty string if t is not a message.
+func messageName(t reflect.Type) string {
+	if t.Kind() != reflect.Struct {
+		return ""
+	}
+	m, ok := reflect.New(t).Interface().(proto.Message)
+	if !ok {
+		return ""
+	}
+	// Messages are registered with either the golang or the gogo proto registry.
+	if n := proto.MessageName(m); n != "" {
+		return n
+	}
+	return gogoproto.MessageName(m)
+}
+
+func typeName(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Ptr:
+		return typeName(t.Elem())
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return "bytes"
+		}
+		return "[]" + typeName(t.Elem())
+	case reflect.Map:
+		if t.Elem().Kind() == reflect.Interface {
+			return "object"
+		}
+		return "map[" + typeName(t.Key()) + "]" + typeName(t.Elem())
+	case reflect.Interface:
+		return "any"
+	case reflect.Struct:
+		if n, ok := scalarTypeNames[t.Name()]; ok {
+			return n
+		}
+		if n := messageName(t); n != "" {
+			return shortName(n)
+		}
+		return t.Name()
+	}
+	return t.Kind().String()
+}
+
+// shortName returns the proto name n without its package.
+func shortName(n string) string {
+	return n[strings.LastIndex(n, ".")+1:]
+}
+
+func deref(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+// elem returns the element type of list and map types, and t for other types.
+func elem(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Slice || t.Kind() == reflect.Map {
+		return t.Elem()
+	}
+	return t
+}
+
+// isListElement reports whether pe selects a list element, by index or with a [key:value] or [value] selector.
+func isListElement(pe string) bool {
+	if _, ok := util.RemoveBrackets(pe); ok {
+		return true
+	}
+	_, err := strconv.Atoi(pe)
+	return err == nil
+}
+
+func names(fields []*Field) []string {
+	var out []string
+	for _, f := range fields {
+		out = append(out, f.Name())
+	}
+	return out
+}
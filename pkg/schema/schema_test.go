@@ -0,0 +1,139 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"strings"
+	"testing"
+
+	"istio.io/operator/pkg/util"
+)
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		desc         string
+		path         string
+		wantType     string
+		wantGoPath   string
+		wantValues   bool
+		wantEnum     string
+		wantDescribe bool
+		wantErr      string
+	}{
+		{
+			desc:         "root field",
+			path:         "hub",
+			wantType:     "string",
+			wantGoPath:   "Hub",
+			wantDescribe: true,
+		},
+		{
+			desc:       "component k8s",
+			path:       "components.pilot.k8s",
+			wantType:   "KubernetesResourcesSpec",
+			wantGoPath: "Components.Pilot.K8S",
+		},
+		{
+			desc:       "wrapped bool",
+			path:       "components.pilot.enabled",
+			wantType:   "bool",
+			wantGoPath: "Components.Pilot.Enabled",
+		},
+		{
+			desc:       "gateway list element",
+			path:       "components.ingressGateways.[name:istio-ingressgateway].k8s.replicaCount",
+			wantType:   "uint32",
+			wantGoPath: "Components.IngressGateways.[name:istio-ingressgateway].K8S.ReplicaCount",
+		},
+		{
+			desc:       "values field",
+			path:       "values.global.proxy.includeIPRanges",
+			wantType:   "string",
+			wantGoPath: "Values.Global.Proxy.IncludeIPRanges",
+			wantValues: true,
+		},
+		{
+			desc:         "mesh config enum",
+			path:         "meshConfig.ingressControllerMode",
+			wantGoPath:   "MeshConfig.IngressControllerMode",
+			wantEnum:     "STRICT",
+			wantDescribe: true,
+		},
+		{
+			desc:    "unknown field",
+			path:    "values.global.proxy.resorces",
+			wantErr: "unknown field resorces in values.global.proxy",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			f, err := Find(util.PathFromString(tt.path))
+			if gotErr, wantErr := errToString(err), tt.wantErr; !strings.Contains(gotErr, wantErr) || (wantErr == "") != (gotErr == "") {
+				t.Fatalf("Find(%s): got error: %s, want error: %s", tt.path, gotErr, wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if tt.wantType != "" && f.TypeName() != tt.wantType {
+				t.Errorf("Find(%s): got type: %s, want: %s", tt.path, f.TypeName(), tt.wantType)
+			}
+			if got := f.GoPath.String(); got != tt.wantGoPath {
+				t.Errorf("Find(%s): got Go path: %s, want: %s", tt.path, got, tt.wantGoPath)
+			}
+			if f.IsValues() != tt.wantValues {
+				t.Errorf("Find(%s): got IsValues: %v, want: %v", tt.path, f.IsValues(), tt.wantValues)
+			}
+			if tt.wantEnum != "" && !contains(f.EnumValues(), tt.wantEnum) {
+				t.Errorf("Find(%s): got enum values: %v, want to contain: %s", tt.path, f.EnumValues(), tt.wantEnum)
+			}
+			if tt.wantDescribe && f.Description() == "" {
+				t.Errorf("Find(%s): got empty description", tt.path)
+			}
+		})
+	}
+}
+
+func TestFields(t *testing.T) {
+	names := map[string]bool{}
+	for _, f := range Root().Fields() {
+		names[f.Name()] = true
+	}
+	for _, want := range []string{"components", "hub", "meshConfig", "tag", "values"} {
+		if !names[want] {
+			t.Errorf("Root().Fields(): missing field %s, got %v", want, names)
+		}
+	}
+	if got := Root().PathString(); got != "IstioOperatorSpec" {
+		t.Errorf("Root().PathString(): got %s, want IstioOperatorSpec", got)
+	}
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// errToString returns the string representation of err and the empty string if
+// err is nil.
+func errToString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -57,6 +57,21 @@ func (nc *PathContext) String() string {
 	return ret
 }
 
+// notFoundError is the error of GetPathContext for paths with an element that does not exist.
+type notFoundError string
+
+// Error implements the error interface.
+func (e notFoundError) Error() string {
+	return string(e)
+}
+
+// IsNotFound reports whether err is the error of GetPathContext for a path with an element that does not exist, rather
+// than a malformed path.
+func IsNotFound(err error) bool {
+	_, ok := err.(notFoundError)
+	return ok
+}
+
 // GetPathContext returns the PathContext for the Node which has the given path from root.
 // It returns false and and no error if the given path is not found, or an error code in other error situations, like
 // a malformed path.
@@ -126,7 +141,7 @@ func getPathContext(nc *PathContext, fullPath, remainPath util.Path, createMissi
 				return getPathContext(nn, fullPath, remainPath[1:], createMissing)
 			}
 		}
-		return nil, false, fmt.Errorf("path %s: element %s not found", fullPath, pe)
+		return nil, false, notFoundError(fmt.Sprintf("path %s: element %s not found", fullPath, pe))
 	}
 
 	if util.IsMap(ncNode) {
@@ -140,7 +155,7 @@ func getPathContext(nc *PathContext, fullPath, remainPath util.Path, createMissi
 					m[pe] = make(map[interface{}]interface{})
 					nn = m[pe]
 				} else {
-					return nil, false, fmt.Errorf("path not found at element %s in path %s", pe, fullPath)
+					return nil, false, notFoundError(fmt.Sprintf("path not found at element %s in path %s", pe, fullPath))
 				}
 			}
 		}
@@ -152,7 +167,7 @@ func getPathContext(nc *PathContext, fullPath, remainPath util.Path, createMissi
 					m[pe] = make(map[string]interface{})
 					nn = m[pe]
 				} else {
-					return nil, false, fmt.Errorf("path not found at element %s in path %s", pe, fullPath)
+					return nil, false, notFoundError(fmt.Sprintf("path not found at element %s in path %s", pe, fullPath))
 				}
 			}
 		}
@@ -0,0 +1,164 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translate
+
+import (
+	"fmt"
+
+	"github.com/ghodss/yaml"
+
+	"istio.io/operator/pkg/util"
+	"istio.io/operator/pkg/version"
+	"istio.io/operator/pkg/vfs"
+)
+
+const (
+	// deprecationsFile is the path of the deprecation table in the vfs.
+	deprecationsFile = "translateConfig/deprecations.yaml"
+	// wildcardPathElement matches any single path element in the path of a DeprecatedField.
+	wildcardPathElement = "*"
+)
+
+// Deprecations is the table of deprecated and removed IstioOperatorSpec fields and Kubernetes APIs.
+type Deprecations struct {
+	// Fields are the deprecated and removed IstioOperatorSpec fields.
+	Fields []*DeprecatedField `json:"fields"`
+	// APIs are the deprecated Kubernetes API versions of kinds.
+	APIs []*DeprecatedAPI `json:"apis"`
+}
+
+// DeprecatedField is an IstioOperatorSpec field that is deprecated or removed.
+type DeprecatedField struct {
+	// Path is the path of the field relative to the IstioOperatorSpec, in which * matches any single element.
+	Path string `json:"path"`
+	// DeprecatedIn is the Istio minor version that deprecates the field.
+	DeprecatedIn string `json:"deprecatedIn"`
+	// RemovedIn is the Istio minor version that removes the field, if it is removed.
+	RemovedIn string `json:"removedIn,omitempty"`
+	// Replacement is the path of the field that replaces it, if any.
+	Replacement string `json:"replacement,omitempty"`
+}
+
+// DeprecatedAPI is a Kubernetes API version of a kind that is deprecated.
+type DeprecatedAPI struct {
+	// APIVersion is the deprecated API version, e.g. extensions/v1beta1.
+	APIVersion string `json:"apiVersion"`
+	// Kind is the kind the API version is deprecated for.
+	Kind string `json:"kind"`
+	// Replacement is the API version to use instead.
+	Replacement string `json:"replacement"`
+	// RemovedInKubernetes is the Kubernetes minor version that stops serving the API version.
+	RemovedInKubernetes string `json:"removedInKubernetes"`
+}
+
+// Removed reports whether f is removed in minorVersion.
+func (f *DeprecatedField) Removed(minorVersion version.MinorVersion) bool {
+	return f.RemovedIn != "" && !minorVersionBefore(minorVersion, f.RemovedIn)
+}
+
+// Deprecated reports whether f is deprecated, or removed, in minorVersion.
+func (f *DeprecatedField) Deprecated(minorVersion version.MinorVersion) bool {
+	return !minorVersionBefore(minorVersion, f.DeprecatedIn)
+}
+
+// Message describes the deprecation or removal of the field at path in minorVersion to users.
+func (f *DeprecatedField) Message(path util.Path, minorVersion version.MinorVersion) string {
+	msg := fmt.Sprintf("%s is deprecated since %s", path, f.DeprecatedIn)
+	if f.Removed(minorVersion) {
+		msg = fmt.Sprintf("%s was removed in %s", path, f.RemovedIn)
+	} else if f.RemovedIn != "" {
+		msg += fmt.Sprintf(" and will be removed in %s", f.RemovedIn)
+	}
+	if f.Replacement != "" {
+		msg += fmt.Sprintf(", use %s instead", f.Replacement)
+	}
+	return msg
+}
+
+// Message describes the deprecation of a in the object with the given name to users.
+func (a *DeprecatedAPI) Message(objectName string) string {
+	return fmt.Sprintf("%s %s uses %s, which is deprecated and removed in Kubernetes %s, use %s instead", a.Kind, objectName,
+		a.APIVersion, a.RemovedInKubernetes, a.Replacement)
+}
+
+// NewDeprecations reads the deprecation table from the vfs.
+func NewDeprecations() (*Deprecations, error) {
+	b, err := vfs.ReadFile(deprecationsFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not read deprecation table %s: %s", deprecationsFile, err)
+	}
+	d := &Deprecations{}
+	if err := yaml.Unmarshal(b, d); err != nil {
+		return nil, fmt.Errorf("could not unmarshal deprecation table %s: %s", deprecationsFile, err)
+	}
+	for _, f := range d.Fields {
+		versions := []string{f.DeprecatedIn}
+		if f.RemovedIn != "" {
+			versions = append(versions, f.RemovedIn)
+		}
+		for _, v := range versions {
+			if _, err := version.NewVersionFromString(v); err != nil {
+				return nil, fmt.Errorf("bad version %q of %s in deprecation table %s: %s", v, f.Path, deprecationsFile, err)
+			}
+		}
+	}
+	return d, nil
+}
+
+// FindField returns the entry of the field at path, or nil if it is neither deprecated nor removed in minorVersion.
+func (d *Deprecations) FindField(path util.Path, minorVersion version.MinorVersion) *DeprecatedField {
+	for _, f := range d.Fields {
+		if f.Deprecated(minorVersion) && pathMatches(util.PathFromString(f.Path), path) {
+			return f
+		}
+	}
+	return nil
+}
+
+// FindAPI returns the entry of kind in apiVersion, or nil if it is not deprecated.
+func (d *Deprecations) FindAPI(apiVersion, kind string) *DeprecatedAPI {
+	for _, a := range d.APIs {
+		if a.APIVersion == apiVersion && a.Kind == kind {
+			return a
+		}
+	}
+	return nil
+}
+
+// pathMatches reports whether path matches pattern, in which * matches any single element.
+func pathMatches(pattern, path util.Path) bool {
+	if len(pattern) != len(path) {
+		return false
+	}
+	for i := range pattern {
+		if pattern[i] != wildcardPathElement && pattern[i] != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// minorVersionBefore reports whether v is before the minor version in the string s.
+func minorVersionBefore(v version.MinorVersion, s string) bool {
+	sv, err := version.NewVersionFromString(s)
+	if err != nil {
+		// Versions are checked when the table is read.
+		return false
+	}
+	if v.Major != sv.Major {
+		return v.Major < sv.Major
+	}
+	return v.Minor < sv.Minor
+}
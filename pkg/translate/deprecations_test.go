@@ -0,0 +1,105 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translate
+
+import (
+	"testing"
+
+	"istio.io/operator/pkg/util"
+	"istio.io/operator/pkg/version"
+)
+
+func TestNewDeprecations(t *testing.T) {
+	d, err := NewDeprecations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d.Fields) == 0 || len(d.APIs) == 0 {
+		t.Fatalf("got %d fields and %d APIs, want both to be listed", len(d.Fields), len(d.APIs))
+	}
+	for _, a := range d.APIs {
+		if a.Replacement == "" || a.RemovedInKubernetes == "" {
+			t.Errorf("API %s %s has no replacement or removal version", a.APIVersion, a.Kind)
+		}
+	}
+	if got := d.FindAPI("extensions/v1beta1", "Ingress"); got == nil || got.Replacement != "networking.k8s.io/v1beta1" {
+		t.Errorf("got %v for extensions/v1beta1 Ingress, want it to be replaced by networking.k8s.io/v1beta1", got)
+	}
+	if got := d.FindAPI("networking.k8s.io/v1beta1", "Ingress"); got != nil {
+		t.Errorf("got %v for networking.k8s.io/v1beta1 Ingress, want none", got)
+	}
+}
+
+func TestFindField(t *testing.T) {
+	d := &Deprecations{Fields: []*DeprecatedField{
+		{Path: "values.pilot.replicaCount", DeprecatedIn: "1.4", Replacement: "components.pilot.k8s.replicaCount"},
+		{Path: "values.gateways.*.cpu", DeprecatedIn: "1.5", RemovedIn: "1.6"},
+	}}
+	tests := []struct {
+		desc        string
+		path        string
+		version     version.MinorVersion
+		wantMessage string
+		wantRemoved bool
+	}{
+		{
+			desc:        "deprecated",
+			path:        "values.pilot.replicaCount",
+			version:     version.NewMinorVersion(1, 5),
+			wantMessage: "values.pilot.replicaCount is deprecated since 1.4, use components.pilot.k8s.replicaCount instead",
+		},
+		{
+			desc:    "not deprecated yet",
+			path:    "values.gateways.istio-ingressgateway.cpu",
+			version: version.NewMinorVersion(1, 4),
+		},
+		{
+			desc:        "wildcard",
+			path:        "values.gateways.istio-ingressgateway.cpu",
+			version:     version.NewMinorVersion(1, 5),
+			wantMessage: "values.gateways.istio-ingressgateway.cpu is deprecated since 1.5 and will be removed in 1.6",
+		},
+		{
+			desc:        "removed",
+			path:        "values.gateways.istio-ingressgateway.cpu",
+			version:     version.NewMinorVersion(1, 6),
+			wantMessage: "values.gateways.istio-ingressgateway.cpu was removed in 1.6",
+			wantRemoved: true,
+		},
+		{
+			desc:    "parent",
+			path:    "values.pilot",
+			version: version.NewMinorVersion(1, 5),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			path := util.PathFromString(tt.path)
+			f := d.FindField(path, tt.version)
+			if f == nil {
+				if tt.wantMessage != "" {
+					t.Fatalf("got no entry, want %q", tt.wantMessage)
+				}
+				return
+			}
+			if got := f.Message(path, tt.version); got != tt.wantMessage {
+				t.Errorf("got message %q, want %q", got, tt.wantMessage)
+			}
+			if got := f.Removed(tt.version); got != tt.wantRemoved {
+				t.Errorf("got removed %v, want %v", got, tt.wantRemoved)
+			}
+		})
+	}
+}
@@ -116,11 +116,37 @@ func NewTranslator(minorVersion version.MinorVersion) (*Translator, error) {
 	return t, nil
 }
 
+// UnmatchedK8sSetting is the warning of OverlayK8sSettings about a K8S setting whose resource is not in the manifest.
+type UnmatchedK8sSetting struct {
+	// Path is the path of the setting in IstioOperatorSpec, in YAML form.
+	Path util.Path
+	// Resource is the Kind:name of the resource the setting is overlaid on.
+	Resource string
+}
+
+// Error implements the error interface.
+func (u *UnmatchedK8sSetting) Error() string {
+	return fmt.Sprintf("%s is set but resource Kind:name %s does not exist in the output manifest", u.Path, u.Resource)
+}
+
+// k8sSettingYAMLPath returns the YAML form of inPath, the path of a K8S setting in the IstioOperatorSpec Go structs.
+func k8sSettingYAMLPath(inPath string) util.Path {
+	p := util.ToYAMLPath(inPath)
+	for i := range p {
+		if p[i] == "k8S" {
+			p[i] = "k8s"
+		}
+	}
+	return p
+}
+
 // OverlayK8sSettings overlays k8s settings from iop over the manifest objects, based on t's translation mappings.
-func (t *Translator) OverlayK8sSettings(yml string, iop *v1alpha1.IstioOperatorSpec, componentName name.ComponentName, index int) (string, error) {
+// K8S settings whose resource is not in the manifest are skipped and returned as UnmatchedK8sSetting warnings.
+func (t *Translator) OverlayK8sSettings(yml string, iop *v1alpha1.IstioOperatorSpec, componentName name.ComponentName,
+	index int) (string, util.Errors, error) {
 	objects, err := object.ParseK8sObjectsFromYAMLManifest(yml)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 	log.Debugf("Manifest contains the following objects:")
 	for _, o := range objects {
@@ -128,16 +154,17 @@ func (t *Translator) OverlayK8sSettings(yml string, iop *v1alpha1.IstioOperatorS
 	}
 	// om is a map of kind:name string to Object ptr.
 	om := objects.ToNameKindMap()
+	var warnings util.Errors
 	for inPath, v := range t.KubernetesMapping {
 		inPath, err := renderFeatureComponentPathTemplate(inPath, componentName)
 		if err != nil {
-			return "", err
+			return "", nil, err
 		}
 		inPath = strings.Replace(inPath, "gressGateways.", "gressGateways."+fmt.Sprint(index)+".", 1)
 		log.Debugf("Checking for path %s in IstioOperatorSpec", inPath)
 		m, found, err := tpath.GetFromStructPath(iop, inPath)
 		if err != nil {
-			return "", err
+			return "", nil, err
 		}
 		if !found {
 			log.Debugf("path %s not found in IstioOperatorSpec, skip mapping.", inPath)
@@ -155,34 +182,37 @@ func (t *Translator) OverlayK8sSettings(yml string, iop *v1alpha1.IstioOperatorS
 		}
 		outPath, err := t.renderResourceComponentPathTemplate(v.OutPath, componentName)
 		if err != nil {
-			return "", err
+			return "", nil, err
 		}
 		log.Debugf("path has value in IstioOperatorSpec, mapping to output path %s", outPath)
 		path := util.PathFromString(outPath)
 		pe := path[0]
 		// Output path must start with [kind:name], which is used to map to the object to overlay.
 		if !util.IsKVPathElement(pe) {
-			return "", fmt.Errorf("path %s has an unexpected first element %s in OverlayK8sSettings", path, pe)
+			return "", nil, fmt.Errorf("path %s has an unexpected first element %s in OverlayK8sSettings", path, pe)
 		}
 		// After brackets are removed, the remaining "kind:name" is the same format as the keys in om.
 		pe, _ = util.RemoveBrackets(pe)
 		oo, ok := om[pe]
 		if !ok {
-			// skip to overlay the K8s settings if the corresponding resource doesn't exist.
-			log.Infof("resource Kind:name %s doesn't exist in the output manifest, skip overlay.", pe)
+			// skip to overlay the K8s settings if the corresponding resource doesn't exist, and warn about it.
+			warnings = util.AppendErr(warnings, &UnmatchedK8sSetting{Path: k8sSettingYAMLPath(inPath), Resource: pe})
 			continue
 		}
 
 		// strategic merge overlay m to the base object oo
 		mergedObj, err := mergeK8sObject(oo, m, path[1:])
 		if err != nil {
-			return "", err
+			return "", nil, err
 		}
 		// Update the original object in objects slice, since the output should be ordered.
 		*(om[pe]) = *mergedObj
 	}
 
-	return objects.YAMLManifest()
+	// The mappings are in a map, sort the warnings so that they are stable.
+	sort.Slice(warnings, func(i, j int) bool { return warnings[i].Error() < warnings[j].Error() })
+	out, err := objects.YAMLManifest()
+	return out, warnings, err
 }
 
 // ProtoToValues traverses the supplied IstioOperatorSpec and returns a values.yaml translation from it.
@@ -282,6 +312,56 @@ func (t *Translator) ComponentMap(cns string) *ComponentMaps {
 	return t.ComponentMaps[cn]
 }
 
+// Targets returns the Helm values path and the paths in the rendered k8s resources that the IstioOperatorSpec field at
+// goPath translates to. goPath is given in Go struct field names, e.g. Components.Pilot.K8S.ReplicaCount. The Helm
+// values path is empty and there are no k8s paths if the field is not translated.
+func (t *Translator) Targets(goPath util.Path) (string, []string, error) {
+	valuesPath := ""
+	if vp, m := getValuesPathMapping(t.APIMapping, goPath); m != nil && vp != "" {
+		var path util.Path
+		for _, p := range util.PathFromString(strings.TrimSuffix(vp, ".")) {
+			path = append(path, firstCharToLower(p))
+		}
+		valuesPath = path.String()
+	}
+	if len(goPath) == 3 && goPath[0] == "Components" {
+		cn := name.ComponentName(goPath[1])
+		if c := t.ComponentMaps[cn]; c != nil && cn != name.IngressComponentName && cn != name.EgressComponentName {
+			switch goPath[2] {
+			case "Enabled":
+				valuesPath = c.ToHelmValuesTreeRoot + "." + HelmValuesEnabledSubpath
+			case "Namespace":
+				valuesPath = c.ToHelmValuesTreeRoot + "." + HelmValuesNamespaceSubpath
+			}
+		}
+	}
+
+	var k8sPaths []string
+	for inTmpl, m := range t.KubernetesMapping {
+		for cn := range t.ComponentMaps {
+			in, err := renderFeatureComponentPathTemplate(inTmpl, cn)
+			if err != nil {
+				return "", nil, err
+			}
+			inPath := util.PathFromString(in)
+			if len(goPath) < len(inPath) || goPath[:len(inPath)].String() != inPath.String() {
+				continue
+			}
+			out, err := t.renderResourceComponentPathTemplate(m.OutPath, cn)
+			if err != nil {
+				return "", nil, err
+			}
+			outPath := util.PathFromString(strings.TrimSuffix(out, "."))
+			for _, p := range goPath[len(inPath):] {
+				outPath = append(outPath, firstCharToLower(p))
+			}
+			k8sPaths = append(k8sPaths, outPath.String())
+		}
+	}
+	sort.Strings(k8sPaths)
+	return valuesPath, k8sPaths, nil
+}
+
 // protoToHelmValues takes an interface which must be a struct ptr and recursively iterates through all its fields.
 // For each leaf, if looks for a mapping from the struct data path to the corresponding YAML path and if one is
 // found, it calls the associated mapping function if one is defined to populate the values YAML path.
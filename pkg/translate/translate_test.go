@@ -15,6 +15,7 @@
 package translate
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/kr/pretty"
@@ -251,3 +252,46 @@ func TestNewTranslator(t *testing.T) {
 		})
 	}
 }
+
+func TestTargets(t *testing.T) {
+	tests := []struct {
+		desc       string
+		goPath     string
+		wantValues string
+		wantK8s    []string
+	}{
+		{
+			desc:       "hub",
+			goPath:     "Hub",
+			wantValues: "global.hub",
+		},
+		{
+			desc:       "component enabled",
+			goPath:     "Components.Pilot.Enabled",
+			wantValues: "pilot.enabled",
+		},
+		{
+			desc:    "component k8s",
+			goPath:  "Components.Pilot.K8S.ReplicaCount",
+			wantK8s: []string{"[Deployment:istio-pilot].spec.replicas"},
+		},
+	}
+	tr, err := NewTranslator(version.NewMinorVersion(1, 5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			gotValues, gotK8s, err := tr.Targets(util.PathFromString(tt.goPath))
+			if err != nil {
+				t.Fatalf("Targets(%s): %s", tt.goPath, err)
+			}
+			if gotValues != tt.wantValues {
+				t.Errorf("Targets(%s): got values path %s, want %s", tt.goPath, gotValues, tt.wantValues)
+			}
+			if !reflect.DeepEqual(gotK8s, tt.wantK8s) {
+				t.Errorf("Targets(%s): got k8s paths %v, want %v", tt.goPath, gotK8s, tt.wantK8s)
+			}
+		})
+	}
+}
@@ -49,3 +49,9 @@ func IsHTTPURL(path string) bool {
 	u, err := url.Parse(path)
 	return err == nil && u.Host != "" && (u.Scheme == "http" || u.Scheme == "https")
 }
+
+// IsFileURL checks whether the given URL is an absolute file:// URL.
+func IsFileURL(path string) bool {
+	u, err := url.Parse(path)
+	return err == nil && u.Scheme == "file" && u.Path != ""
+}
@@ -0,0 +1,282 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+	yaml3 "gopkg.in/yaml.v3"
+)
+
+// Source describes where the value of a field came from.
+type Source struct {
+	// Layer is the kind of layer that set the value, e.g. a profile or the --set flag.
+	Layer string
+	// File identifies the layer, e.g. the profile name or path, or the --set argument. It may be empty.
+	File string
+	// Line is the 1-based line in File that set the value, or 0 if it is not known.
+	Line int
+}
+
+// String implements the Stringer interface.
+func (s Source) String() string {
+	out := s.Layer
+	if s.File != "" {
+		out += " " + s.File
+	}
+	if s.Line > 0 {
+		out += ":" + strconv.Itoa(s.Line)
+	}
+	return out
+}
+
+// Provenance records which layer set each leaf of a YAML tree built by overlaying layers with OverlayYAML. It maps
+// the string form of the path of each leaf to its source. Lists are leaves, because overlays replace them as a whole.
+type Provenance map[string]Source
+
+// OverlayYAML records that the subtree at root of the YAML tree overlayYAML from src was overlaid on the tree p
+// describes, following the JSON merge patch semantics of OverlayYAML. The line of the key of each leaf in overlayYAML
+// is added to src.
+func (p Provenance) OverlayYAML(overlayYAML string, root Path, src Source) error {
+	doc, err := ParseYAMLNode(overlayYAML)
+	if err != nil {
+		return fmt.Errorf("bad overlay YAML for %s: %s", src, err)
+	}
+	if node, _ := YAMLNodeAt(doc, root); node != nil && node.Kind == yaml3.MappingNode {
+		p.overlay(nil, node, src)
+	}
+	return nil
+}
+
+func (p Provenance) overlay(path Path, overlay *yaml3.Node, src Source) {
+	for i := 0; i+1 < len(overlay.Content); i += 2 {
+		key, v := overlay.Content[i], resolveAlias(overlay.Content[i+1])
+		kp := append(append(Path{}, path...), key.Value)
+		ksrc := src
+		ksrc.Line = key.Line
+		switch {
+		case v.Kind == yaml3.ScalarNode && v.Tag == "!!null":
+			p.Delete(kp)
+		case v.Kind == yaml3.MappingNode:
+			if len(v.Content) == 0 {
+				// An empty map only sets a leaf if there is nothing at its path yet.
+				if len(p.Subtree(kp)) == 0 {
+					p.Set(kp, ksrc)
+				}
+				continue
+			}
+			// A map replacing a leaf turns it into an inner node.
+			delete(p, kp.String())
+			p.overlay(kp, v, src)
+		default:
+			p.Set(kp, ksrc)
+		}
+	}
+}
+
+// ParseYAMLNode parses the YAML document y and returns its root node, which keeps the position of every key and
+// value. It returns nil for an empty document.
+func ParseYAMLNode(y string) (*yaml3.Node, error) {
+	doc := &yaml3.Node{}
+	if err := yaml3.Unmarshal([]byte(y), doc); err != nil {
+		return nil, err
+	}
+	if doc.Kind != yaml3.DocumentNode || len(doc.Content) == 0 {
+		return nil, nil
+	}
+	return doc.Content[0], nil
+}
+
+// YAMLNodeAt returns the node at path in the YAML tree node and the line of the key or list item of path in the YAML
+// source, following list indexes in path. If path is not in the tree, it returns nil and the line of the closest
+// ancestor of path that is, e.g. for a field that is set with an unusual syntax.
+func YAMLNodeAt(node *yaml3.Node, path Path) (*yaml3.Node, int) {
+	n, pos := yamlNodeAt(node, path)
+	if pos == nil {
+		return n, 0
+	}
+	return n, pos.Line
+}
+
+// YAMLPositionAt returns the 1-based line and column of the key or list item of path in the YAML tree node, or of its
+// closest ancestor that is in the tree. It returns 0, 0 if no element of path is in the tree.
+func YAMLPositionAt(node *yaml3.Node, path Path) (int, int) {
+	_, pos := yamlNodeAt(node, path)
+	if pos == nil {
+		return 0, 0
+	}
+	return pos.Line, pos.Column
+}
+
+// yamlNodeAt returns the node at path in the YAML tree node, or nil if it is not in the tree, and the node holding the
+// position of path or of its closest ancestor in the source, i.e. the mapping key or the list item.
+func yamlNodeAt(node *yaml3.Node, path Path) (*yaml3.Node, *yaml3.Node) {
+	var pos *yaml3.Node
+	for _, pe := range path {
+		node = resolveAlias(node)
+		if node == nil {
+			return nil, pos
+		}
+		var next *yaml3.Node
+		switch node.Kind {
+		case yaml3.MappingNode:
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				if node.Content[i].Value == pe {
+					pos, next = node.Content[i], node.Content[i+1]
+				}
+			}
+		case yaml3.SequenceNode:
+			if idx, err := strconv.Atoi(pe); err == nil && idx >= 0 && idx < len(node.Content) {
+				next = node.Content[idx]
+				pos = next
+			}
+		}
+		if next == nil {
+			return nil, pos
+		}
+		node = next
+	}
+	return resolveAlias(node), pos
+}
+
+// resolveAlias returns the node an alias node refers to, or node if it is not an alias.
+func resolveAlias(node *yaml3.Node) *yaml3.Node {
+	for node != nil && node.Kind == yaml3.AliasNode {
+		node = node.Alias
+	}
+	return node
+}
+
+// Set records that src set the leaf at path, which replaces anything below path.
+func (p Provenance) Set(path Path, src Source) {
+	p.Delete(path)
+	p[path.String()] = src
+}
+
+// Delete removes the leaf at path and everything below it.
+func (p Provenance) Delete(path Path) {
+	ps := path.String()
+	for k := range p {
+		if k == ps || strings.HasPrefix(k, ps+PathSeparator) {
+			delete(p, k)
+		}
+	}
+}
+
+// Subtree returns the provenance of the subtree at path, with paths relative to it.
+func (p Provenance) Subtree(path Path) Provenance {
+	if len(path) == 0 {
+		return p
+	}
+	ps := path.String()
+	out := make(Provenance)
+	for k, v := range p {
+		switch {
+		case k == ps:
+			out[""] = v
+		case strings.HasPrefix(k, ps+PathSeparator):
+			out[strings.TrimPrefix(k, ps+PathSeparator)] = v
+		}
+	}
+	return out
+}
+
+// AnnotateYAML returns the YAML tree y with a comment naming the source of each leaf, according to p. The order
+// of keys in y is kept. Leaves without provenance are not annotated.
+func AnnotateYAML(y string, p Provenance) (string, error) {
+	var tree yaml.MapSlice
+	if err := yaml.Unmarshal([]byte(y), &tree); err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	if err := writeAnnotated(&sb, tree, nil, 0, p); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+func writeAnnotated(sb *strings.Builder, tree yaml.MapSlice, path Path, indent int, p Provenance) error {
+	pad := strings.Repeat(" ", indent)
+	for _, item := range tree {
+		key := fmt.Sprint(item.Key)
+		kp := append(append(Path{}, path...), key)
+		comment := ""
+		if src, ok := p[kp.String()]; ok {
+			comment = "  # " + src.String()
+		}
+		if m, ok := item.Value.(yaml.MapSlice); ok && len(m) != 0 {
+			sb.WriteString(pad + key + ":" + comment + "\n")
+			if err := writeAnnotated(sb, m, kp, indent+2, p); err != nil {
+				return err
+			}
+			continue
+		}
+		b, err := yaml.Marshal(item.Value)
+		if err != nil {
+			return err
+		}
+		lines := strings.Split(strings.TrimSuffix(string(b), "\n"), "\n")
+		list, isList := item.Value.([]interface{})
+		switch {
+		case isList && len(list) != 0:
+			sb.WriteString(pad + key + ":" + comment + "\n")
+			for _, l := range lines {
+				sb.WriteString(pad + l + "\n")
+			}
+		case len(lines) == 1:
+			sb.WriteString(pad + key + ": " + lines[0] + comment + "\n")
+		case strings.HasPrefix(lines[0], "|") || strings.HasPrefix(lines[0], ">"):
+			// Block scalar, whose content lines are already indented relative to the key.
+			sb.WriteString(pad + key + ": " + lines[0] + comment + "\n")
+			for _, l := range lines[1:] {
+				sb.WriteString(pad + l + "\n")
+			}
+		default:
+			sb.WriteString(pad + key + ":" + comment + "\n")
+			for _, l := range lines {
+				sb.WriteString(pad + "  " + l + "\n")
+			}
+		}
+	}
+	return nil
+}
+
+// convertToStringKeys converts the map[interface{}]interface{} maps produced by gopkg.in/yaml.v2 in tree to
+// map[string]interface{}.
+func convertToStringKeys(tree interface{}) interface{} {
+	switch t := tree.(type) {
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for k, v := range t {
+			out[fmt.Sprint(k)] = convertToStringKeys(v)
+		}
+		return out
+	case map[string]interface{}:
+		for k, v := range t {
+			t[k] = convertToStringKeys(v)
+		}
+		return t
+	case []interface{}:
+		for i, v := range t {
+			t[i] = convertToStringKeys(v)
+		}
+		return t
+	default:
+		return tree
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestProvenance(t *testing.T) {
+	layers := []struct {
+		name string
+		yaml string
+	}{
+		{
+			name: "base",
+			yaml: `
+a:
+  b: 1
+  c: [1, 2]
+  d:
+    e: x
+empty: {}
+`,
+		},
+		{
+			name: "overlay",
+			yaml: `
+a:
+  c: [3]
+  d: null
+f: 2
+`,
+		},
+	}
+	prov := make(Provenance)
+	for _, l := range layers {
+		if err := prov.OverlayYAML(l.yaml, nil, Source{Layer: l.name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := Provenance{
+		"a.b":   {Layer: "base", Line: 3},
+		"a.c":   {Layer: "overlay", Line: 3},
+		"empty": {Layer: "base", Line: 7},
+		"f":     {Layer: "overlay", Line: 5},
+	}
+	if !reflect.DeepEqual(prov, want) {
+		t.Fatalf("got provenance %v, want %v", prov, want)
+	}
+	if got, want := prov.Subtree(Path{"a"}), (Provenance{"b": want["a.b"], "c": want["a.c"]}); !reflect.DeepEqual(got, want) {
+		t.Errorf("got subtree %v, want %v", got, want)
+	}
+
+	got, err := AnnotateYAML(`
+a:
+  b: 1
+  c:
+  - 3
+  s: |
+    line1
+    line2
+empty: {}
+f: 2
+`, prov)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantYAML := `a:
+  b: 1  # base:3
+  c:  # overlay:3
+  - 3
+  s: |
+    line1
+    line2
+empty: {}  # base:7
+f: 2  # overlay:5
+`
+	if got != wantYAML {
+		t.Errorf("got annotated YAML:\n%s\nwant:\n%s", got, wantYAML)
+	}
+}
+
+func TestProvenanceRoot(t *testing.T) {
+	cr := `apiVersion: v1
+kind: IstioOperator
+spec:
+  hub: docker.io
+  values:
+    global: {tag: x}
+`
+	prov := make(Provenance)
+	if err := prov.OverlayYAML(cr, Path{"spec"}, Source{Layer: "profile", File: "p.yaml"}); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"hub":               "profile p.yaml:4",
+		"values.global.tag": "profile p.yaml:6",
+	}
+	got := make(map[string]string)
+	for k, v := range prov {
+		got[k] = v.String()
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got provenance %v, want %v", got, want)
+	}
+}
+
+func TestYAMLNodeAt(t *testing.T) {
+	doc, err := ParseYAMLNode(`# comment
+a:
+  b: 1
+  list:
+  - c: 2
+    d: 3
+  "quoted": x
+  flow: {e: 4,
+    f: 5}
+  script: |
+    g: 6
+h: 7
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		path      string
+		wantLine  int
+		wantFound bool
+	}{
+		{path: "a", wantLine: 2, wantFound: true},
+		{path: "a.b", wantLine: 3, wantFound: true},
+		{path: "a.list.0", wantLine: 5, wantFound: true},
+		{path: "a.list.0.d", wantLine: 6, wantFound: true},
+		{path: "a.quoted", wantLine: 7, wantFound: true},
+		{path: "a.flow.f", wantLine: 9, wantFound: true},
+		{path: "a.script", wantLine: 10, wantFound: true},
+		{path: "a.script.g", wantLine: 10},
+		{path: "a.list.1", wantLine: 4},
+		{path: "a.missing", wantLine: 2},
+		{path: "h", wantLine: 12, wantFound: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			node, line := YAMLNodeAt(doc, PathFromString(tt.path))
+			if (node != nil) != tt.wantFound || line != tt.wantLine {
+				t.Errorf("got found=%v, line %d, want found=%v, line %d", node != nil, line, tt.wantFound, tt.wantLine)
+			}
+		})
+	}
+}
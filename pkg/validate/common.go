@@ -269,3 +269,30 @@ func anchored(res ...*regexp.Regexp) *regexp.Regexp {
 
 // ValidatorFunc validates a value.
 type ValidatorFunc func(path util.Path, i interface{}) util.Errors
+
+// PathError is a validation error of the value at Path, the path of the field in the IstioOperatorSpec in YAML form,
+// e.g. values.global.proxy.includeIPRanges. Its message is the message of Err.
+type PathError struct {
+	Path util.Path
+	Err  error
+}
+
+// Error implements the error interface.
+func (e *PathError) Error() string {
+	return e.Err.Error()
+}
+
+// withPath returns errs with each error wrapped in a PathError for path.
+func withPath(path util.Path, errs util.Errors) util.Errors {
+	var out util.Errors
+	for _, err := range errs {
+		out = append(out, &PathError{Path: append(util.Path{}, path...), Err: err})
+	}
+	return out
+}
+
+// validation is a ValidatorFunc with a description of the values it accepts, which is shown to users.
+type validation struct {
+	validate ValidatorFunc
+	rule     string
+}
@@ -0,0 +1,135 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/ghodss/yaml"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"istio.io/operator/pkg/object"
+	"istio.io/operator/pkg/translate"
+	"istio.io/operator/pkg/util"
+	"istio.io/operator/pkg/version"
+)
+
+// IDs of the checks against the deprecation table.
+const (
+	// RuleDeprecatedField reports IstioOperatorSpec fields that are deprecated in the target version.
+	RuleDeprecatedField = "deprecated-field"
+	// RuleRemovedField reports IstioOperatorSpec fields that are removed in the target version.
+	RuleRemovedField = "removed-field"
+	// RuleDeprecatedAPI reports rendered objects with a Kubernetes API version that is deprecated.
+	RuleDeprecatedAPI = "deprecated-api"
+	// RuleUnservedAPI reports rendered objects with a Kubernetes API version that the cluster does not serve.
+	RuleUnservedAPI = "unserved-api"
+)
+
+// ServedFunc reports whether the cluster serves kind in apiVersion.
+type ServedFunc func(apiVersion, kind string) (bool, error)
+
+// CheckDeprecatedFields returns a Diagnostic for each field set in the IstioOperatorSpec specYAML that the deprecation
+// table d lists as deprecated or removed in the Istio minor version target. Removed fields are errors.
+func CheckDeprecatedFields(specYAML string, d *translate.Deprecations, target version.MinorVersion) (Diagnostics, error) {
+	spec := make(map[string]interface{})
+	if err := yaml.Unmarshal([]byte(specYAML), &spec); err != nil {
+		return nil, err
+	}
+	var ds Diagnostics
+	walkDeprecatedFields(spec, nil, d, target, &ds)
+	sort.SliceStable(ds, func(i, j int) bool { return ds[i].Path < ds[j].Path })
+	return ds, nil
+}
+
+// walkDeprecatedFields appends a Diagnostic for each field in the tree node at path that is deprecated in target to
+// ds. The children of a deprecated field are not reported on their own.
+func walkDeprecatedFields(node interface{}, path util.Path, d *translate.Deprecations, target version.MinorVersion, ds *Diagnostics) {
+	if f := d.FindField(path, target); f != nil {
+		diag := &Diagnostic{RuleID: RuleDeprecatedField, Severity: SeverityWarning, Message: f.Message(path, target),
+			Path: path.String()}
+		if f.Removed(target) {
+			diag.RuleID, diag.Severity = RuleRemovedField, SeverityError
+		}
+		*ds = append(*ds, diag)
+		return
+	}
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for k, v := range n {
+			walkDeprecatedFields(v, append(append(util.Path{}, path...), k), d, target, ds)
+		}
+	case []interface{}:
+		for i, v := range n {
+			walkDeprecatedFields(v, append(append(util.Path{}, path...), strconv.Itoa(i)), d, target, ds)
+		}
+	}
+}
+
+// CheckAPIs returns a Diagnostic for each object in objects with a Kubernetes API version that the deprecation table d
+// lists as deprecated, and an error Diagnostic for each object that served reports the cluster does not serve. Kinds
+// defined by a CustomResourceDefinition in objects are not checked, since the cluster cannot serve them before the
+// manifest is applied. served may be nil if there is no cluster to check against.
+func CheckAPIs(objects object.K8sObjects, d *translate.Deprecations, served ServedFunc) Diagnostics {
+	crdKinds := make(map[string]bool)
+	for _, o := range objects {
+		if o.Kind == "CustomResourceDefinition" {
+			kind, _, _ := unstructured.NestedString(o.UnstructuredObject().Object, "spec", "names", "kind")
+			crdKinds[kind] = true
+		}
+	}
+	var ds Diagnostics
+	// servedAPIs caches the answers of served by API version and kind. Errors are only reported once.
+	servedAPIs := make(map[string]bool)
+	for _, o := range objects {
+		if crdKinds[o.Kind] {
+			continue
+		}
+		apiVersion := o.GroupVersionKind().GroupVersion().String()
+		name := o.Name
+		if o.Namespace != "" {
+			name = o.Namespace + "/" + o.Name
+		}
+		a := d.FindAPI(apiVersion, o.Kind)
+		if a != nil {
+			ds = append(ds, &Diagnostic{RuleID: RuleDeprecatedAPI, Severity: SeverityWarning, Message: a.Message(name)})
+		}
+		if served == nil {
+			continue
+		}
+		key := apiVersion + "/" + o.Kind
+		ok, found := servedAPIs[key]
+		if !found {
+			var err error
+			if ok, err = served(apiVersion, o.Kind); err != nil {
+				ds = append(ds, &Diagnostic{RuleID: RuleUnservedAPI, Severity: SeverityWarning,
+					Message: fmt.Sprintf("could not check whether the cluster serves %s %s: %s", apiVersion, o.Kind, err)})
+				ok = true
+			}
+			servedAPIs[key] = ok
+		}
+		if ok {
+			continue
+		}
+		msg := fmt.Sprintf("%s %s uses %s, which the cluster does not serve", o.Kind, name, apiVersion)
+		if a != nil {
+			msg += fmt.Sprintf(", use %s instead", a.Replacement)
+		}
+		ds = append(ds, &Diagnostic{RuleID: RuleUnservedAPI, Severity: SeverityError, Message: msg})
+	}
+	return ds
+}
@@ -0,0 +1,174 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"fmt"
+	"testing"
+
+	"istio.io/operator/pkg/object"
+	"istio.io/operator/pkg/translate"
+	"istio.io/operator/pkg/version"
+)
+
+var testDeprecations = &translate.Deprecations{
+	Fields: []*translate.DeprecatedField{
+		{Path: "values.pilot.replicaCount", DeprecatedIn: "1.4", Replacement: "components.pilot.k8s.replicaCount"},
+		{Path: "values.pilot.cpu", DeprecatedIn: "1.4", RemovedIn: "1.5"},
+		{Path: "components.ingressGateways.*.foo", DeprecatedIn: "1.4"},
+	},
+	APIs: []*translate.DeprecatedAPI{
+		{APIVersion: "extensions/v1beta1", Kind: "Ingress", Replacement: "networking.k8s.io/v1beta1", RemovedInKubernetes: "1.22"},
+	},
+}
+
+func TestCheckDeprecatedFields(t *testing.T) {
+	specYAML := `
+components:
+  ingressGateways:
+  - name: istio-ingressgateway
+    foo: bar
+values:
+  pilot:
+    enabled: true
+    replicaCount: 2
+    cpu:
+      targetAverageUtilization: 80
+`
+	ds, err := CheckDeprecatedFields(specYAML, testDeprecations, version.NewMinorVersion(1, 5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"warning: components.ingressGateways.0.foo is deprecated since 1.4 [deprecated-field]",
+		"error: values.pilot.cpu was removed in 1.5 [removed-field]",
+		"warning: values.pilot.replicaCount is deprecated since 1.4, use components.pilot.k8s.replicaCount instead [deprecated-field]",
+	}
+	if got := diagnosticStrings(ds); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("got:\n%v\nwant:\n%v", got, want)
+	}
+	if got := ds[0].Path; got != "components.ingressGateways.0.foo" {
+		t.Errorf("got path %s, want components.ingressGateways.0.foo", got)
+	}
+}
+
+func TestCheckAPIs(t *testing.T) {
+	manifest := `
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: gateways.networking.istio.io
+spec:
+  group: networking.istio.io
+  names:
+    kind: Gateway
+---
+apiVersion: networking.istio.io/v1alpha3
+kind: Gateway
+metadata:
+  name: ingressgateway
+  namespace: istio-system
+---
+apiVersion: extensions/v1beta1
+kind: Ingress
+metadata:
+  name: grafana
+  namespace: istio-system
+---
+apiVersion: extensions/v1beta1
+kind: Ingress
+metadata:
+  name: kiali
+  namespace: istio-system
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: istio-pilot
+  namespace: istio-system
+`
+	objs, err := object.ParseK8sObjectsFromYAMLManifest(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		desc   string
+		served map[string]bool
+		want   []string
+	}{
+		{
+			desc: "no cluster",
+			want: []string{
+				"warning: Ingress istio-system/grafana uses extensions/v1beta1, which is deprecated and removed in Kubernetes 1.22, " +
+					"use networking.k8s.io/v1beta1 instead [deprecated-api]",
+				"warning: Ingress istio-system/kiali uses extensions/v1beta1, which is deprecated and removed in Kubernetes 1.22, " +
+					"use networking.k8s.io/v1beta1 instead [deprecated-api]",
+			},
+		},
+		{
+			desc: "served",
+			served: map[string]bool{"apiextensions.k8s.io/v1beta1/CustomResourceDefinition": true, "extensions/v1beta1/Ingress": true,
+				"v1/Service": true},
+			want: []string{
+				"warning: Ingress istio-system/grafana uses extensions/v1beta1, which is deprecated and removed in Kubernetes 1.22, " +
+					"use networking.k8s.io/v1beta1 instead [deprecated-api]",
+				"warning: Ingress istio-system/kiali uses extensions/v1beta1, which is deprecated and removed in Kubernetes 1.22, " +
+					"use networking.k8s.io/v1beta1 instead [deprecated-api]",
+			},
+		},
+		{
+			desc:   "removed",
+			served: map[string]bool{"apiextensions.k8s.io/v1beta1/CustomResourceDefinition": true, "v1/Service": true},
+			want: []string{
+				"warning: Ingress istio-system/grafana uses extensions/v1beta1, which is deprecated and removed in Kubernetes 1.22, " +
+					"use networking.k8s.io/v1beta1 instead [deprecated-api]",
+				"error: Ingress istio-system/grafana uses extensions/v1beta1, which the cluster does not serve, " +
+					"use networking.k8s.io/v1beta1 instead [unserved-api]",
+				"warning: Ingress istio-system/kiali uses extensions/v1beta1, which is deprecated and removed in Kubernetes 1.22, " +
+					"use networking.k8s.io/v1beta1 instead [deprecated-api]",
+				"error: Ingress istio-system/kiali uses extensions/v1beta1, which the cluster does not serve, " +
+					"use networking.k8s.io/v1beta1 instead [unserved-api]",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var served ServedFunc
+			calls := 0
+			if tt.served != nil {
+				served = func(apiVersion, kind string) (bool, error) {
+					calls++
+					return tt.served[apiVersion+"/"+kind], nil
+				}
+			}
+			got := diagnosticStrings(CheckAPIs(objs, testDeprecations, served))
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("got:\n%v\nwant:\n%v", got, tt.want)
+			}
+			if served != nil && calls != 3 {
+				t.Errorf("got %d discovery calls, want one for each API version and kind not defined by a CRD", calls)
+			}
+		})
+	}
+}
+
+// diagnosticStrings returns the String form of each Diagnostic in ds.
+func diagnosticStrings(ds Diagnostics) []string {
+	var out []string
+	for _, d := range ds {
+		out = append(out, d.String())
+	}
+	return out
+}
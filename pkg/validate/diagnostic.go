@@ -0,0 +1,278 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	yaml3 "gopkg.in/yaml.v3"
+
+	"istio.io/operator/pkg/util"
+)
+
+// Severity is the severity of a Diagnostic.
+type Severity string
+
+const (
+	// SeverityError means the configuration cannot be installed.
+	SeverityError Severity = "error"
+	// SeverityWarning means the configuration can be installed but is likely wrong.
+	SeverityWarning Severity = "warning"
+	// SeverityInfo is a hint that does not need to be acted on.
+	SeverityInfo Severity = "info"
+)
+
+// IDs of the checks run on every IstioOperator CR.
+const (
+	// RuleYAMLSyntax reports CRs that are not valid YAML.
+	RuleYAMLSyntax = "yaml-syntax"
+	// RuleUnknownField reports fields that are not in the IstioOperatorSpec or values schema.
+	RuleUnknownField = "unknown-field"
+	// RuleInvalidSpec reports CRs that cannot be unmarshaled into an IstioOperatorSpec.
+	RuleInvalidSpec = "invalid-spec"
+	// RuleInvalidValue reports IstioOperatorSpec fields whose value is not accepted.
+	RuleInvalidValue = "invalid-value"
+	// RuleInvalidValues reports values fields whose value is not accepted.
+	RuleInvalidValues = "invalid-values"
+	// RuleValuesConfig reports values that are not valid for the values API types.
+	RuleValuesConfig = "values-config"
+	// RuleRender reports CRs whose manifests cannot be rendered.
+	RuleRender = "render"
+	// RuleUnmatchedOverlay reports overlays, overlay paths and K8S settings that match nothing in the rendered
+	// manifests.
+	RuleUnmatchedOverlay = "unmatched-overlay"
+)
+
+// ruleDescriptions describes each check to users, e.g. in SARIF output.
+var ruleDescriptions = map[string]string{
+	RuleYAMLSyntax:    "The IstioOperator CR must be valid YAML.",
+	RuleUnknownField:  "Fields must be in the IstioOperatorSpec schema. Unknown values fields are passed to the charts as is.",
+	RuleInvalidSpec:   "The spec of the IstioOperator CR must be an IstioOperatorSpec.",
+	RuleInvalidValue:  "IstioOperatorSpec fields must have valid values.",
+	RuleInvalidValues: "values fields must have valid values.",
+	RuleValuesConfig:  "values must be valid for the values API types and consistent with each other.",
+	RuleRender:        "The manifests of the merged configuration must render.",
+	RuleUnmatchedOverlay: "Overlays, overlay paths and K8S settings should match objects and paths in the rendered " +
+		"manifests, or they have no effect.",
+	RuleUnknownRule: "Rules disabled with the " + DisabledRulesAnnotationKey + " annotation must exist.",
+
+	RuleDeprecatedField: "Fields deprecated in the target version should be replaced.",
+	RuleRemovedField:    "Fields removed in the target version must be replaced.",
+	RuleDeprecatedAPI:   "Rendered objects should not use Kubernetes API versions that are deprecated.",
+	RuleUnservedAPI:     "Rendered objects must use Kubernetes API versions that the cluster serves.",
+}
+
+// RuleDescription returns the description of the check or semantic rule with the given ID, or an empty string if it is
+// not known.
+func RuleDescription(id string) string {
+	if r := semanticRule(id); r != nil {
+		return r.Description
+	}
+	return ruleDescriptions[id]
+}
+
+// Diagnostic is a problem found in an IstioOperator CR.
+type Diagnostic struct {
+	// RuleID is the ID of the check that found the problem.
+	RuleID string `json:"ruleId"`
+	// Severity is the severity of the problem.
+	Severity Severity `json:"severity"`
+	// Message describes the problem.
+	Message string `json:"message"`
+	// Path is the path of the IstioOperatorSpec field with the problem in YAML form, or empty if the problem is not
+	// specific to a field.
+	Path string `json:"path,omitempty"`
+	// File is the file the CR was read from, if any.
+	File string `json:"file,omitempty"`
+	// Line and Column are the 1-based position of Path in File, or 0 if they are not known.
+	Line   int `json:"line,omitempty"`
+	Column int `json:"column,omitempty"`
+}
+
+// String implements the Stringer interface, in the file:line:column: severity: message [rule] form used by compilers.
+func (d *Diagnostic) String() string {
+	pos := d.File
+	if d.Line > 0 {
+		pos += fmt.Sprintf(":%d:%d", d.Line, d.Column)
+	}
+	if pos != "" {
+		pos += ": "
+	}
+	return fmt.Sprintf("%s%s: %s [%s]", pos, d.Severity, d.Message, d.RuleID)
+}
+
+// Diagnostics is a list of Diagnostic.
+type Diagnostics []*Diagnostic
+
+// HasErrors reports whether ds has a Diagnostic with SeverityError.
+func (ds Diagnostics) HasErrors() bool {
+	for _, d := range ds {
+		if d.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Count returns the number of Diagnostic in ds with severity s.
+func (ds Diagnostics) Count(s Severity) int {
+	n := 0
+	for _, d := range ds {
+		if d.Severity == s {
+			n++
+		}
+	}
+	return n
+}
+
+// Sort sorts ds by file and position.
+func (ds Diagnostics) Sort() {
+	sort.SliceStable(ds, func(i, j int) bool {
+		a, b := ds[i], ds[j]
+		switch {
+		case a.File != b.File:
+			return a.File < b.File
+		case a.Line != b.Line:
+			return a.Line < b.Line
+		default:
+			return a.Column < b.Column
+		}
+	})
+}
+
+// Locate sets the file of each Diagnostic in ds to file and its position to the position of its path in doc, the
+// YAML tree of the CR, in which the IstioOperatorSpec is at root.
+func (ds Diagnostics) Locate(doc *yaml3.Node, root util.Path, file string) {
+	for _, d := range ds {
+		d.File = file
+		if d.Line > 0 {
+			continue
+		}
+		path := append(append(util.Path{}, root...), util.PathFromString(d.Path)...)
+		d.Line, d.Column = util.YAMLPositionAt(doc, path)
+	}
+}
+
+// NewDiagnostics returns a Diagnostic with the given rule ID and severity for each error in errs. The path of errors
+// that are a PathError is recorded.
+func NewDiagnostics(ruleID string, severity Severity, errs util.Errors) Diagnostics {
+	var out Diagnostics
+	for _, err := range errs {
+		d := &Diagnostic{RuleID: ruleID, Severity: severity, Message: err.Error()}
+		if pe, ok := err.(*PathError); ok {
+			d.Path = pe.Path.String()
+		}
+		out = append(out, d)
+	}
+	return out
+}
+
+// SARIF returns ds as a SARIF 2.1.0 log of a single run of the tool with the given name and version.
+func (ds Diagnostics) SARIF(toolName, toolVersion string) ([]byte, error) {
+	run := sarifRun{Results: []sarifResult{}}
+	run.Tool.Driver.Name = toolName
+	run.Tool.Driver.Version = toolVersion
+	seen := make(map[string]bool)
+	for _, d := range ds {
+		if !seen[d.RuleID] {
+			seen[d.RuleID] = true
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{
+				ID:               d.RuleID,
+				ShortDescription: sarifMessage{Text: RuleDescription(d.RuleID)},
+			})
+		}
+		r := sarifResult{
+			RuleID:  d.RuleID,
+			Level:   sarifLevel(d.Severity),
+			Message: sarifMessage{Text: d.Message},
+		}
+		if d.File != "" {
+			loc := sarifLocation{}
+			loc.PhysicalLocation.ArtifactLocation.URI = d.File
+			if d.Line > 0 {
+				loc.PhysicalLocation.Region = &sarifRegion{StartLine: d.Line, StartColumn: d.Column}
+			}
+			r.Locations = append(r.Locations, loc)
+		}
+		run.Results = append(run.Results, r)
+	}
+	return json.MarshalIndent(&sarifLog{
+		Version: "2.1.0",
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Runs:    []sarifRun{run},
+	}, "", "  ")
+}
+
+// sarifLevel returns the SARIF result level of severity s.
+func sarifLevel(s Severity) string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+// The types below are the subset of the SARIF 2.1.0 format used to report Diagnostics.
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool struct {
+		Driver struct {
+			Name    string      `json:"name"`
+			Version string      `json:"version,omitempty"`
+			Rules   []sarifRule `json:"rules,omitempty"`
+		} `json:"driver"`
+	} `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation struct {
+		ArtifactLocation struct {
+			URI string `json:"uri"`
+		} `json:"artifactLocation"`
+		Region *sarifRegion `json:"region,omitempty"`
+	} `json:"physicalLocation"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
@@ -0,0 +1,142 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCheckIstioOperatorCR(t *testing.T) {
+	tests := []struct {
+		desc    string
+		crYAML  string
+		want    []string
+		wantNil bool
+	}{
+		{
+			desc: "valid",
+			crYAML: `
+apiVersion: install.istio.io/v1alpha1
+kind: IstioOperator
+spec:
+  hub: docker.io/istio
+  values:
+    global:
+      proxy:
+        includeIPRanges: "1.1.0.0/16"
+`,
+		},
+		{
+			desc: "syntax error",
+			crYAML: `spec:
+  hub: [
+`,
+			want:    []string{"f.yaml:2:1: error: yaml: line 2: did not find expected node content [yaml-syntax]"},
+			wantNil: true,
+		},
+		{
+			desc: "missing spec",
+			crYAML: `
+apiVersion: install.istio.io/v1alpha1
+kind: IstioOperator
+`,
+			want:    []string{"f.yaml: error: spec is missing from IstioOperator YAML [invalid-spec]"},
+			wantNil: true,
+		},
+		{
+			desc: "all problems",
+			crYAML: `
+apiVersion: install.istio.io/v1alpha1
+kind: IstioOperator
+spec:
+  hub: "docker.io/ex ample"
+  components:
+    pilot:
+      enabld: true
+  values:
+    global:
+      proxy:
+        includeIPRanges: "1.1.1.1/33"
+        foo: bar
+`,
+			want: []string{
+				"f.yaml:5:3: error: invalid value Hub: docker.io/ex ample [invalid-value]",
+				"f.yaml:8:7: error: components.pilot.enabld: unknown field, did you mean enabled? [unknown-field]",
+				"f.yaml:12:9: error: global.proxy.includeIPRanges invalid CIDR address: 1.1.1.1/33 [invalid-values]",
+				"f.yaml:13:9: warning: values.global.proxy.foo: unknown field [unknown-field]",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			iops, ds := CheckIstioOperatorCR(tt.crYAML, "f.yaml")
+			if gotNil := iops == nil; gotNil != tt.wantNil {
+				t.Errorf("got nil spec %v, want %v", gotNil, tt.wantNil)
+			}
+			ds.Sort()
+			var got []string
+			for _, d := range ds {
+				got = append(got, d.String())
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got:\n%v\nwant:\n%v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("diagnostic %d: got %q, want %q", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSARIF(t *testing.T) {
+	ds := Diagnostics{
+		{RuleID: RuleInvalidValue, Severity: SeverityError, Message: "bad hub", File: "f.yaml", Line: 4, Column: 3},
+		{RuleID: RuleUnknownField, Severity: SeverityWarning, Message: "unknown", File: "f.yaml", Line: 9, Column: 9},
+		{RuleID: RuleInvalidValue, Severity: SeverityInfo, Message: "hint"},
+	}
+	b, err := ds.SARIF("mesh", "1.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := &sarifLog{}
+	if err := json.Unmarshal(b, got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Version != "2.1.0" || len(got.Runs) != 1 {
+		t.Fatalf("got version %s with %d runs, want 2.1.0 with 1 run", got.Version, len(got.Runs))
+	}
+	run := got.Runs[0]
+	if len(run.Tool.Driver.Rules) != 2 {
+		t.Errorf("got %d rules, want 2", len(run.Tool.Driver.Rules))
+	}
+	if len(run.Results) != 3 {
+		t.Fatalf("got %d results, want 3", len(run.Results))
+	}
+	wantLevels := []string{"error", "warning", "note"}
+	for i, r := range run.Results {
+		if r.Level != wantLevels[i] {
+			t.Errorf("result %d: got level %s, want %s", i, r.Level, wantLevels[i])
+		}
+	}
+	if r := run.Results[0]; len(r.Locations) != 1 || r.Locations[0].PhysicalLocation.Region.StartLine != 4 {
+		t.Errorf("got locations %v, want f.yaml line 4", r.Locations)
+	}
+	if r := run.Results[2]; len(r.Locations) != 0 {
+		t.Errorf("got locations %v, want none", r.Locations)
+	}
+}
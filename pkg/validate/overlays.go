@@ -0,0 +1,121 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/ghodss/yaml"
+
+	"istio.io/api/operator/v1alpha1"
+	"istio.io/operator/pkg/component/component"
+	"istio.io/operator/pkg/patch"
+	"istio.io/operator/pkg/translate"
+	"istio.io/operator/pkg/util"
+)
+
+// k8sSpecGetter is a component spec with K8S settings and overlays.
+type k8sSpecGetter interface {
+	GetK8S() *v1alpha1.KubernetesResourcesSpec
+}
+
+// UnmatchedOverlayDiagnostics returns a Diagnostic for each overlay, overlay path or K8S setting in unmatched that
+// matched nothing in the rendered manifests. They are warnings, or errors if strict is set. K8S settings that are not
+// set in userSpecYAML, the IstioOperatorSpec set by the user, come from the profile and are not reported, since users
+// cannot change them.
+func UnmatchedOverlayDiagnostics(unmatched util.Errors, userSpecYAML string, strict bool) (Diagnostics, error) {
+	spec := make(map[string]interface{})
+	if err := yaml.Unmarshal([]byte(userSpecYAML), &spec); err != nil {
+		return nil, err
+	}
+	severity := SeverityWarning
+	if strict {
+		severity = SeverityError
+	}
+	var ds Diagnostics
+	for _, u := range unmatched {
+		d := &Diagnostic{RuleID: RuleUnmatchedOverlay, Severity: severity, Message: u.Error()}
+		if s, ok := u.(*translate.UnmatchedK8sSetting); ok {
+			if !isSet(spec, s.Path) {
+				continue
+			}
+			d.Path = s.Path.String()
+		}
+		ds = append(ds, d)
+	}
+	return ds, nil
+}
+
+// isSet reports whether the field at path is set in the unmarshaled YAML tree node. List items are selected by their
+// index.
+func isSet(node interface{}, path util.Path) bool {
+	if len(path) == 0 {
+		return node != nil
+	}
+	switch n := node.(type) {
+	case map[string]interface{}:
+		return isSet(n[path[0]], path[1:])
+	case []interface{}:
+		i, err := strconv.Atoi(path[0])
+		return err == nil && i >= 0 && i < len(n) && isSet(n[i], path[1:])
+	}
+	return false
+}
+
+// checkOverlayPaths returns an error for each patch of the overlays of the components and of values.global.k8sOverlays
+// in iops whose path starts with $ but is not one of the reserved paths of package patch.
+func checkOverlayPaths(iops *v1alpha1.IstioOperatorSpec) util.Errors {
+	var errs util.Errors
+	if cs := iops.GetComponents(); cs != nil {
+		v := reflect.ValueOf(cs).Elem()
+		for i := 0; i < v.NumField(); i++ {
+			path := util.ToYAMLPath("Components." + v.Type().Field(i).Name)
+			switch f := v.Field(i).Interface().(type) {
+			case k8sSpecGetter:
+				errs = util.AppendErrs(errs, checkK8sOverlayPaths(append(path, "k8s", "overlays"), f.GetK8S().GetOverlays()))
+			case []*v1alpha1.GatewaySpec:
+				for j, g := range f {
+					gp := append(append(util.Path{}, path...), strconv.Itoa(j), "k8s", "overlays")
+					errs = util.AppendErrs(errs, checkK8sOverlayPaths(gp, g.GetK8S().GetOverlays()))
+				}
+			}
+		}
+	}
+	for name, c := range iops.GetAddonComponents() {
+		path := util.Path{"addonComponents", name, "k8s", "overlays"}
+		errs = util.AppendErrs(errs, checkK8sOverlayPaths(path, c.GetK8S().GetOverlays()))
+	}
+
+	globalOverlays, err := component.GlobalK8sOverlays(iops)
+	if err != nil {
+		return util.AppendErr(errs, err)
+	}
+	return util.AppendErrs(errs, checkK8sOverlayPaths(util.PathFromString("values.global.k8sOverlays"), globalOverlays))
+}
+
+// checkK8sOverlayPaths checks the patch paths of overlays, which are at path in the IstioOperatorSpec.
+func checkK8sOverlayPaths(path util.Path, overlays []*v1alpha1.K8SObjectOverlay) util.Errors {
+	var errs util.Errors
+	for i, o := range overlays {
+		for j, p := range o.Patches {
+			if err := patch.CheckReservedPath(p.Path); err != nil {
+				pp := append(append(util.Path{}, path...), strconv.Itoa(i), "patches", strconv.Itoa(j), "path")
+				errs = util.AppendErrs(errs, withPath(pp, util.NewErrs(err)))
+			}
+		}
+	}
+	return errs
+}
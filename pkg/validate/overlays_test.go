@@ -0,0 +1,151 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"istio.io/api/operator/v1alpha1"
+	"istio.io/operator/pkg/translate"
+	"istio.io/operator/pkg/util"
+)
+
+func TestUnmatchedOverlayDiagnostics(t *testing.T) {
+	userSpecYAML := `
+components:
+  ingressGateways:
+  - name: istio-ingressgateway
+    k8s:
+      replicaCount: 2
+  pilot:
+    k8s:
+      replicaCount: 2
+`
+	unmatched := util.Errors{
+		fmt.Errorf("component Pilot: overlay for Deployment:istio-system:istio-pilot-old does not match any object in output manifest"),
+		&translate.UnmatchedK8sSetting{Path: util.PathFromString("components.pilot.k8s.replicaCount"), Resource: "Deployment:istio-pilot"},
+		&translate.UnmatchedK8sSetting{Path: util.PathFromString("components.sidecarInjector.k8s.strategy"),
+			Resource: "Deployment:istio-sidecar-injector"},
+		&translate.UnmatchedK8sSetting{Path: util.PathFromString("components.ingressGateways.0.k8s.replicaCount"),
+			Resource: "Deployment:istio-ingressgateway"},
+	}
+	tests := []struct {
+		desc   string
+		strict bool
+		want   []string
+	}{
+		{
+			desc: "warnings",
+			want: []string{
+				"warning: component Pilot: overlay for Deployment:istio-system:istio-pilot-old does not match any object in output manifest [unmatched-overlay]",
+				"warning: components.pilot.k8s.replicaCount is set but resource Kind:name Deployment:istio-pilot does not exist in the output manifest [unmatched-overlay]",
+				"warning: components.ingressGateways.0.k8s.replicaCount is set but resource Kind:name Deployment:istio-ingressgateway does not exist " +
+					"in the output manifest [unmatched-overlay]",
+			},
+		},
+		{
+			desc:   "strict",
+			strict: true,
+			want: []string{
+				"error: component Pilot: overlay for Deployment:istio-system:istio-pilot-old does not match any object in output manifest [unmatched-overlay]",
+				"error: components.pilot.k8s.replicaCount is set but resource Kind:name Deployment:istio-pilot does not exist in the output manifest [unmatched-overlay]",
+				"error: components.ingressGateways.0.k8s.replicaCount is set but resource Kind:name Deployment:istio-ingressgateway does not exist " +
+					"in the output manifest [unmatched-overlay]",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			ds, err := UnmatchedOverlayDiagnostics(unmatched, userSpecYAML, tt.strict)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := diagnosticStrings(ds); fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("got:\n%v\nwant:\n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckOverlayPaths(t *testing.T) {
+	specYAML := `
+components:
+  pilot:
+    k8s:
+      overlays:
+      - kind: Deployment
+        name: istio-pilot
+        patches:
+        - path: $jsonPatch
+          value: []
+        - path: $strategicMerge
+          value: {}
+        - path: $selector
+          value: app=istiod
+        - path: $jsonpatch
+          value: []
+  ingressGateways:
+  - name: istio-ingressgateway
+    k8s:
+      overlays:
+      - kind: Service
+        patches:
+        - path: spec.ports.[name:http2]
+        - path: $merge
+addonComponents:
+  custom:
+    k8s:
+      overlays:
+      - kind: Deployment
+        patches:
+        - path: $delete
+values:
+  global:
+    k8sOverlays:
+    - kind: Deployment
+      patches:
+      - path: $strategicMerge
+        value: {}
+      - path: " $patch"
+`
+	iops := &v1alpha1.IstioOperatorSpec{}
+	if err := util.UnmarshalWithJSONPB(specYAML, iops); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"components.pilot.k8s.overlays.0.patches.3.path":             "$jsonpatch",
+		"components.ingressGateways.0.k8s.overlays.0.patches.1.path": "$merge",
+		"addonComponents.custom.k8s.overlays.0.patches.0.path":       "$delete",
+		"values.global.k8sOverlays.0.patches.1.path":                 "$patch",
+	}
+	got := make(map[string]string)
+	for _, err := range checkOverlayPaths(iops) {
+		pe, ok := err.(*PathError)
+		if !ok {
+			t.Fatalf("got error %v without a path", err)
+		}
+		got[pe.Path.String()] = err.Error()
+	}
+	if len(got) != len(want) {
+		t.Errorf("got errors %v, want errors for %v", got, want)
+	}
+	for path, reserved := range want {
+		if msg := got[path]; !strings.Contains(msg, "unknown reserved path "+reserved) {
+			t.Errorf("%s: got error %q, want an unknown reserved path %s error", path, msg, reserved)
+		}
+	}
+}
@@ -0,0 +1,501 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"istio.io/api/operator/v1alpha1"
+	valuesv1alpha1 "istio.io/operator/pkg/apis/istio/v1alpha1"
+	"istio.io/operator/pkg/name"
+	"istio.io/operator/pkg/util"
+)
+
+const (
+	// DisabledRulesAnnotationKey is the annotation of an IstioOperator CR that lists the IDs of the semantic rules
+	// that are not run for it, separated by commas.
+	DisabledRulesAnnotationKey = "install.istio.io/disabled-rules"
+)
+
+// IDs of the semantic rules.
+const (
+	// RuleUnknownRule reports rule IDs in DisabledRulesAnnotationKey that are not registered.
+	RuleUnknownRule = "unknown-rule"
+	// RuleAutoMTLS reports auto mTLS enabled without control plane security.
+	RuleAutoMTLS = "auto-mtls-control-plane-security"
+	// RuleGatewayNames reports gateways with the same name.
+	RuleGatewayNames = "gateway-names-unique"
+	// RuleGatewayPorts reports gateway ports that clash.
+	RuleGatewayPorts = "gateway-ports-unique"
+	// RuleResourceLimits reports resource requests greater than their limit.
+	RuleResourceLimits = "resource-requests-within-limits"
+	// RuleHPAMinMax reports HPAs with more min than max replicas.
+	RuleHPAMinMax = "hpa-min-max-replicas"
+	// RuleHPAMinPDB reports HPAs whose min replicas are below the PodDisruptionBudget minAvailable.
+	RuleHPAMinPDB = "hpa-min-replicas-pdb"
+	// RuleNamespaces reports component namespaces that neither exist nor are created by the installation.
+	RuleNamespaces = "component-namespace-exists"
+	// RuleTelemetryV2Pilot reports Telemetry v2 enabled with Pilot disabled.
+	RuleTelemetryV2Pilot = "telemetry-v2-requires-pilot"
+)
+
+// SemanticRule is a check of several fields of a merged IstioOperatorSpec together, e.g. that two features that
+// cannot be enabled together are not.
+type SemanticRule struct {
+	// ID identifies the rule in Diagnostics and in DisabledRulesAnnotationKey.
+	ID string
+	// Severity is the severity of the problems the rule finds.
+	Severity Severity
+	// Description describes what the rule checks and why to users.
+	Description string
+	// check returns the problems found in in. Problems that are specific to a field are a PathError.
+	check func(in *RuleInput) util.Errors
+}
+
+// RuleInput is the configuration checked by semantic rules.
+type RuleInput struct {
+	// IOPS is the IstioOperatorSpec merged with its profile.
+	IOPS *v1alpha1.IstioOperatorSpec
+	// Values is IOPS.Values as the values API types.
+	Values *valuesv1alpha1.Values
+	// NamespaceExists reports whether a namespace exists in the cluster. It is nil if there is no cluster to look in.
+	NamespaceExists func(name string) (bool, error)
+}
+
+// semanticRules is the registry of semantic rules, in the order they are run.
+var semanticRules = []*SemanticRule{
+	{
+		ID:          RuleAutoMTLS,
+		Severity:    SeverityError,
+		Description: "Automatic mutual TLS requires control plane security to be enabled.",
+		check:       checkAutoMTLS,
+	},
+	{
+		ID:          RuleGatewayNames,
+		Severity:    SeverityError,
+		Description: "Gateway names must be unique across ingress and egress gateways, since they name their resources.",
+		check:       checkGatewayNames,
+	},
+	{
+		ID:       RuleGatewayPorts,
+		Severity: SeverityError,
+		Description: "The ports of a gateway must be unique, and node ports must be unique across all gateways, " +
+			"since they are allocated cluster wide.",
+		check: checkGatewayPorts,
+	},
+	{
+		ID:          RuleResourceLimits,
+		Severity:    SeverityError,
+		Description: "The resource requests of a component must not be greater than its limits.",
+		check:       checkResourceLimits,
+	},
+	{
+		ID:          RuleHPAMinMax,
+		Severity:    SeverityError,
+		Description: "The min replicas of a component HPA must not be greater than its max replicas.",
+		check:       checkHPAMinMax,
+	},
+	{
+		ID:       RuleHPAMinPDB,
+		Severity: SeverityWarning,
+		Description: "The min replicas of a component HPA should not be less than the minAvailable of its " +
+			"PodDisruptionBudget, otherwise its pods cannot be evicted when scaled down, which blocks node drains.",
+		check: checkHPAMinPDB,
+	},
+	{
+		ID:       RuleNamespaces,
+		Severity: SeverityWarning,
+		Description: "The namespace of a component must exist or be created by the installation, i.e. be the root " +
+			"namespace or values.global.istioNamespace. It is only checked when installing into a cluster.",
+		check: checkNamespaces,
+	},
+	{
+		ID:          RuleTelemetryV2Pilot,
+		Severity:    SeverityError,
+		Description: "Telemetry v2 is configured by Pilot, which must be enabled.",
+		check:       checkTelemetryV2Pilot,
+	},
+}
+
+// SemanticRules returns the registered semantic rules, in the order they are run.
+func SemanticRules() []*SemanticRule {
+	return semanticRules
+}
+
+// semanticRule returns the registered semantic rule with the given ID, or nil if there is none.
+func semanticRule(id string) *SemanticRule {
+	for _, r := range semanticRules {
+		if r.ID == id {
+			return r
+		}
+	}
+	return nil
+}
+
+// DisabledRules returns the IDs of the semantic rules disabled by the DisabledRulesAnnotationKey annotation in
+// annotations.
+func DisabledRules(annotations map[string]string) map[string]bool {
+	out := make(map[string]bool)
+	for _, id := range strings.Split(annotations[DisabledRulesAnnotationKey], ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			out[id] = true
+		}
+	}
+	return out
+}
+
+// DisabledRulesFromCR returns the IDs of the semantic rules disabled by the annotations of the IstioOperator CR crYAML.
+func DisabledRulesFromCR(crYAML string) (map[string]bool, error) {
+	cr := struct {
+		Metadata struct {
+			Annotations map[string]string `json:"annotations"`
+		} `json:"metadata"`
+	}{}
+	if err := yaml.Unmarshal([]byte(crYAML), &cr); err != nil {
+		return nil, fmt.Errorf("could not read the metadata of the IstioOperator CR: %s", err)
+	}
+	return DisabledRules(cr.Metadata.Annotations), nil
+}
+
+// CheckRules runs the semantic rules that are not in disabled on the merged IstioOperatorSpec iops and returns the
+// problems found. namespaceExists looks up namespaces in the cluster, or is nil if there is no cluster.
+func CheckRules(iops *v1alpha1.IstioOperatorSpec, disabled map[string]bool,
+	namespaceExists func(name string) (bool, error)) Diagnostics {
+	var ds Diagnostics
+	var unknown []string
+	for id := range disabled {
+		if semanticRule(id) == nil {
+			unknown = append(unknown, id)
+		}
+	}
+	sort.Strings(unknown)
+	for _, id := range unknown {
+		ds = append(ds, &Diagnostic{RuleID: RuleUnknownRule, Severity: SeverityWarning,
+			Message: fmt.Sprintf("%s disables unknown rule %s", DisabledRulesAnnotationKey, id)})
+	}
+
+	values, err := typedValues(iops)
+	if err != nil {
+		return append(ds, NewDiagnostics(RuleInvalidValues, SeverityError, util.NewErrs(err))...)
+	}
+	in := &RuleInput{IOPS: iops, Values: values, NamespaceExists: namespaceExists}
+	for _, r := range semanticRules {
+		if disabled[r.ID] {
+			scope.Debugf("rule %s is disabled", r.ID)
+			continue
+		}
+		ds = append(ds, NewDiagnostics(r.ID, r.Severity, r.check(in))...)
+	}
+	return ds
+}
+
+// typedValues returns the values of iops as the values API types.
+func typedValues(iops *v1alpha1.IstioOperatorSpec) (*valuesv1alpha1.Values, error) {
+	y, err := yaml.Marshal(iops.Values)
+	if err != nil {
+		return nil, err
+	}
+	values := &valuesv1alpha1.Values{}
+	if err := util.UnmarshalValuesWithJSONPB(string(y), values, true); err != nil {
+		return nil, &PathError{Path: util.Path{"values"}, Err: err}
+	}
+	return values, nil
+}
+
+// componentSettings holds the settings of a component or gateway that semantic rules check.
+type componentSettings struct {
+	// path is the path of the component in the IstioOperatorSpec, in YAML form.
+	path util.Path
+	// enabled reports whether the component is enabled.
+	enabled bool
+	// namespace is the namespace set for the component, if any.
+	namespace string
+	// k8s is the Kubernetes resource spec of the component, if any.
+	k8s *v1alpha1.KubernetesResourcesSpec
+}
+
+// components returns the settings of the core components and gateways in iops.
+func components(iops *v1alpha1.IstioOperatorSpec) []*componentSettings {
+	if iops.Components == nil {
+		return nil
+	}
+	var out []*componentSettings
+	cv := reflect.ValueOf(iops.Components).Elem()
+	for _, cn := range name.AllCoreComponentNames {
+		v := cv.FieldByName(string(cn))
+		if !v.IsValid() || v.IsNil() {
+			continue
+		}
+		cs := &componentSettings{path: util.ToYAMLPath("components." + string(cn))}
+		if f := v.Elem().FieldByName("Enabled"); f.IsValid() {
+			cs.enabled = boolValue(f.Interface().(*v1alpha1.BoolValueForPB), false)
+		}
+		if f := v.Elem().FieldByName("Namespace"); f.IsValid() {
+			cs.namespace = f.String()
+		}
+		if f := v.Elem().FieldByName("K8S"); f.IsValid() {
+			cs.k8s = f.Interface().(*v1alpha1.KubernetesResourcesSpec)
+		}
+		out = append(out, cs)
+	}
+	for _, gws := range gateways(iops) {
+		for i, gw := range gws.specs {
+			out = append(out, &componentSettings{
+				path: util.Path{"components", gws.field, strconv.Itoa(i)},
+				// Gateways in the list are enabled unless disabled explicitly.
+				enabled:   boolValue(gw.Enabled, true),
+				namespace: gw.Namespace,
+				k8s:       gw.K8S,
+			})
+		}
+	}
+	return out
+}
+
+// gatewayList is a list of gateways of an IstioOperatorSpec.
+type gatewayList struct {
+	// field is the field of the list in the IstioOperatorSpec components, in YAML form.
+	field string
+	specs []*v1alpha1.GatewaySpec
+}
+
+// gateways returns the ingress and egress gateway lists of iops.
+func gateways(iops *v1alpha1.IstioOperatorSpec) []gatewayList {
+	return []gatewayList{
+		{"ingressGateways", iops.GetComponents().GetIngressGateways()},
+		{"egressGateways", iops.GetComponents().GetEgressGateways()},
+	}
+}
+
+// boolValue returns the value of b, or def if b is not set.
+func boolValue(b *v1alpha1.BoolValueForPB, def bool) bool {
+	if b == nil {
+		return def
+	}
+	return b.GetValue()
+}
+
+// pathErrorf returns a PathError for path with the given message.
+func pathErrorf(path util.Path, format string, args ...interface{}) error {
+	return &PathError{Path: path, Err: fmt.Errorf(format, args...)}
+}
+
+// join returns a copy of path with pes appended.
+func join(path util.Path, pes ...string) util.Path {
+	return append(append(util.Path{}, path...), pes...)
+}
+
+func checkAutoMTLS(in *RuleInput) util.Errors {
+	g := in.Values.GetGlobal()
+	if g.GetMtls().GetAuto().GetValue() && !g.GetControlPlaneSecurityEnabled().GetValue() {
+		return util.NewErrs(pathErrorf(util.Path{"values", "global", "mtls", "auto"},
+			"security: auto mtls is enabled, but control plane security is not enabled"))
+	}
+	return nil
+}
+
+func checkGatewayNames(in *RuleInput) (errs util.Errors) {
+	// seen maps each gateway name to the path of the first gateway with it.
+	seen := make(map[string]util.Path)
+	for _, gws := range gateways(in.IOPS) {
+		for i, gw := range gws.specs {
+			path := util.Path{"components", gws.field, strconv.Itoa(i)}
+			if gw.Name == "" {
+				continue
+			}
+			if first, ok := seen[gw.Name]; ok {
+				errs = util.AppendErr(errs, pathErrorf(join(path, "name"), "gateway name %s is also used by %s", gw.Name, first))
+				continue
+			}
+			seen[gw.Name] = path
+		}
+	}
+	return errs
+}
+
+func checkGatewayPorts(in *RuleInput) (errs util.Errors) {
+	// nodePorts maps each node port to the path of the first port that uses it.
+	nodePorts := make(map[int32]util.Path)
+	checkPorts := func(gwPath util.Path, ports []gatewayPort) {
+		seen := make(map[int32]util.Path)
+		for i, p := range ports {
+			path := join(gwPath, strconv.Itoa(i))
+			if p.port != 0 {
+				if first, ok := seen[p.port]; ok {
+					errs = util.AppendErr(errs, pathErrorf(path, "port %d is also used by %s", p.port, first))
+				} else {
+					seen[p.port] = path
+				}
+			}
+			if p.nodePort != 0 {
+				if first, ok := nodePorts[p.nodePort]; ok {
+					errs = util.AppendErr(errs, pathErrorf(path, "node port %d is also used by %s", p.nodePort, first))
+				} else {
+					nodePorts[p.nodePort] = path
+				}
+			}
+		}
+	}
+	for _, c := range components(in.IOPS) {
+		if c.k8s.GetService() == nil {
+			continue
+		}
+		var ports []gatewayPort
+		for _, p := range c.k8s.GetService().GetPorts() {
+			ports = append(ports, gatewayPort{port: p.GetPort(), nodePort: p.GetNodePort()})
+		}
+		checkPorts(join(c.path, "k8s", "service", "ports"), ports)
+	}
+	gws := in.Values.GetGateways()
+	for gw, vps := range map[string][]*valuesv1alpha1.PortsConfig{
+		"istio-ingressgateway": gws.GetIstioIngressgateway().GetPorts(),
+		"istio-egressgateway":  gws.GetIstioEgressgateway().GetPorts(),
+	} {
+		var ports []gatewayPort
+		for _, p := range vps {
+			ports = append(ports, gatewayPort{port: p.GetPort(), nodePort: p.GetNodePort()})
+		}
+		checkPorts(util.Path{"values", "gateways", gw, "ports"}, ports)
+	}
+	return errs
+}
+
+// gatewayPort is a port of a gateway service.
+type gatewayPort struct {
+	port     int32
+	nodePort int32
+}
+
+func checkResourceLimits(in *RuleInput) (errs util.Errors) {
+	for _, c := range components(in.IOPS) {
+		r := c.k8s.GetResources()
+		if r == nil {
+			continue
+		}
+		// Sort the resource names so that the errors are in a stable order.
+		var names []string
+		for rn := range r.Requests {
+			names = append(names, rn)
+		}
+		sort.Strings(names)
+		for _, rn := range names {
+			ls, ok := r.Limits[rn]
+			if !ok {
+				continue
+			}
+			path := join(c.path, "k8s", "resources", "requests", rn)
+			request, err := resource.ParseQuantity(r.Requests[rn])
+			if err != nil {
+				errs = util.AppendErr(errs, pathErrorf(path, "invalid quantity %s: %s", r.Requests[rn], err))
+				continue
+			}
+			limit, err := resource.ParseQuantity(ls)
+			if err != nil {
+				errs = util.AppendErr(errs, pathErrorf(join(c.path, "k8s", "resources", "limits", rn),
+					"invalid quantity %s: %s", ls, err))
+				continue
+			}
+			if request.Cmp(limit) > 0 {
+				errs = util.AppendErr(errs, pathErrorf(path, "%s request %s is greater than its limit %s", rn,
+					r.Requests[rn], ls))
+			}
+		}
+	}
+	return errs
+}
+
+func checkHPAMinMax(in *RuleInput) (errs util.Errors) {
+	for _, c := range components(in.IOPS) {
+		hpa := c.k8s.GetHpaSpec()
+		// maxReplicas is required, a spec without it is completed by the chart.
+		if hpa == nil || hpa.MinReplicas == nil || hpa.MaxReplicas == 0 {
+			continue
+		}
+		if *hpa.MinReplicas > hpa.MaxReplicas {
+			errs = util.AppendErr(errs, pathErrorf(join(c.path, "k8s", "hpaSpec", "minReplicas"),
+				"HPA min replicas %d is greater than max replicas %d", *hpa.MinReplicas, hpa.MaxReplicas))
+		}
+	}
+	return errs
+}
+
+func checkHPAMinPDB(in *RuleInput) (errs util.Errors) {
+	for _, c := range components(in.IOPS) {
+		hpa, pdb := c.k8s.GetHpaSpec(), c.k8s.GetPodDisruptionBudget()
+		if hpa == nil || pdb == nil {
+			continue
+		}
+		// minReplicas defaults to 1.
+		min := int32(1)
+		if hpa.MinReplicas != nil {
+			min = *hpa.MinReplicas
+		}
+		if uint32(min) < pdb.GetMinAvailable() {
+			errs = util.AppendErr(errs, pathErrorf(join(c.path, "k8s", "hpaSpec", "minReplicas"),
+				"HPA min replicas %d is less than the pod disruption budget minAvailable %d", min, pdb.GetMinAvailable()))
+		}
+	}
+	return errs
+}
+
+func checkNamespaces(in *RuleInput) (errs util.Errors) {
+	if in.NamespaceExists == nil {
+		// Without a cluster there is no telling whether a namespace exists.
+		return nil
+	}
+	created := map[string]bool{
+		in.IOPS.GetMeshConfig().GetRootNamespace(): true,
+		in.Values.GetGlobal().GetIstioNamespace():  true,
+	}
+	exists := make(map[string]bool)
+	for _, c := range components(in.IOPS) {
+		ns := c.namespace
+		if !c.enabled || ns == "" || created[ns] {
+			continue
+		}
+		path := join(c.path, "namespace")
+		ok, looked := exists[ns]
+		if !looked {
+			var err error
+			if ok, err = in.NamespaceExists(ns); err != nil {
+				errs = util.AppendErr(errs, pathErrorf(path, "could not look up namespace %s: %s", ns, err))
+				continue
+			}
+			exists[ns] = ok
+		}
+		if !ok {
+			errs = util.AppendErr(errs, pathErrorf(path, "namespace %s does not exist and is not created by the installation", ns))
+		}
+	}
+	return errs
+}
+
+func checkTelemetryV2Pilot(in *RuleInput) util.Errors {
+	if !in.Values.GetTelemetry().GetV2().GetEnabled().GetValue() {
+		return nil
+	}
+	if pilot := in.IOPS.GetComponents().GetPilot(); pilot != nil && !boolValue(pilot.Enabled, true) {
+		return util.NewErrs(pathErrorf(util.Path{"values", "telemetry", "v2", "enabled"},
+			"telemetry v2 is enabled, but pilot, which configures it, is disabled"))
+	}
+	return nil
+}
@@ -0,0 +1,348 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"fmt"
+	"testing"
+
+	"istio.io/api/operator/v1alpha1"
+	"istio.io/operator/pkg/helm"
+	"istio.io/operator/pkg/manifest"
+	"istio.io/operator/pkg/util"
+)
+
+func TestCheckRules(t *testing.T) {
+	tests := []struct {
+		desc     string
+		iopsYAML string
+		disabled map[string]bool
+		// namespaces are the namespaces in the cluster, or nil if there is no cluster.
+		namespaces map[string]bool
+		want       []string
+	}{
+		{
+			desc: "no problems",
+			iopsYAML: `
+components:
+  pilot:
+    enabled: true
+    k8s:
+      resources:
+        requests:
+          cpu: 500m
+        limits:
+          cpu: "1"
+`,
+		},
+		{
+			desc: "auto mTLS without control plane security",
+			iopsYAML: `
+values:
+  global:
+    controlPlaneSecurityEnabled: false
+    mtls:
+      auto: true
+`,
+			want: []string{
+				"error: security: auto mtls is enabled, but control plane security is not enabled [auto-mtls-control-plane-security]",
+			},
+		},
+		{
+			desc: "disabled rule",
+			iopsYAML: `
+values:
+  global:
+    controlPlaneSecurityEnabled: false
+    mtls:
+      auto: true
+`,
+			disabled: map[string]bool{RuleAutoMTLS: true, "no-such-rule": true},
+			want: []string{
+				"warning: install.istio.io/disabled-rules disables unknown rule no-such-rule [unknown-rule]",
+			},
+		},
+		{
+			desc: "gateway names",
+			iopsYAML: `
+components:
+  ingressGateways:
+  - name: istio-ingressgateway
+  - name: my-gateway
+  egressGateways:
+  - name: istio-egressgateway
+  - name: my-gateway
+`,
+			want: []string{
+				"error: gateway name my-gateway is also used by components.ingressGateways.1 [gateway-names-unique]",
+			},
+		},
+		{
+			desc: "gateway ports",
+			iopsYAML: `
+components:
+  ingressGateways:
+  - name: istio-ingressgateway
+    k8s:
+      service:
+        ports:
+        - port: 80
+          nodePort: 31380
+        - port: 80
+  egressGateways:
+  - name: istio-egressgateway
+    k8s:
+      service:
+        ports:
+        - port: 80
+          nodePort: 31380
+`,
+			want: []string{
+				"error: port 80 is also used by components.ingressGateways.0.k8s.service.ports.0 [gateway-ports-unique]",
+				"error: node port 31380 is also used by components.ingressGateways.0.k8s.service.ports.0 [gateway-ports-unique]",
+			},
+		},
+		{
+			desc: "values gateway ports",
+			iopsYAML: `
+values:
+  gateways:
+    istio-ingressgateway:
+      ports:
+      - port: 443
+      - port: 443
+`,
+			want: []string{
+				"error: port 443 is also used by values.gateways.istio-ingressgateway.ports.0 [gateway-ports-unique]",
+			},
+		},
+		{
+			desc: "resource requests",
+			iopsYAML: `
+components:
+  pilot:
+    k8s:
+      resources:
+        requests:
+          cpu: "2"
+          memory: 1Gi
+        limits:
+          cpu: 1500m
+          memory: 2Gi
+  ingressGateways:
+  - name: istio-ingressgateway
+    k8s:
+      resources:
+        requests:
+          memory: lots
+        limits:
+          memory: 1Gi
+`,
+			want: []string{
+				"error: cpu request 2 is greater than its limit 1500m [resource-requests-within-limits]",
+				"error: invalid quantity lots: quantities must match the regular expression " +
+					"'^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$' [resource-requests-within-limits]",
+			},
+		},
+		{
+			desc: "HPA replicas",
+			iopsYAML: `
+components:
+  pilot:
+    k8s:
+      hpaSpec:
+        minReplicas: 3
+        maxReplicas: 2
+  policy:
+    k8s:
+      hpaSpec:
+        minReplicas: 1
+        maxReplicas: 5
+      podDisruptionBudget:
+        minAvailable: 2
+`,
+			want: []string{
+				"error: HPA min replicas 3 is greater than max replicas 2 [hpa-min-max-replicas]",
+				"warning: HPA min replicas 1 is less than the pod disruption budget minAvailable 2 [hpa-min-replicas-pdb]",
+			},
+		},
+		{
+			desc: "namespaces without a cluster",
+			iopsYAML: `
+meshConfig:
+  rootNamespace: istio-system
+components:
+  pilot:
+    enabled: true
+    namespace: istio-control
+  policy:
+    enabled: false
+    namespace: istio-policy
+  telemetry:
+    enabled: true
+    namespace: istio-system
+`,
+		},
+		{
+			desc: "namespaces in a cluster",
+			iopsYAML: `
+meshConfig:
+  rootNamespace: istio-system
+values:
+  global:
+    istioNamespace: istio-control
+components:
+  pilot:
+    enabled: true
+    namespace: istio-control
+  telemetry:
+    enabled: true
+    namespace: istio-telemetry
+  ingressGateways:
+  - name: istio-ingressgateway
+    namespace: istio-gateways
+  - name: other-gateway
+    namespace: istio-gateways
+`,
+			namespaces: map[string]bool{"istio-telemetry": true},
+			want: []string{
+				"warning: namespace istio-gateways does not exist and is not created by the installation [component-namespace-exists]",
+				"warning: namespace istio-gateways does not exist and is not created by the installation [component-namespace-exists]",
+			},
+		},
+		{
+			desc: "telemetry v2 without pilot",
+			iopsYAML: `
+components:
+  pilot:
+    enabled: false
+values:
+  telemetry:
+    v2:
+      enabled: true
+`,
+			want: []string{
+				"error: telemetry v2 is enabled, but pilot, which configures it, is disabled [telemetry-v2-requires-pilot]",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			iops := &v1alpha1.IstioOperatorSpec{}
+			if err := util.UnmarshalWithJSONPB(tt.iopsYAML, iops); err != nil {
+				t.Fatal(err)
+			}
+			var namespaceExists func(string) (bool, error)
+			if tt.namespaces != nil {
+				namespaceExists = func(name string) (bool, error) {
+					return tt.namespaces[name], nil
+				}
+			}
+			ds := CheckRules(iops, tt.disabled, namespaceExists)
+			var got []string
+			for _, d := range ds {
+				got = append(got, d.String())
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got:\n%v\nwant:\n%v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("diagnostic %d: got %q, want %q", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCheckRulesPaths(t *testing.T) {
+	iops := &v1alpha1.IstioOperatorSpec{}
+	if err := util.UnmarshalWithJSONPB(`
+components:
+  egressGateways:
+  - name: gw
+    k8s:
+      resources:
+        requests:
+          cpu: "2"
+        limits:
+          cpu: "1"
+`, iops); err != nil {
+		t.Fatal(err)
+	}
+	ds := CheckRules(iops, nil, nil)
+	if len(ds) != 1 {
+		t.Fatalf("got %v, want 1 diagnostic", ds)
+	}
+	if got, want := ds[0].Path, "components.egressGateways.0.k8s.resources.requests.cpu"; got != want {
+		t.Errorf("got path %s, want %s", got, want)
+	}
+}
+
+func TestBuiltinProfilesPassRules(t *testing.T) {
+	for _, profile := range []string{"default", "demo", "minimal", "sds", "remote", "empty"} {
+		t.Run(profile, func(t *testing.T) {
+			y, err := helm.ReadMergedProfileYAML(profile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			iops, _, err := manifest.ParseK8SYAMLToIstioOperatorSpec(y)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, d := range CheckRules(iops, nil, nil) {
+				if d.Severity == SeverityError {
+					t.Errorf("profile %s breaks a rule: %s", profile, d)
+				}
+			}
+		})
+	}
+}
+
+func TestSemanticRulesHaveDocs(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, r := range SemanticRules() {
+		if r.ID == "" || r.Description == "" || r.check == nil {
+			t.Errorf("rule %q must have an ID, a description and a check", r.ID)
+		}
+		if r.Severity != SeverityError && r.Severity != SeverityWarning && r.Severity != SeverityInfo {
+			t.Errorf("rule %s has bad severity %q", r.ID, r.Severity)
+		}
+		if seen[r.ID] {
+			t.Errorf("rule %s is registered twice", r.ID)
+		}
+		seen[r.ID] = true
+		if got := RuleDescription(r.ID); got != r.Description {
+			t.Errorf("RuleDescription(%s): got %q, want %q", r.ID, got, r.Description)
+		}
+	}
+}
+
+func TestDisabledRulesFromCR(t *testing.T) {
+	got, err := DisabledRulesFromCR(`
+apiVersion: install.istio.io/v1alpha1
+kind: IstioOperator
+metadata:
+  annotations:
+    install.istio.io/disabled-rules: " hpa-min-replicas-pdb, component-namespace-exists,"
+spec: {}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]bool{RuleHPAMinPDB: true, RuleNamespaces: true}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
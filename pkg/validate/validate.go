@@ -24,24 +24,46 @@ import (
 )
 
 var (
-	// defaultValidations maps a data path to a validation function.
-	defaultValidations = map[string]ValidatorFunc{
-		"Hub":                validateHub,
-		"Tag":                validateTag,
-		"InstallPackagePath": validateInstallPackagePath,
+	// defaultValidations maps a data path to a validation function and its description.
+	defaultValidations = map[string]validation{
+		"Hub":                {validateHub, "must be a container image registry and repository, e.g. docker.io/istio"},
+		"Tag":                {validateTag, "must be a container image tag matching " + TagRegexp.String()},
+		"InstallPackagePath": {validateInstallPackagePath, "must be empty for the compiled-in charts, or an absolute path or URL"},
 	}
 	// requiredValues lists all the values that must be non-empty.
 	requiredValues = map[string]bool{}
 )
 
+// Rules returns descriptions of the validations of the IstioOperatorSpec field at path, which is in YAML form, e.g.
+// values.global.proxy.includeIPRanges.
+func Rules(path util.Path) []string {
+	if len(path) != 0 && path[0] == "values" {
+		return valuesRules(path[1:])
+	}
+	var out []string
+	ps := path.String()
+	for k := range requiredValues {
+		if util.ToYAMLPathString(k) == ps {
+			out = append(out, "required")
+		}
+	}
+	for k, v := range defaultValidations {
+		if util.ToYAMLPathString(k) == ps {
+			out = append(out, v.rule)
+		}
+	}
+	return out
+}
+
 // CheckIstioOperatorSpec validates the values in the given Installer spec, using the field map defaultValidations to
-// call the appropriate validation function.
+// call the appropriate validation function, and the paths of its overlay patches.
 func CheckIstioOperatorSpec(is *v1alpha1.IstioOperatorSpec, checkRequired bool) (errs util.Errors) {
 	errs = CheckValues(is.Values)
+	errs = util.AppendErrs(errs, checkOverlayPaths(is))
 	return util.AppendErrs(errs, validate(defaultValidations, is, nil, checkRequired))
 }
 
-func validate(validations map[string]ValidatorFunc, structPtr interface{}, path util.Path, checkRequired bool) (errs util.Errors) {
+func validate(validations map[string]validation, structPtr interface{}, path util.Path, checkRequired bool) (errs util.Errors) {
 	scope.Debugf("validate with path %s, %v (%T)", path, structPtr, structPtr)
 	if structPtr == nil {
 		return nil
@@ -103,7 +125,7 @@ func validate(validations map[string]ValidatorFunc, structPtr interface{}, path
 	return errs
 }
 
-func validateLeaf(validations map[string]ValidatorFunc, path util.Path, val interface{}, checkRequired bool) util.Errors {
+func validateLeaf(validations map[string]validation, path util.Path, val interface{}, checkRequired bool) util.Errors {
 	pstr := path.String()
 	msg := fmt.Sprintf("validate %s:%v(%T) ", pstr, val, val)
 	if util.IsValueNil(val) || util.IsEmptyString(val) {
@@ -115,7 +137,7 @@ func validateLeaf(validations map[string]ValidatorFunc, path util.Path, val inte
 		return nil
 	}
 
-	vf, ok := validations[pstr]
+	v, ok := validations[pstr]
 	if !ok {
 		msg += fmt.Sprintf("validate %s: OK (no validation)", pstr)
 		scope.Debug(msg)
@@ -123,7 +145,7 @@ func validateLeaf(validations map[string]ValidatorFunc, path util.Path, val inte
 		return nil
 	}
 	scope.Debug(msg)
-	return vf(path, val)
+	return withPath(util.ToYAMLPath(pstr), v.validate(path, val))
 }
 
 func validateHub(path util.Path, val interface{}) util.Errors {
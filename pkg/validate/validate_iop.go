@@ -0,0 +1,126 @@
+// Copyright 2019 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/ghodss/yaml"
+
+	"istio.io/api/operator/v1alpha1"
+	valuesvalidation "istio.io/operator/pkg/apis/istio/v1alpha1/validation"
+	"istio.io/operator/pkg/schema"
+	"istio.io/operator/pkg/translate"
+	"istio.io/operator/pkg/util"
+	binversion "istio.io/operator/version"
+)
+
+var (
+	// specPath is the path of the IstioOperatorSpec in an IstioOperator CR.
+	specPath = util.Path{"spec"}
+	// yamlErrorLineRegexp matches the line number in YAML parser errors.
+	yamlErrorLineRegexp = regexp.MustCompile(`line (\d+)`)
+)
+
+// CheckIstioOperatorCR runs the checks that only need the IstioOperator CR crYAML, read from file, and returns the
+// IstioOperatorSpec of the CR, or nil if it cannot be unmarshaled, with the problems found. The position of each
+// problem in crYAML is set.
+func CheckIstioOperatorCR(crYAML, file string) (*v1alpha1.IstioOperatorSpec, Diagnostics) {
+	doc, err := util.ParseYAMLNode(crYAML)
+	if err != nil {
+		d := &Diagnostic{RuleID: RuleYAMLSyntax, Severity: SeverityError, Message: err.Error(), File: file}
+		if m := yamlErrorLineRegexp.FindStringSubmatch(err.Error()); m != nil {
+			d.Line, _ = strconv.Atoi(m[1])
+			d.Column = 1
+		}
+		return nil, Diagnostics{d}
+	}
+
+	var ds Diagnostics
+	iops, err := checkSpec(crYAML, &ds)
+	if err != nil {
+		ds = append(ds, &Diagnostic{RuleID: RuleInvalidSpec, Severity: SeverityError, Message: err.Error()})
+	}
+	if iops != nil {
+		ds = append(ds, NewDiagnostics(RuleInvalidValue, SeverityError, validate(defaultValidations, iops, nil, false))...)
+		ds = append(ds, NewDiagnostics(RuleInvalidValue, SeverityError, checkOverlayPaths(iops))...)
+		ds = append(ds, NewDiagnostics(RuleInvalidValues, SeverityError, CheckValues(iops.Values))...)
+	}
+	ds.Locate(doc, specPath, file)
+	return iops, ds
+}
+
+// checkSpec appends the unknown fields and the fields deprecated in the operator version of the spec of the
+// IstioOperator CR crYAML to ds and returns the spec. Unknown fields are skipped when unmarshaling, so that the other
+// fields can still be checked.
+func checkSpec(crYAML string, ds *Diagnostics) (*v1alpha1.IstioOperatorSpec, error) {
+	cr := make(map[string]interface{})
+	if err := yaml.Unmarshal([]byte(crYAML), &cr); err != nil {
+		return nil, err
+	}
+	spec, ok := cr["spec"]
+	if !ok {
+		return nil, fmt.Errorf("spec is missing from IstioOperator YAML")
+	}
+	unknownSpecFields := false
+	for _, err := range schema.CheckFields(crYAML, specPath, "") {
+		d := &Diagnostic{RuleID: RuleUnknownField, Severity: SeverityError, Message: err.Error()}
+		if ufe, ok := err.(*schema.UnknownFieldError); ok {
+			d.Path = ufe.Path.String()
+			if ufe.Path[0] == "values" {
+				// values is passed to the charts as is, and charts may define their own values.
+				d.Severity = SeverityWarning
+			}
+		}
+		unknownSpecFields = unknownSpecFields || d.Severity == SeverityError
+		*ds = append(*ds, d)
+	}
+	specYAML, err := yaml.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	deprecations, err := translate.NewDeprecations()
+	if err != nil {
+		return nil, err
+	}
+	dds, err := CheckDeprecatedFields(string(specYAML), deprecations, binversion.OperatorBinaryVersion.MinorVersion)
+	if err != nil {
+		return nil, err
+	}
+	*ds = append(*ds, dds...)
+	iops := &v1alpha1.IstioOperatorSpec{}
+	if err := util.UnmarshalValuesWithJSONPB(string(specYAML), iops, unknownSpecFields); err != nil {
+		return nil, err
+	}
+	return iops, nil
+}
+
+// CheckValuesConfig checks the values of the merged IstioOperatorSpec iops with the validation of the values API types.
+// Checks of features that depend on each other are semantic rules, see CheckRules.
+func CheckValuesConfig(iops *v1alpha1.IstioOperatorSpec) Diagnostics {
+	values, err := typedValues(iops)
+	if err != nil {
+		return NewDiagnostics(RuleInvalidValues, SeverityError, util.NewErrs(err))
+	}
+	ds := NewDiagnostics(RuleValuesConfig, SeverityError, valuesvalidation.ValidateConfig(false, values, iops))
+	for _, d := range ds {
+		if d.Path == "" {
+			d.Path = "values"
+		}
+	}
+	return ds
+}
@@ -15,6 +15,7 @@
 package validate
 
 import (
+	"strings"
 	"testing"
 
 	"istio.io/api/operator/v1alpha1"
@@ -146,3 +147,44 @@ values:
 		})
 	}
 }
+
+func TestRules(t *testing.T) {
+	tests := []struct {
+		desc string
+		path string
+		want string
+	}{
+		{
+			desc: "tag",
+			path: "tag",
+			want: "tag",
+		},
+		{
+			desc: "values ip ranges",
+			path: "values.global.proxy.includeIPRanges",
+			want: "CIDR",
+		},
+		{
+			desc: "no rules",
+			path: "meshConfig.rootNamespace",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got := strings.Join(Rules(util.PathFromString(tt.path)), "\n")
+			if (tt.want == "") != (got == "") || !strings.Contains(got, tt.want) {
+				t.Errorf("Rules(%s): got %q, want to contain %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidationsHaveRules(t *testing.T) {
+	for _, validations := range []map[string]validation{defaultValidations, defaultValuesValidations} {
+		for path, v := range validations {
+			if v.validate == nil || v.rule == "" {
+				t.Errorf("validation of %s must have both a validator func and a rule", path)
+			}
+		}
+	}
+}
@@ -15,41 +15,68 @@
 package validate
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/ghodss/yaml"
 
 	"istio.io/operator/pkg/apis/istio/v1alpha1"
+	"istio.io/operator/pkg/component/component"
+	"istio.io/operator/pkg/helm"
+	"istio.io/operator/pkg/object"
+	"istio.io/operator/pkg/schema"
 	"istio.io/operator/pkg/util"
 )
 
 var (
-	// defaultValidations maps a data path to a validation function.
-	defaultValuesValidations = map[string]ValidatorFunc{
-		"global.proxy.includeIPRanges":     validateIPRangesOrStar,
-		"global.proxy.excludeIPRanges":     validateIPRangesOrStar,
-		"global.proxy.includeInboundPorts": validateStringList(validatePortNumberString),
-		"global.proxy.excludeInboundPorts": validateStringList(validatePortNumberString),
+	// defaultValuesValidations maps a data path to a validation function and its description.
+	defaultValuesValidations = map[string]validation{
+		"global.proxy.includeIPRanges":     {validateIPRangesOrStar, ipRangesOrStarRule},
+		"global.proxy.excludeIPRanges":     {validateIPRangesOrStar, ipRangesOrStarRule},
+		"global.proxy.includeInboundPorts": {validateStringList(validatePortNumberString), portNumberListRule},
+		"global.proxy.excludeInboundPorts": {validateStringList(validatePortNumberString), portNumberListRule},
+		"global.extraManifests":            {validateExtraManifests, extraManifestsRule},
 	}
 )
 
-// CheckValues validates the values in the given tree, which follows the Istio values.yaml schema.
+const (
+	ipRangesOrStarRule = "must be * or a comma separated list of CIDR ranges"
+	portNumberListRule = "must be a comma separated list of port numbers in [0, 65535]"
+	extraManifestsRule = "must be a list of entries with the fields component, manifest and file, where exactly one of " +
+		"manifest and file is set and manifest holds Kubernetes resources"
+)
+
+// valuesRules returns descriptions of the validations of the values field at path.
+func valuesRules(path util.Path) []string {
+	if v, ok := defaultValuesValidations[path.String()]; ok {
+		return []string{v.rule}
+	}
+	return nil
+}
+
+// CheckValues validates the values in the given tree, which follows the Istio values.yaml schema. Fields that are not
+// in the schema are allowed, because values is passed to the charts as is and they may define their own values, and
+// only logged as warnings.
 func CheckValues(root map[string]interface{}) util.Errors {
 	vs, err := yaml.Marshal(root)
 	if err != nil {
 		return util.Errors{err}
 	}
 	val := &v1alpha1.Values{}
-	if err := util.UnmarshalValuesWithJSONPB(string(vs), val, false); err != nil {
+	if err := util.UnmarshalValuesWithJSONPB(string(vs), val, true); err != nil {
 		return util.Errors{err}
 	}
+	for _, err := range checkValuesFields(root) {
+		scope.Warnf("%s", err)
+	}
 	return validateValues(root, nil)
 }
 
 func validateValues(node interface{}, path util.Path) (errs util.Errors) {
 	pstr := path.String()
 	scope.Debugf("validateValues %s", pstr)
-	vf := defaultValuesValidations[pstr]
-	if vf != nil {
-		errs = util.AppendErrs(errs, vf(path, node))
+	if v, ok := defaultValuesValidations[pstr]; ok {
+		errs = util.AppendErrs(errs, withPath(append(util.Path{"values"}, path...), v.validate(path, node)))
 	}
 
 	nn, ok := node.(map[string]interface{})
@@ -63,3 +90,38 @@ func validateValues(node interface{}, path util.Path) (errs util.Errors) {
 
 	return errs
 }
+
+// validateExtraManifests checks that val is a list of ExtraManifestConfig entries. Unlike the other values, unknown
+// fields in the entries are errors, since the entries are not passed to the charts.
+func validateExtraManifests(path util.Path, val interface{}) util.Errors {
+	entries, ok := val.([]interface{})
+	if !ok {
+		return util.NewErrs(fmt.Errorf("validateExtraManifests %s got %T, want list", path, val))
+	}
+	var errs util.Errors
+	for i, entry := range entries {
+		e, err := component.ParseExtraManifest(entry)
+		if err != nil {
+			errs = util.AppendErr(errs, fmt.Errorf("entry %d: %s", i, err))
+			continue
+		}
+		for _, doc := range strings.Split(e.Manifest, helm.YAMLSeparator) {
+			if strings.TrimSpace(doc) == "" {
+				continue
+			}
+			if _, err := object.ParseYAMLToK8sObject([]byte(doc)); err != nil {
+				errs = util.AppendErr(errs, fmt.Errorf("entry %d: bad manifest: %s", i, err))
+			}
+		}
+	}
+	return errs
+}
+
+// checkValuesFields returns an error for each field in the values tree root that is not in the values schema.
+func checkValuesFields(root map[string]interface{}) util.Errors {
+	y, err := yaml.Marshal(map[string]interface{}{"values": root})
+	if err != nil {
+		return util.Errors{err}
+	}
+	return schema.CheckFields(string(y), nil, "")
+}
@@ -124,21 +124,62 @@ global:
 			wantErrs: makeErrors([]string{`global.proxy.includeInboundPorts : strconv.ParseInt: parsing "222x": invalid syntax`}),
 		},
 		{
-			desc: "unknown field",
+			desc: "unknown field is allowed",
 			yamlStr: `
 global:
   proxy:
     foo: "bar"
 `,
-			wantErrs: makeErrors([]string{`unknown field "foo" in v1alpha1.ProxyConfig`}),
 		},
 		{
-			desc: "unknown field",
+			desc: "unknown field is allowed",
 			yamlStr: `
 cni:
   foo: "bar"
 `,
-			wantErrs: makeErrors([]string{`unknown field "foo" in v1alpha1.CNIConfig`}),
+		},
+		{
+			desc: "ExtraManifests",
+			yamlStr: `
+global:
+  extraManifests:
+  - component: Pilot
+    manifest: |
+      apiVersion: v1
+      kind: ConfigMap
+      metadata:
+        name: extra
+  - file: extra.yaml
+`,
+		},
+		{
+			desc: "BadExtraManifests",
+			yamlStr: `
+global:
+  extraManifests:
+  - component: Pilot
+    foo: bar
+    manifest: "kind: ConfigMap"
+  - component: Pilot
+  - manifest: "kind: ConfigMap"
+    file: extra.yaml
+  - manifest: "kind: [ConfigMap"
+`,
+			wantErrs: makeErrors([]string{`entry 0: unknown field "foo" in v1alpha1.ExtraManifestConfig`,
+				`entry 1: exactly one of manifest and file must be set`,
+				`entry 2: exactly one of manifest and file must be set`,
+				"entry 3: bad manifest: error decoding object: error converting YAML to JSON: yaml: line 1: " +
+					"did not find expected ',' or ']'"}),
+		},
+		{
+			desc: "bad type of known field",
+			yamlStr: `
+global:
+  proxy:
+    foo: "bar"
+    privileged: "yes"
+`,
+			wantErrs: makeErrors([]string{`json: cannot unmarshal string into Go value of type bool`}),
 		},
 	}
 
@@ -15,13 +15,33 @@
 package version
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	goversion "github.com/hashicorp/go-version"
 	"gopkg.in/yaml.v2"
 )
 
+const (
+	// StableChannel is the release channel for versions recommended for production use.
+	StableChannel = "stable"
+	// RegularChannel is the release channel for versions that have had some production exposure.
+	RegularChannel = "regular"
+	// RapidChannel is the release channel for the newest versions.
+	RapidChannel = "rapid"
+
+	// DateLayout is the format of the deprecation and EOL dates in the versions map.
+	DateLayout = "2006-01-02"
+)
+
+var (
+	// Channels is the list of all valid release channels, ordered from most to least conservative.
+	Channels = []string{StableChannel, RegularChannel, RapidChannel}
+)
+
 // CompatibilityMapping is a mapping from an Istio operator version and the corresponding recommended and
 // supported versions of Istio.
 type CompatibilityMapping struct {
@@ -29,6 +49,84 @@ type CompatibilityMapping struct {
 	OperatorVersionRange     goversion.Constraints `json:"operatorVersionRange,omitempty"`
 	SupportedIstioVersions   goversion.Constraints `json:"supportedIstioVersions,omitempty"`
 	RecommendedIstioVersions goversion.Constraints `json:"recommendedIstioVersions,omitempty"`
+	// Channels is the list of release channels this version is published in.
+	Channels []string `json:"channels,omitempty"`
+	// K8sVersions is the range of Kubernetes versions this version can be installed on.
+	K8sVersions goversion.Constraints `json:"k8sVersions,omitempty"`
+	// DeprecationDate is the date after which this version is no longer recommended.
+	DeprecationDate time.Time `json:"deprecationDate,omitempty"`
+	// EOLDate is the date after which this version no longer receives any fixes.
+	EOLDate time.Time `json:"eolDate,omitempty"`
+}
+
+// IsValidChannel reports whether channel is one of the known release channels.
+func IsValidChannel(channel string) bool {
+	for _, c := range Channels {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
+
+// InChannel reports whether v is published in the given release channel.
+func (v *CompatibilityMapping) InChannel(channel string) bool {
+	for _, c := range v.Channels {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
+
+// SupportsK8sVersion reports whether v can be installed on Kubernetes version kv. A mapping without a Kubernetes
+// range supports any version.
+func (v *CompatibilityMapping) SupportsK8sVersion(kv *goversion.Version) bool {
+	if v.K8sVersions == nil || kv == nil {
+		return true
+	}
+	return v.K8sVersions.Check(kv)
+}
+
+// IsDeprecated reports whether v is deprecated at time t.
+func (v *CompatibilityMapping) IsDeprecated(t time.Time) bool {
+	return !v.DeprecationDate.IsZero() && !t.Before(v.DeprecationDate)
+}
+
+// IsEOL reports whether v has reached its end of life at time t.
+func (v *CompatibilityMapping) IsEOL(t time.Time) bool {
+	return !v.EOLDate.IsZero() && !t.Before(v.EOLDate)
+}
+
+// RecommendedIstioVersion returns the highest Istio version that RecommendedIstioVersions pins exactly, e.g. 1.4.3 or
+// = 1.4.3, or nil if it only gives ranges.
+func (v *CompatibilityMapping) RecommendedIstioVersion() *goversion.Version {
+	var out *goversion.Version
+	for _, c := range v.RecommendedIstioVersions {
+		rv, err := goversion.NewVersion(strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(c.String()), "=")))
+		if err != nil {
+			continue
+		}
+		if out == nil || rv.GreaterThan(out) {
+			out = rv
+		}
+	}
+	return out
+}
+
+// NewestInChannel returns the mapping with the highest operator version in the given channel that has not reached
+// its end of life at time t, or nil if there is none.
+func NewestInChannel(mappings []*CompatibilityMapping, channel string, t time.Time) *CompatibilityMapping {
+	var newest *CompatibilityMapping
+	for _, v := range mappings {
+		if !v.InChannel(channel) || v.IsEOL(t) {
+			continue
+		}
+		if newest == nil || v.OperatorVersion.GreaterThan(newest.OperatorVersion) {
+			newest = v
+		}
+	}
+	return newest
 }
 
 // NewVersionFromString creates a new Version from the provided SemVer formatted string and returns a pointer to it.
@@ -60,7 +158,27 @@ func NewVersionFromString(s string) (*Version, error) {
 
 // MarshalYAML implements the Marshaler interface.
 func (v *CompatibilityMapping) MarshalYAML() (interface{}, error) {
-	out := make(map[string]string)
+	out := v.toMap()
+	if len(out) == 0 {
+		return nil, nil
+	}
+	return out, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. Version constraints are not HTML escaped.
+func (v *CompatibilityMapping) MarshalJSON() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v.toMap()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// toMap returns the fields of v that are set, keyed by their versions map field name.
+func (v *CompatibilityMapping) toMap() map[string]interface{} {
+	out := make(map[string]interface{})
 	if v.OperatorVersion != nil {
 		out["operatorVersion"] = v.OperatorVersion.String()
 	}
@@ -73,19 +191,32 @@ func (v *CompatibilityMapping) MarshalYAML() (interface{}, error) {
 	if v.RecommendedIstioVersions != nil {
 		out["recommendedIstioVersions"] = v.RecommendedIstioVersions.String()
 	}
-	if len(out) == 0 {
-		return nil, nil
+	if len(v.Channels) != 0 {
+		out["channels"] = v.Channels
 	}
-	return out, nil
+	if v.K8sVersions != nil {
+		out["k8sVersions"] = v.K8sVersions.String()
+	}
+	if !v.DeprecationDate.IsZero() {
+		out["deprecationDate"] = v.DeprecationDate.Format(DateLayout)
+	}
+	if !v.EOLDate.IsZero() {
+		out["eolDate"] = v.EOLDate.Format(DateLayout)
+	}
+	return out
 }
 
 // UnmarshalYAML implements the Unmarshaler interface.
 func (v *CompatibilityMapping) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	type inStruct struct {
-		OperatorVersion          string `yaml:"operatorVersion"`
-		OperatorVersionRange     string `yaml:"operatorVersionRange"`
-		SupportedIstioVersions   string `yaml:"supportedIstioVersions"`
-		RecommendedIstioVersions string `yaml:"recommendedIstioVersions"`
+		OperatorVersion          string   `yaml:"operatorVersion"`
+		OperatorVersionRange     string   `yaml:"operatorVersionRange"`
+		SupportedIstioVersions   string   `yaml:"supportedIstioVersions"`
+		RecommendedIstioVersions string   `yaml:"recommendedIstioVersions"`
+		Channels                 []string `yaml:"channels"`
+		K8sVersions              string   `yaml:"k8sVersions"`
+		DeprecationDate          string   `yaml:"deprecationDate"`
+		EOLDate                  string   `yaml:"eolDate"`
 	}
 	tmp := inStruct{}
 	if err := unmarshal(&tmp); err != nil {
@@ -122,6 +253,27 @@ func (v *CompatibilityMapping) UnmarshalYAML(unmarshal func(interface{}) error)
 			return err
 		}
 	}
+	for _, c := range tmp.Channels {
+		if !IsValidChannel(c) {
+			return fmt.Errorf("unknown channel %s for operatorVersion %s, must be one of %v", c, tmp.OperatorVersion, Channels)
+		}
+	}
+	v.Channels = tmp.Channels
+	if tmp.K8sVersions != "" {
+		if v.K8sVersions, err = goversion.NewConstraint(tmp.K8sVersions); err != nil {
+			return err
+		}
+	}
+	if tmp.DeprecationDate != "" {
+		if v.DeprecationDate, err = time.Parse(DateLayout, tmp.DeprecationDate); err != nil {
+			return fmt.Errorf("bad deprecationDate for operatorVersion %s: %v", tmp.OperatorVersion, err)
+		}
+	}
+	if tmp.EOLDate != "" {
+		if v.EOLDate, err = time.Parse(DateLayout, tmp.EOLDate); err != nil {
+			return fmt.Errorf("bad eolDate for operatorVersion %s: %v", tmp.OperatorVersion, err)
+		}
+	}
 	return nil
 }
 
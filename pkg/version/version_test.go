@@ -17,9 +17,11 @@ package version
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"istio.io/operator/pkg/util"
 
+	goversion "github.com/hashicorp/go-version"
 	"github.com/kr/pretty"
 	"gopkg.in/yaml.v2"
 )
@@ -130,6 +132,36 @@ operatorVersionRange: 1.3.0
 supportedIstioVersions: "> 1.1, < 1.4.0"
 `,
 		},
+		{
+			desc: "channels and lifecycle",
+			yamlStr: `
+operatorVersion: 1.3.0
+operatorVersionRange: 1.3.0
+supportedIstioVersions: 1.3.0
+channels: [stable, regular]
+k8sVersions: '>= 1.13, < 1.16'
+deprecationDate: 2019-11-14
+eolDate: 2020-03-19
+`,
+		},
+		{
+			desc: "unknown channel",
+			yamlStr: `
+operatorVersion: 1.3.0
+supportedIstioVersions: 1.3.0
+channels: [beta]
+`,
+			wantErr: `unknown channel beta for operatorVersion 1.3.0, must be one of [stable regular rapid]`,
+		},
+		{
+			desc: "bad eolDate",
+			yamlStr: `
+operatorVersion: 1.3.0
+supportedIstioVersions: 1.3.0
+eolDate: March 2020
+`,
+			wantErr: `bad eolDate for operatorVersion 1.3.0: parsing time "March 2020" as "2006-01-02": cannot parse "March 2020" as "2006"`,
+		},
 		{
 			desc: "missing operatorVersion",
 			yamlStr: `
@@ -180,6 +212,156 @@ badField: ">= 1, < 1.4"
 
 }
 
+func TestNewestInChannel(t *testing.T) {
+	yamlStr := `
+- operatorVersion: 1.3.7
+  supportedIstioVersions: 1.3.7
+  channels: [stable]
+  eolDate: 2020-03-19
+- operatorVersion: 1.4.2
+  supportedIstioVersions: 1.4.2
+  channels: [stable, regular]
+  k8sVersions: '>= 1.13, < 1.17'
+- operatorVersion: 1.4.3
+  supportedIstioVersions: 1.4.3
+  channels: [regular]
+- operatorVersion: 1.5.0
+  supportedIstioVersions: 1.5.0
+  channels: [rapid]
+`
+	var mappings []*CompatibilityMapping
+	if err := yaml.Unmarshal([]byte(yamlStr), &mappings); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		desc    string
+		channel string
+		time    string
+		want    string
+	}{
+		{
+			desc:    "stable before EOL",
+			channel: StableChannel,
+			time:    "2019-12-01",
+			want:    "1.4.2",
+		},
+		{
+			desc:    "regular",
+			channel: RegularChannel,
+			time:    "2020-04-01",
+			want:    "1.4.3",
+		},
+		{
+			desc:    "rapid",
+			channel: RapidChannel,
+			time:    "2020-04-01",
+			want:    "1.5.0",
+		},
+		{
+			desc:    "unknown channel",
+			channel: "beta",
+			time:    "2020-04-01",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			now, err := time.Parse(DateLayout, tt.time)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := ""
+			if v := NewestInChannel(mappings, tt.channel, now); v != nil {
+				got = v.OperatorVersion.String()
+			}
+			if got != tt.want {
+				t.Errorf("%s: got %s, want %s", tt.desc, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecommendedIstioVersion(t *testing.T) {
+	tests := []struct {
+		desc        string
+		recommended string
+		want        string
+	}{
+		{
+			desc:        "exact",
+			recommended: "1.4.3",
+			want:        "1.4.3",
+		},
+		{
+			desc:        "equals",
+			recommended: "= 1.4.3",
+			want:        "1.4.3",
+		},
+		{
+			desc:        "highest exact",
+			recommended: "1.4.2, 1.4.3",
+			want:        "1.4.3",
+		},
+		{
+			desc:        "range",
+			recommended: ">= 1.4, < 1.5",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			c, err := goversion.NewConstraint(tt.recommended)
+			if err != nil {
+				t.Fatal(err)
+			}
+			v := &CompatibilityMapping{RecommendedIstioVersions: c}
+			got := ""
+			if rv := v.RecommendedIstioVersion(); rv != nil {
+				got = rv.String()
+			}
+			if got != tt.want {
+				t.Errorf("%s: got %s, want %s", tt.desc, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLifecycle(t *testing.T) {
+	m := &CompatibilityMapping{}
+	if err := yaml.Unmarshal([]byte(`
+operatorVersion: 1.3.0
+supportedIstioVersions: 1.3.0
+k8sVersions: '>= 1.13, < 1.16'
+deprecationDate: 2019-11-14
+eolDate: 2020-03-19
+`), m); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		time           string
+		wantDeprecated bool
+		wantEOL        bool
+	}{
+		{time: "2019-11-13"},
+		{time: "2019-11-14", wantDeprecated: true},
+		{time: "2020-03-19", wantDeprecated: true, wantEOL: true},
+	} {
+		now, err := time.Parse(DateLayout, tt.time)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := m.IsDeprecated(now); got != tt.wantDeprecated {
+			t.Errorf("IsDeprecated(%s): got %v, want %v", tt.time, got, tt.wantDeprecated)
+		}
+		if got := m.IsEOL(now); got != tt.wantEOL {
+			t.Errorf("IsEOL(%s): got %v, want %v", tt.time, got, tt.wantEOL)
+		}
+	}
+	for kv, want := range map[string]bool{"1.12.9": false, "1.13.0": true, "1.15.4": true, "1.16.0": false} {
+		if got := m.SupportsK8sVersion(goversion.Must(goversion.NewVersion(kv))); got != want {
+			t.Errorf("SupportsK8sVersion(%s): got %v, want %v", kv, got, want)
+		}
+	}
+}
+
 // errToString returns the string representation of err and the empty string if
 // err is nil.
 func errToString(err error) string {
@@ -228,14 +228,17 @@
 // ../../data/operator/templates/crd.yaml
 // ../../data/operator/templates/deployment.yaml
 // ../../data/operator/templates/namespace.yaml
+// ../../data/operator/templates/package_cache_pvc.yaml
 // ../../data/operator/templates/service.yaml
 // ../../data/operator/templates/service_account.yaml
+// ../../data/operator/templates/webhook.yaml
 // ../../data/profiles/default.yaml
 // ../../data/profiles/demo.yaml
 // ../../data/profiles/empty.yaml
 // ../../data/profiles/minimal.yaml
 // ../../data/profiles/remote.yaml
 // ../../data/profiles/sds.yaml
+// ../../data/translateConfig/deprecations.yaml
 // ../../data/translateConfig/reverseTranslateConfig-1.4.yaml
 // ../../data/translateConfig/reverseTranslateConfig-1.5.yaml
 // ../../data/translateConfig/translateConfig-1.3.yaml
@@ -38882,31 +38885,6 @@ spec:
   scope: Namespaced
   subresources:
     status: {}
-  validation:
-    openAPIV3Schema:
-      properties:
-        apiVersion:
-          description: 'APIVersion defines the versioned schema of this representation
-            of an object. Servers should convert recognized schemas to the latest
-            internal value, and may reject unrecognized values.
-            More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#resources'
-          type: string
-        kind:
-          description: 'Kind is a string value representing the REST resource this
-            object represents. Servers may infer this from the endpoint the client
-            submits requests to. Cannot be updated. In CamelCase.
-            More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
-          type: string
-        spec:
-          description: 'Specification of the desired state of the istio control plane resource.
-            More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status'
-          type: object
-        status:
-          description: 'Status describes each of istio control plane component status at the current time.
-            0 means NONE, 1 means UPDATING, 2 means HEALTHY, 3 means ERROR, 4 means RECONCILING.
-            More info: https://github.com/istio/api/blob/master/operator/v1alpha1/istio.operator.v1alpha1.pb.html &
-            https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status'
-          type: object
   versions:
   - name: v1alpha1
     served: true
@@ -38951,7 +38929,12 @@ spec:
           command:
           - istio-operator
           - server
+          - --package-cache-dir=/var/cache/istio-operator/packages
+          - --keyring=/etc/istio-operator/keyring
           imagePullPolicy: IfNotPresent
+          ports:
+          - name: https-webhook
+            containerPort: 9443
           resources:
             limits:
               cpu: 200m
@@ -38964,12 +38947,33 @@ spec:
               value: {{.Values.istioNamespace}}
             - name: LEADER_ELECTION_NAMESPACE
               value: {{.Values.operatorNamespace}}
+            - name: POD_NAMESPACE
+              valueFrom:
+                fieldRef:
+                  fieldPath: metadata.namespace
             - name: POD_NAME
               valueFrom:
                 fieldRef:
                   fieldPath: metadata.name
             - name: OPERATOR_NAME
               value: {{.Values.operatorNamespace}}
+          volumeMounts:
+            - name: package-cache
+              mountPath: /var/cache/istio-operator/packages
+            - name: keyring
+              mountPath: /etc/istio-operator/keyring
+              readOnly: true
+      volumes:
+        # Install packages fetched from URLs are kept across restarts of the operator.
+        - name: package-cache
+          persistentVolumeClaim:
+            claimName: istio-operator-package-cache
+        # Public keys that install package signatures are verified against. Only needed if an IstioOperator
+        # installPackagePath is a URL.
+        - name: keyring
+          secret:
+            secretName: istio-operator-keyring
+            optional: true
 ---
 `)
 
@@ -39013,6 +39017,35 @@ func operatorTemplatesNamespaceYaml() (*asset, error) {
 	return a, nil
 }
 
+var _operatorTemplatesPackage_cache_pvcYaml = []byte(`apiVersion: v1
+kind: PersistentVolumeClaim
+metadata:
+  namespace: {{.Values.operatorNamespace}}
+  name: istio-operator-package-cache
+spec:
+  accessModes:
+  - ReadWriteOnce
+  resources:
+    requests:
+      storage: 1Gi
+---
+`)
+
+func operatorTemplatesPackage_cache_pvcYamlBytes() ([]byte, error) {
+	return _operatorTemplatesPackage_cache_pvcYaml, nil
+}
+
+func operatorTemplatesPackage_cache_pvcYaml() (*asset, error) {
+	bytes, err := operatorTemplatesPackage_cache_pvcYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "operator/templates/package_cache_pvc.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _operatorTemplatesServiceYaml = []byte(`apiVersion: v1
 kind: Service
 metadata:
@@ -39025,6 +39058,9 @@ spec:
   - name: http-metrics
     port: 8383
     targetPort: 8383
+  - name: https-webhook
+    port: 443
+    targetPort: 9443
   selector:
     name: istio-operator
 ---
@@ -39068,6 +39104,54 @@ func operatorTemplatesService_accountYaml() (*asset, error) {
 	return a, nil
 }
 
+var _operatorTemplatesWebhookYaml = []byte(`# Validates IstioOperator resources when they are applied. The operator sets the CA bundle when it starts, and the
+# webhook is ignored until it is ready, so that the operator can be installed together with its resources.
+apiVersion: admissionregistration.k8s.io/v1beta1
+kind: ValidatingWebhookConfiguration
+metadata:
+  name: istio-operator
+  labels:
+    name: istio-operator
+webhooks:
+  - name: validation.install.istio.io
+    clientConfig:
+      service:
+        namespace: {{.Values.operatorNamespace}}
+        name: istio-operator
+        path: /validate-istiooperator
+    rules:
+      - operations:
+        - CREATE
+        - UPDATE
+        apiGroups:
+        - install.istio.io
+        apiVersions:
+        - v1alpha1
+        resources:
+        - istiooperators
+    failurePolicy: Ignore
+    sideEffects: None
+    admissionReviewVersions:
+    - v1beta1
+    timeoutSeconds: 30
+---
+`)
+
+func operatorTemplatesWebhookYamlBytes() ([]byte, error) {
+	return _operatorTemplatesWebhookYaml, nil
+}
+
+func operatorTemplatesWebhookYaml() (*asset, error) {
+	bytes, err := operatorTemplatesWebhookYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "operator/templates/webhook.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _profilesDefaultYaml = []byte(`apiVersion: operator.istio.io/v1alpha1
 kind: IstioOperator
 spec:
@@ -40130,6 +40214,252 @@ func profilesSdsYaml() (*asset, error) {
 	return a, nil
 }
 
+var _translateconfigDeprecationsYaml = []byte(`# Deprecated and removed IstioOperatorSpec fields and Kubernetes APIs, by the version that deprecates or removes them.
+#
+# fields are paths relative to the IstioOperatorSpec. Setting a field is a warning from the Istio minor version in
+# deprecatedIn and an error from the one in removedIn.
+# apis are Kubernetes API versions of kinds. Rendering an object with one of them is a warning, and an error when the
+# cluster does not serve it anymore, which happens from the Kubernetes minor version in removedInKubernetes.
+fields:
+  # Kubernetes settings of components in values moved to the k8s settings of the components.
+  - path: values.pilot.replicaCount
+    deprecatedIn: "1.4"
+    replacement: components.pilot.k8s.replicaCount
+  - path: values.pilot.resources
+    deprecatedIn: "1.4"
+    replacement: components.pilot.k8s.resources
+  - path: values.pilot.nodeSelector
+    deprecatedIn: "1.4"
+    replacement: components.pilot.k8s.nodeSelector
+  - path: values.pilot.podAnnotations
+    deprecatedIn: "1.4"
+    replacement: components.pilot.k8s.podAnnotations
+  - path: values.pilot.tolerations
+    deprecatedIn: "1.4"
+    replacement: components.pilot.k8s.tolerations
+  - path: values.pilot.rollingMaxSurge
+    deprecatedIn: "1.4"
+    replacement: components.pilot.k8s.strategy.rollingUpdate.maxSurge
+  - path: values.pilot.rollingMaxUnavailable
+    deprecatedIn: "1.4"
+    replacement: components.pilot.k8s.strategy.rollingUpdate.maxUnavailable
+  - path: values.pilot.cpu
+    deprecatedIn: "1.4"
+    replacement: components.pilot.k8s.hpaSpec.metrics
+  - path: values.mixer.policy.replicaCount
+    deprecatedIn: "1.4"
+    replacement: components.policy.k8s.replicaCount
+  - path: values.mixer.policy.resources
+    deprecatedIn: "1.4"
+    replacement: components.policy.k8s.resources
+  - path: values.mixer.policy.podAnnotations
+    deprecatedIn: "1.4"
+    replacement: components.policy.k8s.podAnnotations
+  - path: values.mixer.policy.cpu
+    deprecatedIn: "1.4"
+    replacement: components.policy.k8s.hpaSpec.metrics
+  - path: values.mixer.telemetry.replicaCount
+    deprecatedIn: "1.4"
+    replacement: components.telemetry.k8s.replicaCount
+  - path: values.mixer.telemetry.resources
+    deprecatedIn: "1.4"
+    replacement: components.telemetry.k8s.resources
+  - path: values.mixer.telemetry.nodeSelector
+    deprecatedIn: "1.4"
+    replacement: components.telemetry.k8s.nodeSelector
+  - path: values.mixer.telemetry.podAnnotations
+    deprecatedIn: "1.4"
+    replacement: components.telemetry.k8s.podAnnotations
+  - path: values.mixer.telemetry.tolerations
+    deprecatedIn: "1.4"
+    replacement: components.telemetry.k8s.tolerations
+  - path: values.mixer.telemetry.rollingMaxSurge
+    deprecatedIn: "1.4"
+    replacement: components.telemetry.k8s.strategy.rollingUpdate.maxSurge
+  - path: values.mixer.telemetry.rollingMaxUnavailable
+    deprecatedIn: "1.4"
+    replacement: components.telemetry.k8s.strategy.rollingUpdate.maxUnavailable
+  - path: values.mixer.telemetry.cpu
+    deprecatedIn: "1.4"
+    replacement: components.telemetry.k8s.hpaSpec.metrics
+  - path: values.galley.replicaCount
+    deprecatedIn: "1.4"
+    replacement: components.galley.k8s.replicaCount
+  - path: values.galley.resources
+    deprecatedIn: "1.4"
+    replacement: components.galley.k8s.resources
+  - path: values.galley.tolerations
+    deprecatedIn: "1.4"
+    replacement: components.galley.k8s.tolerations
+  - path: values.galley.rollingMaxSurge
+    deprecatedIn: "1.4"
+    replacement: components.galley.k8s.strategy.rollingUpdate.maxSurge
+  - path: values.galley.rollingMaxUnavailable
+    deprecatedIn: "1.4"
+    replacement: components.galley.k8s.strategy.rollingUpdate.maxUnavailable
+  - path: values.sidecarInjectorWebhook.replicaCount
+    deprecatedIn: "1.4"
+    replacement: components.sidecarInjector.k8s.replicaCount
+  - path: values.sidecarInjectorWebhook.resources
+    deprecatedIn: "1.4"
+    replacement: components.sidecarInjector.k8s.resources
+  - path: values.sidecarInjectorWebhook.nodeSelector
+    deprecatedIn: "1.4"
+    replacement: components.sidecarInjector.k8s.nodeSelector
+  - path: values.sidecarInjectorWebhook.tolerations
+    deprecatedIn: "1.4"
+    replacement: components.sidecarInjector.k8s.tolerations
+  - path: values.security.replicaCount
+    deprecatedIn: "1.4"
+    replacement: components.citadel.k8s.replicaCount
+  - path: values.security.nodeSelector
+    deprecatedIn: "1.4"
+    replacement: components.citadel.k8s.nodeSelector
+  - path: values.security.podAnnotations
+    deprecatedIn: "1.4"
+    replacement: components.citadel.k8s.podAnnotations
+  - path: values.security.tolerations
+    deprecatedIn: "1.4"
+    replacement: components.citadel.k8s.tolerations
+  - path: values.nodeagent.nodeSelector
+    deprecatedIn: "1.4"
+    replacement: components.nodeAgent.k8s.nodeSelector
+  - path: values.nodeagent.tolerations
+    deprecatedIn: "1.4"
+    replacement: components.nodeAgent.k8s.tolerations
+  - path: values.gateways.istio-ingressgateway.replicaCount
+    deprecatedIn: "1.4"
+    replacement: components.ingressGateways.<index>.k8s.replicaCount
+  - path: values.gateways.istio-ingressgateway.resources
+    deprecatedIn: "1.4"
+    replacement: components.ingressGateways.<index>.k8s.resources
+  - path: values.gateways.istio-ingressgateway.nodeSelector
+    deprecatedIn: "1.4"
+    replacement: components.ingressGateways.<index>.k8s.nodeSelector
+  - path: values.gateways.istio-ingressgateway.podAnnotations
+    deprecatedIn: "1.4"
+    replacement: components.ingressGateways.<index>.k8s.podAnnotations
+  - path: values.gateways.istio-ingressgateway.tolerations
+    deprecatedIn: "1.4"
+    replacement: components.ingressGateways.<index>.k8s.tolerations
+  - path: values.gateways.istio-ingressgateway.rollingMaxSurge
+    deprecatedIn: "1.4"
+    replacement: components.ingressGateways.<index>.k8s.strategy.rollingUpdate.maxSurge
+  - path: values.gateways.istio-ingressgateway.rollingMaxUnavailable
+    deprecatedIn: "1.4"
+    replacement: components.ingressGateways.<index>.k8s.strategy.rollingUpdate.maxUnavailable
+  - path: values.gateways.istio-ingressgateway.cpu
+    deprecatedIn: "1.4"
+    replacement: components.ingressGateways.<index>.k8s.hpaSpec.metrics
+  - path: values.gateways.istio-egressgateway.resources
+    deprecatedIn: "1.4"
+    replacement: components.egressGateways.<index>.k8s.resources
+  - path: values.gateways.istio-egressgateway.nodeSelector
+    deprecatedIn: "1.4"
+    replacement: components.egressGateways.<index>.k8s.nodeSelector
+  - path: values.gateways.istio-egressgateway.podAnnotations
+    deprecatedIn: "1.4"
+    replacement: components.egressGateways.<index>.k8s.podAnnotations
+  - path: values.gateways.istio-egressgateway.tolerations
+    deprecatedIn: "1.4"
+    replacement: components.egressGateways.<index>.k8s.tolerations
+  - path: values.gateways.istio-egressgateway.cpu
+    deprecatedIn: "1.4"
+    replacement: components.egressGateways.<index>.k8s.hpaSpec.metrics
+  - path: values.global.defaultResources
+    deprecatedIn: "1.4"
+    replacement: components.<component>.k8s.resources
+  - path: values.global.defaultNodeSelector
+    deprecatedIn: "1.4"
+    replacement: components.<component>.k8s.nodeSelector
+  - path: values.global.defaultPodDisruptionBudget
+    deprecatedIn: "1.4"
+    replacement: components.<component>.k8s.podDisruptionBudget
+  - path: values.global.priorityClassName
+    deprecatedIn: "1.4"
+    replacement: components.<component>.k8s.priorityClassName
+apis:
+  - apiVersion: extensions/v1beta1
+    kind: Ingress
+    replacement: networking.k8s.io/v1beta1
+    removedInKubernetes: "1.22"
+  - apiVersion: extensions/v1beta1
+    kind: Deployment
+    replacement: apps/v1
+    removedInKubernetes: "1.16"
+  - apiVersion: extensions/v1beta1
+    kind: DaemonSet
+    replacement: apps/v1
+    removedInKubernetes: "1.16"
+  - apiVersion: extensions/v1beta1
+    kind: ReplicaSet
+    replacement: apps/v1
+    removedInKubernetes: "1.16"
+  - apiVersion: extensions/v1beta1
+    kind: NetworkPolicy
+    replacement: networking.k8s.io/v1
+    removedInKubernetes: "1.16"
+  - apiVersion: extensions/v1beta1
+    kind: PodSecurityPolicy
+    replacement: policy/v1beta1
+    removedInKubernetes: "1.16"
+  - apiVersion: apps/v1beta1
+    kind: Deployment
+    replacement: apps/v1
+    removedInKubernetes: "1.16"
+  - apiVersion: apps/v1beta1
+    kind: StatefulSet
+    replacement: apps/v1
+    removedInKubernetes: "1.16"
+  - apiVersion: apps/v1beta2
+    kind: Deployment
+    replacement: apps/v1
+    removedInKubernetes: "1.16"
+  - apiVersion: apps/v1beta2
+    kind: DaemonSet
+    replacement: apps/v1
+    removedInKubernetes: "1.16"
+  - apiVersion: apps/v1beta2
+    kind: StatefulSet
+    replacement: apps/v1
+    removedInKubernetes: "1.16"
+  - apiVersion: apps/v1beta2
+    kind: ReplicaSet
+    replacement: apps/v1
+    removedInKubernetes: "1.16"
+  - apiVersion: rbac.authorization.k8s.io/v1beta1
+    kind: ClusterRole
+    replacement: rbac.authorization.k8s.io/v1
+    removedInKubernetes: "1.22"
+  - apiVersion: rbac.authorization.k8s.io/v1beta1
+    kind: ClusterRoleBinding
+    replacement: rbac.authorization.k8s.io/v1
+    removedInKubernetes: "1.22"
+  - apiVersion: rbac.authorization.k8s.io/v1beta1
+    kind: Role
+    replacement: rbac.authorization.k8s.io/v1
+    removedInKubernetes: "1.22"
+  - apiVersion: rbac.authorization.k8s.io/v1beta1
+    kind: RoleBinding
+    replacement: rbac.authorization.k8s.io/v1
+    removedInKubernetes: "1.22"
+`)
+
+func translateconfigDeprecationsYamlBytes() ([]byte, error) {
+	return _translateconfigDeprecationsYaml, nil
+}
+
+func translateconfigDeprecationsYaml() (*asset, error) {
+	bytes, err := translateconfigDeprecationsYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "translateConfig/deprecations.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _translateconfigReversetranslateconfig14Yaml = []byte(`kubernetesPatternMapping:
   "{{.ValueComponentName}}.env":                   "{{.FeatureName}}.Components.{{.ComponentName}}.K8s.Env"
   "{{.ValueComponentName}}.autoscaleEnabled":      "{{.FeatureName}}.Components.{{.ComponentName}}.K8s.HpaSpec"
@@ -40828,45 +41158,87 @@ func translateconfigTranslateconfig15Yaml() (*asset, error) {
 var _versionsYaml = []byte(`- operatorVersion: 1.3.0
   supportedIstioVersions: 1.3.0
   recommendedIstioVersions: 1.3.0
+  channels: [stable]
+  k8sVersions: ">=1.13, <1.16"
+  deprecationDate: 2019-11-14
+  eolDate: 2020-03-19
 - operatorVersion: 1.3.1
   supportedIstioVersions: ">=1.3.0,<=1.3.1"
   recommendedIstioVersions: 1.3.1
+  channels: [stable]
+  k8sVersions: ">=1.13, <1.16"
+  deprecationDate: 2019-11-14
+  eolDate: 2020-03-19
 - operatorVersion: 1.3.2
   supportedIstioVersions: ">=1.3.0,<=1.3.2"
   recommendedIstioVersions: 1.3.2
+  channels: [stable]
+  k8sVersions: ">=1.13, <1.16"
+  deprecationDate: 2019-11-14
+  eolDate: 2020-03-19
 - operatorVersion: 1.3.3
   supportedIstioVersions: ">=1.3.0,<=1.3.3"
   recommendedIstioVersions: 1.3.3
+  channels: [stable]
+  k8sVersions: ">=1.13, <1.16"
+  deprecationDate: 2019-11-14
+  eolDate: 2020-03-19
 - operatorVersion: 1.3.4
   supportedIstioVersions: ">=1.3.0,<=1.3.4"
   recommendedIstioVersions: 1.3.4
+  channels: [stable]
+  k8sVersions: ">=1.13, <1.16"
+  deprecationDate: 2019-11-14
+  eolDate: 2020-03-19
 - operatorVersion: 1.3.5
   supportedIstioVersions: ">=1.3.0,<=1.3.5"
   recommendedIstioVersions: 1.3.5
+  channels: [stable]
+  k8sVersions: ">=1.13, <1.16"
+  deprecationDate: 2019-11-14
+  eolDate: 2020-03-19
 - operatorVersion: 1.3.6
   supportedIstioVersions: ">=1.3.0,<=1.3.6"
   recommendedIstioVersions: 1.3.6
+  channels: [stable]
+  k8sVersions: ">=1.13, <1.16"
+  deprecationDate: 2019-11-14
+  eolDate: 2020-03-19
 - operatorVersion: 1.3.7
   operatorVersionRange: ">=1.3.7,<1.4.0"
   supportedIstioVersions: ">=1.3.0,<1.4.0"
   recommendedIstioVersions: 1.3.7
+  channels: [stable]
+  k8sVersions: ">=1.13, <1.16"
+  deprecationDate: 2019-11-14
+  eolDate: 2020-03-19
 - operatorVersion: 1.4.0
   supportedIstioVersions: ">=1.3.3, <1.6"
   recommendedIstioVersions: 1.4.0
+  channels: [stable, regular]
+  k8sVersions: ">=1.13, <1.17"
 - operatorVersion: 1.4.1
   supportedIstioVersions: ">=1.3.3, <1.6"
   recommendedIstioVersions: 1.4.1
+  channels: [stable, regular]
+  k8sVersions: ">=1.13, <1.17"
 - operatorVersion: 1.4.2
   supportedIstioVersions: ">=1.3.3, <1.6"
   recommendedIstioVersions: 1.4.2
+  channels: [stable, regular]
+  k8sVersions: ">=1.13, <1.17"
 - operatorVersion: 1.4.3
   operatorVersionRange: ">=1.4.3,<1.5.0"
   supportedIstioVersions: ">=1.3.3, <1.6"
   recommendedIstioVersions: 1.4.3
+  channels: [stable, regular]
+  k8sVersions: ">=1.13, <1.17"
 - operatorVersion: 1.5.0
   operatorVersionRange: ">=1.5.0,<1.6.0"
   supportedIstioVersions: ">=1.5.0, <1.6"
   recommendedIstioVersions: 1.5.0
+  channels: [rapid]
+  k8sVersions: ">=1.14, <1.17"
 `)
 
 func versionsYamlBytes() ([]byte, error) {
@@ -41164,14 +41536,17 @@ var _bindata = map[string]func() (*asset, error){
 	"operator/templates/crd.yaml":                                                         operatorTemplatesCrdYaml,
 	"operator/templates/deployment.yaml":                                                  operatorTemplatesDeploymentYaml,
 	"operator/templates/namespace.yaml":                                                   operatorTemplatesNamespaceYaml,
+	"operator/templates/package_cache_pvc.yaml":                                           operatorTemplatesPackage_cache_pvcYaml,
 	"operator/templates/service.yaml":                                                     operatorTemplatesServiceYaml,
 	"operator/templates/service_account.yaml":                                             operatorTemplatesService_accountYaml,
+	"operator/templates/webhook.yaml":                                                     operatorTemplatesWebhookYaml,
 	"profiles/default.yaml":                                                               profilesDefaultYaml,
 	"profiles/demo.yaml":                                                                  profilesDemoYaml,
 	"profiles/empty.yaml":                                                                 profilesEmptyYaml,
 	"profiles/minimal.yaml":                                                               profilesMinimalYaml,
 	"profiles/remote.yaml":                                                                profilesRemoteYaml,
 	"profiles/sds.yaml":                                                                   profilesSdsYaml,
+	"translateConfig/deprecations.yaml":                                                   translateconfigDeprecationsYaml,
 	"translateConfig/reverseTranslateConfig-1.4.yaml":                                     translateconfigReversetranslateconfig14Yaml,
 	"translateConfig/reverseTranslateConfig-1.5.yaml":                                     translateconfigReversetranslateconfig15Yaml,
 	"translateConfig/translateConfig-1.3.yaml":                                            translateconfigTranslateconfig13Yaml,
@@ -41555,8 +41930,10 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"crd.yaml":                 &bintree{operatorTemplatesCrdYaml, map[string]*bintree{}},
 			"deployment.yaml":          &bintree{operatorTemplatesDeploymentYaml, map[string]*bintree{}},
 			"namespace.yaml":           &bintree{operatorTemplatesNamespaceYaml, map[string]*bintree{}},
+			"package_cache_pvc.yaml":   &bintree{operatorTemplatesPackage_cache_pvcYaml, map[string]*bintree{}},
 			"service.yaml":             &bintree{operatorTemplatesServiceYaml, map[string]*bintree{}},
 			"service_account.yaml":     &bintree{operatorTemplatesService_accountYaml, map[string]*bintree{}},
+			"webhook.yaml":             &bintree{operatorTemplatesWebhookYaml, map[string]*bintree{}},
 		}},
 	}},
 	"profiles": &bintree{nil, map[string]*bintree{
@@ -41568,6 +41945,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"sds.yaml":     &bintree{profilesSdsYaml, map[string]*bintree{}},
 	}},
 	"translateConfig": &bintree{nil, map[string]*bintree{
+		"deprecations.yaml":               &bintree{translateconfigDeprecationsYaml, map[string]*bintree{}},
 		"reverseTranslateConfig-1.4.yaml": &bintree{translateconfigReversetranslateconfig14Yaml, map[string]*bintree{}},
 		"reverseTranslateConfig-1.5.yaml": &bintree{translateconfigReversetranslateconfig15Yaml, map[string]*bintree{}},
 		"translateConfig-1.3.yaml":        &bintree{translateconfigTranslateconfig13Yaml, map[string]*bintree{}},